@@ -47,6 +47,11 @@ string get_current_timestamp() {
     return ss.str();
 }
 
+// 진행 상황을 서버에서 읽을 수 있도록 한 줄 JSON으로 출력 (PROGRESS:{...})
+void print_progress(const json& progress) {
+    cout << "PROGRESS:" << progress.dump() << endl;
+}
+
 // 파일명에서 CCTV ID 추출 (예: P1_B2_3_1_Current.jpg -> P1_B2_3_1)
 string extract_cctv_id_from_filename(const string& filename) {
     // _Current가 있는 경우와 없는 경우 모두 처리
@@ -104,8 +109,11 @@ vector<RoiInfo> get_rois_from_json(const string& json_path, const string& cctv_i
                     roiInfo.points = roi;
                     
                     // parking_id 추출 (기본값은 0)
+                    // 백엔드가 정한 고정 번호(roi_id)가 있으면 그대로 사용, 없으면 parking_id 문자열에서 추출
                     roiInfo.parking_id = 0;
-                    if (match.contains("parking_id")) {
+                    if (match.contains("roi_id") && match["roi_id"].is_number_integer()) {
+                        roiInfo.parking_id = match["roi_id"];
+                    } else if (match.contains("parking_id")) {
                         try {
                             if (match["parking_id"].is_number()) {
                                 roiInfo.parking_id = match["parking_id"];
@@ -158,7 +166,7 @@ ParkingResult process_single_test_image(const string& test_image_path, double le
     if (cctv_id.empty()) {
         // CCTV ID를 추출할 수 없는 경우, 파일명에서 확장자를 제거한 것을 사용
         cctv_id = fs::path(result.test_image_name).stem().string();
-        cout << "CCTV ID를 추출할 수 없어 파일명을 사용합니다: " << cctv_id << endl;
+        cerr << "CCTV ID를 추출할 수 없어 파일명을 사용합니다: " << cctv_id << endl;
     }
     result.cctv_id = cctv_id;
     
@@ -172,10 +180,10 @@ ParkingResult process_single_test_image(const string& test_image_path, double le
         // 특정 CCTV 폴더가 없는 경우, learningBackImg 폴더의 모든 이미지를 사용
         learning_folder_path = fs::path(learning_base_path) / "learningBackImg";
         if (!fs::exists(learning_folder_path)) {
-            cout << "학습 폴더를 찾을 수 없습니다: " << learning_folder_path << endl;
+            cerr << "학습 폴더를 찾을 수 없습니다: " << learning_folder_path << endl;
             return result;
         }
-        cout << "특정 CCTV 폴더가 없어 전체 학습 폴더를 사용합니다: " << learning_folder_path << endl;
+        cerr << "특정 CCTV 폴더가 없어 전체 학습 폴더를 사용합니다: " << learning_folder_path << endl;
     }
     
     // 1️⃣ MOG2 초기화
@@ -203,6 +211,7 @@ ParkingResult process_single_test_image(const string& test_image_path, double le
     
     // 학습 데이터 크기 저장
     result.learning_data_size = learning_data_count;
+    print_progress({{"stage", "learned"}, {"cctv_id", cctv_id}, {"learned_images", learning_data_count}});
 
     // 3️⃣ 테스트 이미지 로드
     Mat testImg = imread(test_image_path);
@@ -221,7 +230,7 @@ ParkingResult process_single_test_image(const string& test_image_path, double le
     // 6️⃣ 해당 CCTV의 ROI 정보 JSON에서 읽기
     vector<RoiInfo> rois = get_rois_from_json(roi_path, cctv_id);
     if (rois.empty()) {
-        cout << "CCTV " << cctv_id << "의 ROI 정보를 찾을 수 없어 처리를 건너뜁니다." << endl;
+        cerr << "CCTV " << cctv_id << "의 ROI 정보를 찾을 수 없어 처리를 건너뜁니다." << endl;
         
         // ROI 정보가 없어도 기본 이미지는 저장
         string roiImagePath = cctv_output_dir + "/roi_result.jpg";
@@ -344,6 +353,7 @@ void save_result_to_json(const vector<ParkingResult>& results, const string& out
     for (const auto& result : results) {
         json result_obj;
         result_obj["cctv_id"] = result.cctv_id;
+        result_obj["test_image"] = result.test_image_name;
         result_obj["learning_data_size"] = result.learning_data_size;
         
         json roi_array = json::array();
@@ -399,7 +409,8 @@ int main(int argc, char* argv[]) {
     
     vector<ParkingResult> all_results;
     
-    // 모든 테스트 이미지 처리 (재귀적으로 검색)
+    // 모든 테스트 이미지 수집 (재귀적으로 검색, 진행률 표시를 위해 먼저 개수 확인)
+    vector<string> test_images;
     for (const auto& entry : fs::recursive_directory_iterator(test_images_path)) {
         if (entry.is_regular_file()) {
             string ext = entry.path().extension().string();
@@ -408,18 +419,28 @@ int main(int argc, char* argv[]) {
                 
                 // jpg 파일 처리 (_Current가 있든 없든)
                 if (filename.find(".jpg") != string::npos) {
-                    ParkingResult result = process_single_test_image(
-                        entry.path().string(), learning_rate, iterations, var_threshold,
-                        learning_base_path, roi_path, results_dir
-                    );
-                    
-                    if (!result.cctv_id.empty()) {
-                        all_results.push_back(result);
-                    }
+                    test_images.push_back(entry.path().string());
                 }
             }
         }
     }
+    print_progress({{"stage", "start"}, {"total", test_images.size()}});
+    
+    for (size_t i = 0; i < test_images.size(); i++) {
+        string filename = fs::path(test_images[i]).filename().string();
+        print_progress({{"stage", "testing"}, {"index", i + 1}, {"total", test_images.size()}, {"image", filename}});
+        
+        ParkingResult result = process_single_test_image(
+            test_images[i], learning_rate, iterations, var_threshold,
+            learning_base_path, roi_path, results_dir
+        );
+        
+        if (!result.cctv_id.empty()) {
+            all_results.push_back(result);
+        }
+        print_progress({{"stage", "tested"}, {"index", i + 1}, {"total", test_images.size()}, {"image", filename},
+                        {"cctv_id", result.cctv_id}, {"roi_count", result.roi_results.size()}});
+    }
     
     // 결과를 shared/{project_id}/results 폴더에 저장
     save_result_to_json(all_results, results_dir);
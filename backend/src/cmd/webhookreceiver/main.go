@@ -0,0 +1,59 @@
+// 웹훅 로컬 수신기 (서명 확인 후 받은 이벤트를 출력)
+// go run ./cmd/webhookreceiver -addr :9000 -secret whsec_...
+// 등록할 url은 http://localhost:9000/ , -fail N이면 처음 N번은 500으로 응답해 재시도를 확인할 수 있음
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"main/common/webhook"
+	"net/http"
+	"sync/atomic"
+)
+
+func main() {
+	addr := flag.String("addr", ":9000", "수신 주소")
+	secret := flag.String("secret", "", "구독 secret (비우면 서명을 확인하지 않음)")
+	fail := flag.Int64("fail", 0, "처음 N번 요청은 500으로 응답")
+	flag.Parse()
+
+	var received atomic.Int64
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		count := received.Add(1)
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		verified := "확인 안 함"
+		if *secret != "" {
+			if !webhook.Verify(*secret, r.Header.Get(webhook.HeaderTimestamp), body, r.Header.Get(webhook.HeaderSignature)) {
+				fmt.Printf("#%d 서명 불일치 (%s %s)\n", count, r.Header.Get(webhook.HeaderEvent), r.Header.Get(webhook.HeaderDelivery))
+				http.Error(w, "invalid signature", http.StatusUnauthorized)
+				return
+			}
+			verified = "일치"
+		}
+
+		var pretty bytes.Buffer
+		if err := json.Indent(&pretty, body, "", "  "); err != nil {
+			pretty.Write(body)
+		}
+		fmt.Printf("#%d %s (delivery %s, 서명 %s)\n%s\n", count, r.Header.Get(webhook.HeaderEvent), r.Header.Get(webhook.HeaderDelivery), verified, pretty.String())
+
+		if count <= *fail {
+			http.Error(w, "fail requested", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	fmt.Printf("웹훅 수신 대기: %s\n", *addr)
+	if err := http.ListenAndServe(*addr, nil); err != nil {
+		fmt.Println(err)
+	}
+}
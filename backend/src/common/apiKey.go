@@ -0,0 +1,29 @@
+package common
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// PublicAPIKeyHeader 공개 API 키를 담는 요청 헤더 (쿼리 문자열은 로그에 남으므로 받지 않음)
+const PublicAPIKeyHeader = "X-API-Key"
+
+// 키 앞부분은 목록에서 키를 구분하는 용도로 저장 ("pk_" + 8자)
+const publicAPIKeyPrefixLength = 11
+
+// NewPublicAPIKey 공개 API 키 생성 (키는 발급 응답에서만 보여주고 해시와 앞부분만 저장)
+func NewPublicAPIKey() (key string, prefix string, err error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	key = "pk_" + hex.EncodeToString(buf)
+	return key, key[:publicAPIKeyPrefixLength], nil
+}
+
+// HashPublicAPIKey 저장/조회용 키 해시 (SHA-256 hex)
+func HashPublicAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
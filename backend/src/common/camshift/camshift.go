@@ -0,0 +1,206 @@
+package camshift
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"math"
+	"math/cmplx"
+)
+
+// 카메라 흔들림 검사
+//
+// 기준 프레임과 새 이미지를 축소한 흑백 영상으로 바꾼 뒤 위상 상관(phase correlation)으로
+// 화면 전체가 움직인 양(평행 이동)을 추정함. 결과는 원본 해상도 픽셀 단위.
+
+const (
+	// gridSize 축소 영상 크기 (FFT를 위해 2의 거듭제곱, 긴 변을 이 크기에 맞춤)
+	gridSize = 256
+	// DefaultTolerance 이 거리(원본 픽셀)보다 많이 움직이면 카메라가 틀어진 것으로 봄
+	DefaultTolerance = 5.0
+	// MinConfidence 상관 정점 값이 이보다 낮으면 장면이 너무 달라 추정 결과를 믿을 수 없음
+	MinConfidence = 0.05
+)
+
+// ErrSizeMismatch 기준 프레임과 해상도가 다름
+var ErrSizeMismatch = errors.New("기준 프레임과 이미지 해상도가 다릅니다")
+
+// Frame 위상 상관용으로 축소한 흑백 영상
+type Frame struct {
+	Width  int
+	Height int
+
+	scale    float64
+	spectrum [][]complex128
+}
+
+// Shift 기준 프레임 대비 현재 이미지 내용이 움직인 양 (원본 픽셀, ROI에 그대로 더하면 맞춰짐)
+type Shift struct {
+	Dx         float64
+	Dy         float64
+	Confidence float64
+}
+
+// Distance 이동 거리
+func (s Shift) Distance() float64 {
+	return math.Hypot(s.Dx, s.Dy)
+}
+
+// NewFrame 이미지를 축소 흑백 영상으로 변환하고 스펙트럼 계산
+func NewFrame(img image.Image) *Frame {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	scale := math.Max(float64(max(width, height))/gridSize, 1)
+	w := min(int(math.Ceil(float64(width)/scale)), gridSize)
+	h := min(int(math.Ceil(float64(height)/scale)), gridSize)
+
+	// 영역 평균으로 축소
+	sum := make([]float64, w*h)
+	count := make([]float64, w*h)
+	for y := 0; y < height; y++ {
+		gy := min(int(float64(y)/scale), h-1)
+		for x := 0; x < width; x++ {
+			gx := min(int(float64(x)/scale), w-1)
+			r, g, b, _ := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+			sum[gy*w+gx] += 0.299*float64(r) + 0.587*float64(g) + 0.114*float64(b)
+			count[gy*w+gx]++
+		}
+	}
+	mean := 0.0
+	for i := range sum {
+		sum[i] /= count[i]
+		mean += sum[i]
+	}
+	mean /= float64(len(sum))
+
+	// 평균을 빼고 Hann 창을 씌워 가장자리 불연속이 정점을 만들지 않게 함 (남는 칸은 0)
+	grid := make([][]complex128, gridSize)
+	for y := range grid {
+		grid[y] = make([]complex128, gridSize)
+		if y >= h {
+			continue
+		}
+		wy := hann(y, h)
+		for x := 0; x < w; x++ {
+			grid[y][x] = complex((sum[y*w+x]-mean)*wy*hann(x, w), 0)
+		}
+	}
+	fft2(grid, false)
+
+	return &Frame{Width: width, Height: height, scale: scale, spectrum: grid}
+}
+
+// Estimate 기준 프레임 대비 현재 프레임의 이동량 추정
+func Estimate(ref *Frame, cur *Frame) (Shift, error) {
+	if ref.Width != cur.Width || ref.Height != cur.Height {
+		return Shift{}, fmt.Errorf("%w (기준 %dx%d, 이미지 %dx%d)", ErrSizeMismatch, ref.Width, ref.Height, cur.Width, cur.Height)
+	}
+
+	// 정규화한 교차 전력 스펙트럼의 역변환 정점 위치가 이동량
+	corr := make([][]complex128, gridSize)
+	for y := range corr {
+		corr[y] = make([]complex128, gridSize)
+		for x := range corr[y] {
+			p := cur.spectrum[y][x] * cmplx.Conj(ref.spectrum[y][x])
+			if m := cmplx.Abs(p); m > 1e-12 {
+				corr[y][x] = p / complex(m, 0)
+			}
+		}
+	}
+	fft2(corr, true)
+
+	peakX, peakY, peak := 0, 0, math.Inf(-1)
+	for y := range corr {
+		for x := range corr[y] {
+			if v := real(corr[y][x]); v > peak {
+				peakX, peakY, peak = x, y, v
+			}
+		}
+	}
+
+	dx := float64(wrap(peakX)) + subpixel(real(corr[peakY][(peakX+gridSize-1)%gridSize]), peak, real(corr[peakY][(peakX+1)%gridSize]))
+	dy := float64(wrap(peakY)) + subpixel(real(corr[(peakY+gridSize-1)%gridSize][peakX]), peak, real(corr[(peakY+1)%gridSize][peakX]))
+	return Shift{
+		Dx:         dx * ref.scale,
+		Dy:         dy * ref.scale,
+		Confidence: math.Max(peak, 0),
+	}, nil
+}
+
+// wrap 순환 인덱스를 음수 포함 이동량으로 (절반 이상이면 반대 방향)
+func wrap(i int) int {
+	if i > gridSize/2 {
+		return i - gridSize
+	}
+	return i
+}
+
+// subpixel 정점과 양 옆 값으로 포물선 보간한 정점 위치 보정 (-0.5 ~ 0.5)
+func subpixel(left float64, center float64, right float64) float64 {
+	denom := left - 2*center + right
+	if denom >= 0 {
+		return 0
+	}
+	return math.Max(-0.5, math.Min(0.5, 0.5*(left-right)/denom))
+}
+
+// hann Hann 창 가중치
+func hann(i int, n int) float64 {
+	if n <= 1 {
+		return 1
+	}
+	return 0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/float64(n-1))
+}
+
+// fft2 2차원 FFT (행, 열 순서로 1차원 FFT, inverse면 1/N² 포함)
+func fft2(grid [][]complex128, inverse bool) {
+	for _, row := range grid {
+		fft(row, inverse)
+	}
+	column := make([]complex128, len(grid))
+	for x := range grid[0] {
+		for y := range grid {
+			column[y] = grid[y][x]
+		}
+		fft(column, inverse)
+		for y := range grid {
+			grid[y][x] = column[y]
+		}
+	}
+}
+
+// fft 제자리 radix-2 FFT (길이는 2의 거듭제곱)
+func fft(a []complex128, inverse bool) {
+	n := len(a)
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			a[i], a[j] = a[j], a[i]
+		}
+	}
+	sign := -1.0
+	if inverse {
+		sign = 1.0
+	}
+	for size := 2; size <= n; size <<= 1 {
+		step := cmplx.Rect(1, sign*2*math.Pi/float64(size))
+		for start := 0; start < n; start += size {
+			w := complex(1, 0)
+			for k := 0; k < size/2; k++ {
+				u, v := a[start+k], a[start+k+size/2]*w
+				a[start+k] = u + v
+				a[start+k+size/2] = u - v
+				w *= step
+			}
+		}
+	}
+	if inverse {
+		for i := range a {
+			a[i] /= complex(float64(n), 0)
+		}
+	}
+}
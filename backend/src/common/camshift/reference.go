@@ -0,0 +1,183 @@
+package camshift
+
+import (
+	"encoding/json"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"main/common"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// 기준 프레임 저장 위치: {workspace}/roiReferences/{cctvId}.{jpg|png} + {cctvId}.json
+
+// Status 카메라 정렬 상태
+type Status string
+
+const (
+	StatusAligned      = Status("aligned")
+	StatusMisaligned   = Status("misaligned")
+	StatusUncertain    = Status("uncertain")
+	StatusNoReference  = Status("no_reference")
+	StatusSizeMismatch = Status("size_mismatch")
+)
+
+// Reference 기준 프레임 정보 (ROI를 저장할 때 그 ROI를 그린 이미지)
+type Reference struct {
+	CctvID  string    `json:"cctv_id"`
+	Image   string    `json:"image"`
+	Source  string    `json:"source"`
+	RoiFile string    `json:"roi_file"`
+	Width   int       `json:"width"`
+	Height  int       `json:"height"`
+	SavedAt time.Time `json:"saved_at"`
+}
+
+// Result 한 카메라의 정렬 검사 결과
+type Result struct {
+	Status    Status
+	Shift     Shift
+	Reference *Reference
+	Message   string
+}
+
+// referenceDir 작업 폴더의 기준 프레임 폴더
+func referenceDir(ws common.Workspace) (string, error) {
+	return ws.Resolve("roiReferences")
+}
+
+// SaveReference sourcePath 이미지를 cctvID의 기준 프레임으로 저장 (기존 기준 프레임 교체)
+func SaveReference(ws common.Workspace, cctvID string, sourcePath string, roiFile string) (*Reference, error) {
+	if err := common.ValidatePathSegment(cctvID); err != nil {
+		return nil, err
+	}
+	dir, err := referenceDir(ws)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("기준 프레임 폴더 생성 실패: %v", err)
+	}
+
+	data, err := os.ReadFile(sourcePath)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(sourcePath)
+	if err != nil {
+		return nil, err
+	}
+	config, _, err := image.DecodeConfig(file)
+	file.Close()
+	if err != nil {
+		return nil, fmt.Errorf("기준 이미지 읽기 실패: %v", err)
+	}
+
+	// 확장자가 바뀌어도 이전 이미지가 남지 않도록 정리
+	imageName := cctvID + strings.ToLower(filepath.Ext(sourcePath))
+	for _, ext := range []string{".jpg", ".jpeg", ".png"} {
+		if cctvID+ext != imageName {
+			os.Remove(filepath.Join(dir, cctvID+ext))
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, imageName), data, 0644); err != nil {
+		return nil, err
+	}
+
+	ref := &Reference{
+		CctvID:  cctvID,
+		Image:   imageName,
+		Source:  filepath.Base(sourcePath),
+		RoiFile: roiFile,
+		Width:   config.Width,
+		Height:  config.Height,
+		SavedAt: time.Now(),
+	}
+	meta, err := json.MarshalIndent(ref, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(filepath.Join(dir, cctvID+".json"), meta, 0644); err != nil {
+		return nil, err
+	}
+	return ref, nil
+}
+
+// LoadReference cctvID의 기준 프레임 정보 (없으면 os.ErrNotExist)
+func LoadReference(ws common.Workspace, cctvID string) (*Reference, error) {
+	if err := common.ValidatePathSegment(cctvID); err != nil {
+		return nil, err
+	}
+	dir, err := referenceDir(ws)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(dir, cctvID+".json"))
+	if err != nil {
+		return nil, err
+	}
+	var ref Reference
+	if err := json.Unmarshal(data, &ref); err != nil {
+		return nil, fmt.Errorf("기준 프레임 정보 읽기 실패: %v", err)
+	}
+	return &ref, nil
+}
+
+// Check imagePath가 cctvID의 기준 프레임에서 얼마나 움직였는지 검사
+// tolerance(원본 픽셀)보다 많이 움직였으면 misaligned
+func Check(ws common.Workspace, cctvID string, imagePath string, tolerance float64) (Result, error) {
+	ref, err := LoadReference(ws, cctvID)
+	if os.IsNotExist(err) {
+		return Result{Status: StatusNoReference, Message: "ROI 저장 시 기록된 기준 프레임이 없습니다"}, nil
+	}
+	if err != nil {
+		return Result{}, err
+	}
+	dir, err := referenceDir(ws)
+	if err != nil {
+		return Result{}, err
+	}
+
+	refFrame, err := loadFrame(filepath.Join(dir, ref.Image))
+	if err != nil {
+		return Result{}, fmt.Errorf("기준 프레임 읽기 실패: %v", err)
+	}
+	curFrame, err := loadFrame(imagePath)
+	if err != nil {
+		return Result{}, fmt.Errorf("이미지 읽기 실패: %v", err)
+	}
+
+	shift, err := Estimate(refFrame, curFrame)
+	if err != nil {
+		return Result{Status: StatusSizeMismatch, Reference: ref, Message: err.Error()}, nil
+	}
+
+	result := Result{Status: StatusAligned, Shift: shift, Reference: ref}
+	switch {
+	case shift.Confidence < MinConfidence:
+		result.Status = StatusUncertain
+		result.Message = "기준 프레임과 장면이 많이 달라 이동량을 추정할 수 없습니다"
+	case shift.Distance() > tolerance:
+		result.Status = StatusMisaligned
+		result.Message = fmt.Sprintf("카메라가 (%.1f, %.1f) 픽셀 움직였습니다", shift.Dx, shift.Dy)
+	}
+	return result, nil
+}
+
+// loadFrame 이미지 파일을 위상 상관용 영상으로
+func loadFrame(path string) (*Frame, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	img, _, err := image.Decode(file)
+	if err != nil {
+		return nil, err
+	}
+	return NewFrame(img), nil
+}
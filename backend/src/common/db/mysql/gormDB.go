@@ -1,9 +1,260 @@
 package mysql
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
+const (
+	ProjectStatusActive   = "active"
+	ProjectStatusInactive = "inactive"
+)
+
+type Projects struct {
+	ID          string    `json:"id" gorm:"column:id;primaryKey"`
+	Name        string    `json:"name" gorm:"column:name"`
+	Description string    `json:"description" gorm:"column:description"`
+	Location    string    `json:"location" gorm:"column:location"`
+	Status      string    `json:"status" gorm:"column:status"`
+	CreatedAt   time.Time `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt   time.Time `json:"updated_at" gorm:"column:updated_at"`
+}
+
+const (
+	LearningJobStatusQueued    = "queued"
+	LearningJobStatusRunning   = "running"
+	LearningJobStatusSucceeded = "succeeded"
+	LearningJobStatusFailed    = "failed"
+	LearningJobStatusCancelled = "cancelled"
+)
+
+type LearningJobs struct {
+	ID                  uint       `json:"id" gorm:"column:id;primaryKey"`
+	ProjectId           string     `json:"project_id" gorm:"column:project_id"`
+	SweepId             *uint      `json:"sweep_id" gorm:"column:sweep_id"`
+	Status              string     `json:"status" gorm:"column:status"`
+	VarThreshold        float64    `json:"var_threshold" gorm:"column:var_threshold"`
+	LearningRate        float64    `json:"learning_rate" gorm:"column:learning_rate"`
+	Iterations          int        `json:"iterations" gorm:"column:iterations"`
+	LearningPath        string     `json:"learning_path" gorm:"column:learning_path"`
+	TestImagePath       string     `json:"test_image_path" gorm:"column:test_image_path"`
+	RoiPath             string     `json:"roi_path" gorm:"column:roi_path"`
+	ResultFolder        string     `json:"result_folder" gorm:"column:result_folder"`
+	ExperimentSessionId *int       `json:"experiment_session_id" gorm:"column:experiment_session_id"`
+	ExitCode            *int       `json:"exit_code" gorm:"column:exit_code"`
+	StdoutTail          string     `json:"stdout_tail" gorm:"column:stdout_tail"`
+	StderrTail          string     `json:"stderr_tail" gorm:"column:stderr_tail"`
+	ErrorMessage        string     `json:"error_message" gorm:"column:error_message"`
+	DurationMs          int64      `json:"duration_ms" gorm:"column:duration_ms"`
+	StartedAt           *time.Time `json:"started_at" gorm:"column:started_at"`
+	FinishedAt          *time.Time `json:"finished_at" gorm:"column:finished_at"`
+	CreatedAt           time.Time  `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt           time.Time  `json:"updated_at" gorm:"column:updated_at"`
+}
+
+// LearningSweeps 하이퍼파라미터 조합 실행 묶음 (조합별 작업은 learning_jobs.sweep_id로 연결)
+type LearningSweeps struct {
+	ID                 uint      `json:"id" gorm:"column:id;primaryKey"`
+	ProjectId          string    `json:"project_id" gorm:"column:project_id"`
+	LearningPath       string    `json:"learning_path" gorm:"column:learning_path"`
+	TestImagePath      string    `json:"test_image_path" gorm:"column:test_image_path"`
+	RoiPath            string    `json:"roi_path" gorm:"column:roi_path"`
+	OccupancyThreshold float64   `json:"occupancy_threshold" gorm:"column:occupancy_threshold"`
+	TotalRuns          int       `json:"total_runs" gorm:"column:total_runs"`
+	CreatedAt          time.Time `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt          time.Time `json:"updated_at" gorm:"column:updated_at"`
+}
+
+const (
+	ThresholdScopeProject = "project"
+	ThresholdScopeCctv    = "cctv"
+	ThresholdScopeRoi     = "roi"
+)
+
+// OccupancyThresholds 점유 판정 기준 (project: 프로젝트 기본, cctv: CCTV별, roi: CCTV의 parking_id별)
+type OccupancyThresholds struct {
+	ID        uint      `json:"id" gorm:"column:id;primaryKey"`
+	ProjectId string    `json:"project_id" gorm:"column:project_id"`
+	Scope     string    `json:"scope" gorm:"column:scope"`
+	CctvId    string    `json:"cctv_id" gorm:"column:cctv_id"`
+	RoiId     int       `json:"roi_id" gorm:"column:roi_id"`
+	Threshold float64   `json:"threshold" gorm:"column:threshold"`
+	CreatedAt time.Time `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"column:updated_at"`
+}
+
+// RoiVersions 초안 저장으로 만들어진 ROI 파일 버전 (작성자 기록용)
+type RoiVersions struct {
+	ID          uint      `json:"id" gorm:"column:id;primaryKey"`
+	ProjectId   string    `json:"project_id" gorm:"column:project_id"`
+	BaseName    string    `json:"base_name" gorm:"column:base_name"`
+	FileName    string    `json:"file_name" gorm:"column:file_name"`
+	AuthorId    *uint     `json:"author_id" gorm:"column:author_id"`
+	AuthorEmail string    `json:"author_email" gorm:"column:author_email"`
+	CreatedAt   time.Time `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt   time.Time `json:"updated_at" gorm:"column:updated_at"`
+}
+
+// ParkingZones 주차장 구역 (주차장 lot → 층 floor → 구역 zone)
+type ParkingZones struct {
+	ID        uint      `json:"id" gorm:"column:id;primaryKey"`
+	ProjectId string    `json:"project_id" gorm:"column:project_id"`
+	Lot       string    `json:"lot" gorm:"column:lot"`
+	Floor     string    `json:"floor" gorm:"column:floor"`
+	Zone      string    `json:"zone" gorm:"column:zone"`
+	CreatedAt time.Time `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"column:updated_at"`
+}
+
+// ParkingZoneSpaces 구역에 속한 주차면 (CCTV의 parking_id는 한 구역에만 속함)
+type ParkingZoneSpaces struct {
+	ID        uint      `json:"id" gorm:"column:id;primaryKey"`
+	ProjectId string    `json:"project_id" gorm:"column:project_id"`
+	ZoneId    uint      `json:"zone_id" gorm:"column:zone_id"`
+	CctvId    string    `json:"cctv_id" gorm:"column:cctv_id"`
+	ParkingId string    `json:"parking_id" gorm:"column:parking_id"`
+	CreatedAt time.Time `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"column:updated_at"`
+}
+
+// ParkingZoneRules 이름 규칙 (cctv_pattern, parking_pattern 정규식의 이름 그룹으로 lot/floor/zone 결정)
+type ParkingZoneRules struct {
+	ID             uint      `json:"id" gorm:"column:id;primaryKey"`
+	ProjectId      string    `json:"project_id" gorm:"column:project_id"`
+	Priority       int       `json:"priority" gorm:"column:priority"`
+	CctvPattern    string    `json:"cctv_pattern" gorm:"column:cctv_pattern"`
+	ParkingPattern string    `json:"parking_pattern" gorm:"column:parking_pattern"`
+	Lot            string    `json:"lot" gorm:"column:lot"`
+	Floor          string    `json:"floor" gorm:"column:floor"`
+	Zone           string    `json:"zone" gorm:"column:zone"`
+	CreatedAt      time.Time `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt      time.Time `json:"updated_at" gorm:"column:updated_at"`
+}
+
+// ParkingSpaceCategories 주차면 종류 (지정하지 않은 주차면은 general)
+type ParkingSpaceCategories struct {
+	ID        uint      `json:"id" gorm:"column:id;primaryKey"`
+	ProjectId string    `json:"project_id" gorm:"column:project_id"`
+	CctvId    string    `json:"cctv_id" gorm:"column:cctv_id"`
+	ParkingId string    `json:"parking_id" gorm:"column:parking_id"`
+	Category  string    `json:"category" gorm:"column:category"`
+	CreatedAt time.Time `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"column:updated_at"`
+}
+
+// ParkingSpaces CCTV별 parking_id와 OpenCV ROI 번호(roi_results.roi_id) 대응
+type ParkingSpaces struct {
+	ID        uint      `json:"id" gorm:"column:id;primaryKey"`
+	ProjectId string    `json:"project_id" gorm:"column:project_id"`
+	CctvId    string    `json:"cctv_id" gorm:"column:cctv_id"`
+	ParkingId string    `json:"parking_id" gorm:"column:parking_id"`
+	RoiId     int       `json:"roi_id" gorm:"column:roi_id"`
+	CreatedAt time.Time `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"column:updated_at"`
+}
+
+// SpaceState 주차면별 최신 실시간 점유 상태 (source_image는 currentImages의 파일명)
+type SpaceState struct {
+	ID          uint      `json:"id" gorm:"column:id;primaryKey"`
+	ProjectId   string    `json:"project_id" gorm:"column:project_id"`
+	CctvId      string    `json:"cctv_id" gorm:"column:cctv_id"`
+	ParkingId   string    `json:"parking_id" gorm:"column:parking_id"`
+	RoiId       int       `json:"roi_id" gorm:"column:roi_id"`
+	Occupied    bool      `json:"occupied" gorm:"column:occupied"`
+	Rate        float64   `json:"rate" gorm:"column:rate"`
+	Threshold   float64   `json:"threshold" gorm:"column:threshold"`
+	SourceImage string    `json:"source_image" gorm:"column:source_image"`
+	ObservedAt  time.Time `json:"observed_at" gorm:"column:observed_at"`
+	CreatedAt   time.Time `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt   time.Time `json:"updated_at" gorm:"column:updated_at"`
+}
+
+// TableName 한 행이 주차면 하나의 현재 상태라 단수형 테이블 이름 사용
+func (SpaceState) TableName() string {
+	return "space_state"
+}
+
+// OccupancySamples 실시간 학습마다 쌓이는 주차면 점유 이력 (원본 점)
+type OccupancySamples struct {
+	ID         uint64    `json:"id" gorm:"column:id;primaryKey"`
+	ProjectId  string    `json:"project_id" gorm:"column:project_id"`
+	CctvId     string    `json:"cctv_id" gorm:"column:cctv_id"`
+	ParkingId  string    `json:"parking_id" gorm:"column:parking_id"`
+	Occupied   bool      `json:"occupied" gorm:"column:occupied"`
+	Rate       float64   `json:"rate" gorm:"column:rate"`
+	ObservedAt time.Time `json:"observed_at" gorm:"column:observed_at"`
+	CreatedAt  time.Time `json:"created_at" gorm:"column:created_at"`
+}
+
+// OccupancyHourlySamples 보관 기간이 지난 원본 점을 시간 단위로 줄인 이력 (평균 전경 비율 = rate_sum / samples)
+type OccupancyHourlySamples struct {
+	ID              uint64    `json:"id" gorm:"column:id;primaryKey"`
+	ProjectId       string    `json:"project_id" gorm:"column:project_id"`
+	CctvId          string    `json:"cctv_id" gorm:"column:cctv_id"`
+	ParkingId       string    `json:"parking_id" gorm:"column:parking_id"`
+	BucketStart     time.Time `json:"bucket_start" gorm:"column:bucket_start"`
+	Samples         int       `json:"samples" gorm:"column:samples"`
+	OccupiedSamples int       `json:"occupied_samples" gorm:"column:occupied_samples"`
+	RateSum         float64   `json:"rate_sum" gorm:"column:rate_sum"`
+	CreatedAt       time.Time `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt       time.Time `json:"updated_at" gorm:"column:updated_at"`
+}
+
+// WebhookSubscriptions 프로젝트별 외부 알림 구독 (event_types는 쉼표로 구분, zone_id가 없으면 프로젝트 전체 점유율 기준)
+type WebhookSubscriptions struct {
+	ID               uint      `json:"id" gorm:"column:id;primaryKey"`
+	ProjectId        string    `json:"project_id" gorm:"column:project_id"`
+	Url              string    `json:"url" gorm:"column:url"`
+	Secret           string    `json:"-" gorm:"column:secret"`
+	EventTypes       string    `json:"event_types" gorm:"column:event_types"`
+	Description      string    `json:"description" gorm:"column:description"`
+	ZoneId           *uint     `json:"zone_id" gorm:"column:zone_id"`
+	ThresholdPercent float64   `json:"threshold_percent" gorm:"column:threshold_percent"`
+	StaleMinutes     int       `json:"stale_minutes" gorm:"column:stale_minutes"`
+	Active           bool      `json:"active" gorm:"column:active"`
+	CreatedAt        time.Time `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt        time.Time `json:"updated_at" gorm:"column:updated_at"`
+}
+
+const (
+	WebhookDeliveryStatusPending   = "pending"
+	WebhookDeliveryStatusSucceeded = "succeeded"
+	WebhookDeliveryStatusFailed    = "failed"
+)
+
+// WebhookDeliveries 구독별 이벤트 전송 기록 (payload는 서명 대상 본문 그대로 보관)
+type WebhookDeliveries struct {
+	ID             uint64     `json:"id" gorm:"column:id;primaryKey"`
+	SubscriptionId uint       `json:"subscription_id" gorm:"column:subscription_id"`
+	ProjectId      string     `json:"project_id" gorm:"column:project_id"`
+	EventId        string     `json:"event_id" gorm:"column:event_id"`
+	EventType      string     `json:"event_type" gorm:"column:event_type"`
+	Payload        string     `json:"payload" gorm:"column:payload"`
+	Status         string     `json:"status" gorm:"column:status"`
+	Attempts       int        `json:"attempts" gorm:"column:attempts"`
+	ResponseStatus int        `json:"response_status" gorm:"column:response_status"`
+	ResponseBody   string     `json:"response_body" gorm:"column:response_body"`
+	ErrorMessage   string     `json:"error_message" gorm:"column:error_message"`
+	NextAttemptAt  *time.Time `json:"next_attempt_at" gorm:"column:next_attempt_at"`
+	DeliveredAt    *time.Time `json:"delivered_at" gorm:"column:delivered_at"`
+	CreatedAt      time.Time  `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt      time.Time  `json:"updated_at" gorm:"column:updated_at"`
+}
+
+// PublicApiKeys 파트너용 공개 API 키 (원래 키 대신 SHA-256 해시 저장, revoked_at이 있으면 폐기됨)
+type PublicApiKeys struct {
+	ID         uint       `json:"id" gorm:"column:id;primaryKey"`
+	ProjectId  string     `json:"project_id" gorm:"column:project_id"`
+	Name       string     `json:"name" gorm:"column:name"`
+	KeyPrefix  string     `json:"key_prefix" gorm:"column:key_prefix"`
+	KeyHash    string     `json:"-" gorm:"column:key_hash"`
+	LastUsedAt *time.Time `json:"last_used_at" gorm:"column:last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at" gorm:"column:revoked_at"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt  time.Time  `json:"updated_at" gorm:"column:updated_at"`
+}
+
 type ExperimentSessions struct {
 	gorm.Model
 	VarThreshold  float64 `json:"var_threshold" gorm:"column:var_threshold"`
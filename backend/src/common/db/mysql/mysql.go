@@ -38,7 +38,7 @@ func InitMySQL() error {
 	MysqlDB, err := sql.Open("mysql", connectionString)
 	if err != nil {
 		fmt.Println("Failed to connect to MySQL!")
-		fmt.Sprintln("에러 메시지 %s", err)
+		fmt.Printf("에러 메시지 %s\n", err)
 	}
 	fmt.Println("Connected to MySQL!")
 
@@ -49,7 +49,7 @@ func InitMySQL() error {
 	})
 	if err != nil {
 		fmt.Println("Failed to connect to Gorm MySQL!")
-		fmt.Sprintln("에러 메시지 %s", err)
+		fmt.Printf("에러 메시지 %s\n", err)
 	}
 
 	return nil
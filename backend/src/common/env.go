@@ -34,6 +34,22 @@ type Config struct {
 	UploadPath  string
 	MaxFileSize int64
 
+	// Learning Job Configuration
+	LearningWorkers   int
+	LearningQueueSize int
+
+	// Occupancy History Configuration
+	OccupancyRawRetentionDays    int // 원본 점 보관 일수 (지나면 시간 단위로 줄임)
+	OccupancyHourlyRetentionDays int // 시간 단위 이력 보관 일수 (0이면 삭제하지 않음)
+	OccupancyRetentionMinutes    int // 보관 정책 적용 주기 (0이면 적용하지 않음)
+
+	// Webhook Configuration
+	WebhookTimeoutSeconds int // 전송 한 번의 응답 대기 시간
+	WebhookMaxAttempts    int // 실패 시 재시도를 포함한 최대 전송 횟수
+
+	// Public API Configuration
+	PublicCacheMaxAgeSeconds int // 공개 가용 현황 응답의 Cache-Control max-age
+
 	// CORS Configuration
 	AllowedOrigins []string
 
@@ -70,6 +86,14 @@ func InitVarNames() []string {
 	result = append(result, "JWT_EXPIRE_HOURS")
 	result = append(result, "UPLOAD_PATH")
 	result = append(result, "MAX_FILE_SIZE")
+	result = append(result, "LEARNING_WORKERS")
+	result = append(result, "LEARNING_QUEUE_SIZE")
+	result = append(result, "OCCUPANCY_RAW_RETENTION_DAYS")
+	result = append(result, "OCCUPANCY_HOURLY_RETENTION_DAYS")
+	result = append(result, "OCCUPANCY_RETENTION_MINUTES")
+	result = append(result, "WEBHOOK_TIMEOUT_SECONDS")
+	result = append(result, "WEBHOOK_MAX_ATTEMPTS")
+	result = append(result, "PUBLIC_CACHE_MAX_AGE_SECONDS")
 	result = append(result, "ALLOWED_ORIGINS")
 	result = append(result, "LOG_LEVEL")
 	result = append(result, "LOG_FILE")
@@ -106,6 +130,22 @@ func LoadConfig() error {
 		UploadPath:  getEnv("UPLOAD_PATH", "../shared"),
 		MaxFileSize: getEnvAsInt64("MAX_FILE_SIZE", 10485760), // 10MB
 
+		// Learning Job Configuration
+		LearningWorkers:   getEnvAsInt("LEARNING_WORKERS", 1),
+		LearningQueueSize: getEnvAsInt("LEARNING_QUEUE_SIZE", 100),
+
+		// Occupancy History Configuration
+		OccupancyRawRetentionDays:    getEnvAsInt("OCCUPANCY_RAW_RETENTION_DAYS", 7),
+		OccupancyHourlyRetentionDays: getEnvAsInt("OCCUPANCY_HOURLY_RETENTION_DAYS", 365),
+		OccupancyRetentionMinutes:    getEnvAsInt("OCCUPANCY_RETENTION_MINUTES", 60),
+
+		// Webhook Configuration
+		WebhookTimeoutSeconds: getEnvAsInt("WEBHOOK_TIMEOUT_SECONDS", 10),
+		WebhookMaxAttempts:    getEnvAsInt("WEBHOOK_MAX_ATTEMPTS", 6),
+
+		// Public API Configuration
+		PublicCacheMaxAgeSeconds: getEnvAsInt("PUBLIC_CACHE_MAX_AGE_SECONDS", 30),
+
 		// CORS Configuration
 		AllowedOrigins: getEnvAsSlice("ALLOWED_ORIGINS", []string{"http://localhost:3000"}),
 
@@ -181,6 +221,10 @@ func (c *Config) Print() {
 	fmt.Printf("Debug: %t\n", c.Debug)
 	fmt.Printf("Upload Path: %s\n", c.UploadPath)
 	fmt.Printf("Max File Size: %d bytes\n", c.MaxFileSize)
+	fmt.Printf("Learning Workers: %d (queue %d)\n", c.LearningWorkers, c.LearningQueueSize)
+	fmt.Printf("Occupancy Retention: raw %d days, hourly %d days (every %d min)\n", c.OccupancyRawRetentionDays, c.OccupancyHourlyRetentionDays, c.OccupancyRetentionMinutes)
+	fmt.Printf("Webhook: timeout %ds, max attempts %d\n", c.WebhookTimeoutSeconds, c.WebhookMaxAttempts)
+	fmt.Printf("Public API: cache max-age %ds\n", c.PublicCacheMaxAgeSeconds)
 	fmt.Printf("Allowed Origins: %v\n", c.AllowedOrigins)
 	fmt.Printf("===================\n")
 }
@@ -49,6 +49,13 @@ const (
 	ErrInternalServer = ErrType("INTERNAL_SERVER")
 	ErrInternalDB     = ErrType("INTERNAL_DB")
 	ErrPartner        = ErrType("PARTNER")
+	ErrAlreadyExists  = ErrType("ALREADY_EXISTS")
+	ErrQueueFull      = ErrType("QUEUE_FULL")
+	ErrConflict       = ErrType("CONFLICT")
+	// ErrPreconditionRequired If-Match 등 조건부 요청 헤더 누락
+	ErrPreconditionRequired = ErrType("PRECONDITION_REQUIRED")
+	// ErrInvalidAPIKey 공개 API 키 누락, 폐기 또는 다른 프로젝트의 키
+	ErrInvalidAPIKey = ErrType("INVALID_API_KEY")
 )
 
 // game error
@@ -109,6 +116,7 @@ var ErrHttpCode = map[string]int{
 	"TOKEN_BAD":            http.StatusUnauthorized,
 	"INVALID_ACCESS_TOKEN": http.StatusUnauthorized,
 	"INVALID_AUTH_CODE":    http.StatusUnauthorized,
+	"INVALID_API_KEY":      http.StatusUnauthorized,
 
 	//403
 	"PARTNER": http.StatusForbidden,
@@ -116,10 +124,20 @@ var ErrHttpCode = map[string]int{
 	//404
 	"NOT_FOUND": http.StatusNotFound,
 
+	//409
+	"ALREADY_EXISTS": http.StatusConflict,
+	"CONFLICT":       http.StatusConflict,
+
+	//428
+	"PRECONDITION_REQUIRED": http.StatusPreconditionRequired,
+
 	//500
 	"INTERNAL_SERVER":            http.StatusInternalServerError,
 	"INTERNAL_DB":                http.StatusInternalServerError,
 	"PLAYER_STATE_CHANGE_FAILED": http.StatusInternalServerError,
+
+	//503
+	"QUEUE_FULL": http.StatusServiceUnavailable,
 }
 
 func ErrorParsing(data string) Err {
@@ -139,6 +157,11 @@ func ErrorMsg(ctx context.Context, errType ErrType, trace string, msg string, fr
 	return fmt.Errorf("%s|%s|%s|%s", errType, trace, msg, from)
 }
 
+// IsErrType ErrorMsg로 만든 에러의 타입이 errType인지
+func IsErrType(err error, errType ErrType) bool {
+	return err != nil && strings.HasPrefix(err.Error(), string(errType)+"|")
+}
+
 func (e ErrType) New(errType string, msg string) *ResError {
 	return &ResError{ErrType: errType, Msg: msg}
 }
@@ -3,21 +3,27 @@ package common
 import (
 	"fmt"
 	"main/common/db/mysql"
+	"main/common/jobqueue"
 )
 
 func InitServer() error {
 	if err := InitEnv(); err != nil {
-		fmt.Sprintf("서버 에러 발생 : %s", err.Error())
+		fmt.Printf("서버 에러 발생 : %s\n", err.Error())
 		return err
 	}
 
 	if err := InitJwt(); err != nil {
-		fmt.Sprintf("jwt 초기화 에러 : %s", err.Error())
+		fmt.Printf("jwt 초기화 에러 : %s\n", err.Error())
 		return err
 	}
 
 	if err := mysql.InitMySQL(); err != nil {
-		fmt.Sprintf("db 초기화 에러 : %s", err.Error())
+		fmt.Printf("db 초기화 에러 : %s\n", err.Error())
+		return err
+	}
+
+	if err := jobqueue.InitJobQueue(Env.LearningWorkers, Env.LearningQueueSize); err != nil {
+		fmt.Printf("작업 대기열 초기화 에러 : %s\n", err.Error())
 		return err
 	}
 
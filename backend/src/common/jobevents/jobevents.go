@@ -0,0 +1,183 @@
+package jobevents
+
+import (
+	"sync"
+	"time"
+)
+
+// Event 작업 진행 이벤트 (ID는 스트림 안에서 1부터 증가)
+type Event struct {
+	ID   int         `json:"id"`
+	Type string      `json:"type"`
+	Data interface{} `json:"data"`
+	Time time.Time   `json:"time"`
+}
+
+// StatusEvent 작업 상태 변경 이벤트 종류 (Data는 JobState)
+const StatusEvent = "status"
+
+// JobState 작업 상태 변경 이벤트 데이터
+type JobState struct {
+	Status       string `json:"status"`
+	ResultFolder string `json:"result_folder,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// Subscription 지금까지의 이벤트(Replay)와 이후 이벤트 채널
+// Events는 스트림이 끝나거나 구독자가 너무 느려 끊기면 닫힘
+type Subscription struct {
+	Replay      []Event
+	Events      <-chan Event
+	Unsubscribe func()
+}
+
+type stream struct {
+	events []Event
+	nextID int
+	subs   map[chan Event]struct{}
+	closed bool
+}
+
+// Broker 작업별 이벤트 스트림 보관 및 구독자 전달
+type Broker struct {
+	mu        sync.Mutex
+	streams   map[string]*stream
+	maxEvents int
+	retention time.Duration
+}
+
+// LearningEvents 학습 작업 진행 이벤트
+var LearningEvents = NewBroker(2000, 10*time.Minute)
+
+// OccupancyEvents 프로젝트별 실시간 점유 변경 이벤트 (스트림은 닫지 않고 최근 이벤트만 보관)
+var OccupancyEvents = NewBroker(500, 0)
+
+// 구독자 채널 버퍼 (가득 차면 해당 구독자는 끊고 재접속 시 replay로 복구)
+const subscriberBuffer = 64
+
+// NewBroker maxEvents: 스트림별 보관 이벤트 수, retention: 종료된 스트림 보관 시간
+func NewBroker(maxEvents int, retention time.Duration) *Broker {
+	return &Broker{
+		streams:   make(map[string]*stream),
+		maxEvents: maxEvents,
+		retention: retention,
+	}
+}
+
+// Open 스트림 생성 (같은 키가 있으면 새로 시작)
+func (b *Broker) Open(key string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if old, ok := b.streams[key]; ok {
+		closeSubscribers(old)
+	}
+	b.streams[key] = &stream{nextID: 1, subs: make(map[chan Event]struct{})}
+}
+
+// Ensure 스트림이 없으면 생성 (있으면 이벤트와 구독자를 그대로 유지)
+func (b *Broker) Ensure(key string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.streams[key]; !ok {
+		b.streams[key] = &stream{nextID: 1, subs: make(map[chan Event]struct{})}
+	}
+}
+
+// Publish 이벤트 추가 후 구독자에게 전달 (열려 있지 않은 스트림은 무시)
+func (b *Broker) Publish(key string, eventType string, data interface{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	s, ok := b.streams[key]
+	if !ok || s.closed {
+		return
+	}
+	event := Event{ID: s.nextID, Type: eventType, Data: data, Time: time.Now()}
+	s.nextID++
+
+	s.events = append(s.events, event)
+	if len(s.events) > b.maxEvents {
+		s.events = s.events[len(s.events)-b.maxEvents:]
+	}
+
+	for ch := range s.subs {
+		select {
+		case ch <- event:
+		default:
+			delete(s.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+// Close 스트림 종료 (구독자 채널을 닫고 retention 이후 삭제)
+func (b *Broker) Close(key string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	s, ok := b.streams[key]
+	if !ok || s.closed {
+		return
+	}
+	s.closed = true
+	closeSubscribers(s)
+
+	time.AfterFunc(b.retention, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if b.streams[key] == s {
+			delete(b.streams, key)
+		}
+	})
+}
+
+// Finish 마지막 상태 이벤트를 보내고 스트림 종료
+func (b *Broker) Finish(key string, state JobState) {
+	b.Publish(key, StatusEvent, state)
+	b.Close(key)
+}
+
+// Subscribe afterID 이후의 이벤트를 replay로 받고 이후 이벤트를 구독
+// 스트림이 없으면 ok=false
+func (b *Broker) Subscribe(key string, afterID int) (Subscription, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	s, ok := b.streams[key]
+	if !ok {
+		return Subscription{}, false
+	}
+
+	replay := []Event{}
+	for _, event := range s.events {
+		if event.ID > afterID {
+			replay = append(replay, event)
+		}
+	}
+
+	ch := make(chan Event, subscriberBuffer)
+	if s.closed {
+		close(ch)
+		return Subscription{Replay: replay, Events: ch, Unsubscribe: func() {}}, true
+	}
+	s.subs[ch] = struct{}{}
+
+	unsubscribe := func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := s.subs[ch]; ok {
+			delete(s.subs, ch)
+			close(ch)
+		}
+	}
+	return Subscription{Replay: replay, Events: ch, Unsubscribe: unsubscribe}, true
+}
+
+func closeSubscribers(s *stream) {
+	for ch := range s.subs {
+		delete(s.subs, ch)
+		close(ch)
+	}
+}
@@ -0,0 +1,147 @@
+package jobqueue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+)
+
+// ErrQueueFull 대기열이 가득 차서 작업을 받을 수 없음
+var ErrQueueFull = errors.New("작업 대기열이 가득 찼습니다")
+
+// Task 워커에서 실행할 작업 (ctx는 Cancel 호출 시 취소됨)
+type Task func(ctx context.Context)
+
+// CancelResult Cancel 호출 결과
+type CancelResult int
+
+const (
+	// CancelNotFound 대기열/실행 중인 작업에 없음
+	CancelNotFound CancelResult = iota
+	// CancelDequeued 아직 시작하지 않은 작업이라 실행하지 않고 제거
+	CancelDequeued
+	// CancelSignalled 실행 중인 작업의 ctx를 취소 (종료 처리는 작업 쪽에서 진행)
+	CancelSignalled
+)
+
+type queuedTask struct {
+	id   string
+	task Task
+}
+
+type entry struct {
+	cancel    context.CancelFunc
+	cancelled bool
+}
+
+// Queue 고정된 개수의 워커가 대기열의 작업을 순서대로 실행
+type Queue struct {
+	tasks chan queuedTask
+
+	mu      sync.Mutex
+	entries map[string]*entry
+}
+
+// LearningQueue 학습(OpenCV) 작업 대기열
+var LearningQueue *Queue
+
+func InitJobQueue(workers int, size int) error {
+	if workers < 1 {
+		return fmt.Errorf("워커 수는 1 이상이어야 합니다: %d", workers)
+	}
+	if size < 1 {
+		return fmt.Errorf("대기열 크기는 1 이상이어야 합니다: %d", size)
+	}
+	LearningQueue = NewQueue(workers, size)
+	return nil
+}
+
+// NewQueue 워커를 띄우고 대기열 생성
+func NewQueue(workers int, size int) *Queue {
+	q := &Queue{
+		tasks:   make(chan queuedTask, size),
+		entries: make(map[string]*entry),
+	}
+	for i := 0; i < workers; i++ {
+		go q.work()
+	}
+	return q
+}
+
+// Submit 작업을 대기열에 추가 (가득 차 있으면 ErrQueueFull)
+func (q *Queue) Submit(id string, task Task) error {
+	q.mu.Lock()
+	if _, ok := q.entries[id]; ok {
+		q.mu.Unlock()
+		return fmt.Errorf("이미 등록된 작업입니다: %s", id)
+	}
+	q.entries[id] = &entry{}
+	q.mu.Unlock()
+
+	select {
+	case q.tasks <- queuedTask{id: id, task: task}:
+		return nil
+	default:
+		q.remove(id)
+		return ErrQueueFull
+	}
+}
+
+// Free 대기열에 더 넣을 수 있는 작업 수 (동시에 Submit하면 달라질 수 있음)
+func (q *Queue) Free() int {
+	return cap(q.tasks) - len(q.tasks)
+}
+
+// Cancel 대기 중인 작업은 건너뛰도록 표시하고, 실행 중인 작업은 ctx 취소
+func (q *Queue) Cancel(id string) CancelResult {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	e, ok := q.entries[id]
+	if !ok || e.cancelled {
+		return CancelNotFound
+	}
+	if e.cancel == nil {
+		e.cancelled = true
+		return CancelDequeued
+	}
+	e.cancel()
+	return CancelSignalled
+}
+
+func (q *Queue) work() {
+	for t := range q.tasks {
+		q.run(t)
+	}
+}
+
+// run 작업 하나 실행 (panic이 나도 워커는 유지)
+func (q *Queue) run(t queuedTask) {
+	q.mu.Lock()
+	e, ok := q.entries[t.id]
+	if !ok || e.cancelled {
+		delete(q.entries, t.id)
+		q.mu.Unlock()
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	e.cancel = cancel
+	q.mu.Unlock()
+
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Printf("작업 실행 중 panic 발생 (job %s): %v\n%s\n", t.id, r, debug.Stack())
+		}
+		cancel()
+		q.remove(t.id)
+	}()
+	t.task(ctx)
+}
+
+func (q *Queue) remove(id string) {
+	q.mu.Lock()
+	delete(q.entries, id)
+	q.mu.Unlock()
+}
@@ -0,0 +1,54 @@
+package occupancy
+
+import "main/common/db/mysql"
+
+// DefaultThreshold 프로젝트 기준이 없을 때 쓰는 점유 판정 기준
+// (ROI 전경 비율이 이 값 이상이면 차량 있음, OpenCV 결과 이미지 색상 기준과 동일)
+const DefaultThreshold = 0.4
+
+// Thresholds 프로젝트의 점유 판정 기준 (ROI > CCTV > 프로젝트 순으로 적용)
+type Thresholds struct {
+	Project float64
+	Cctv    map[string]float64
+	Roi     map[string]map[int]float64
+}
+
+// NewThresholds DB에 저장된 기준으로 생성 (프로젝트 기준이 없으면 DefaultThreshold)
+func NewThresholds(rows []mysql.OccupancyThresholds) Thresholds {
+	t := Thresholds{
+		Project: DefaultThreshold,
+		Cctv:    make(map[string]float64),
+		Roi:     make(map[string]map[int]float64),
+	}
+	for _, row := range rows {
+		switch row.Scope {
+		case mysql.ThresholdScopeProject:
+			t.Project = row.Threshold
+		case mysql.ThresholdScopeCctv:
+			t.Cctv[row.CctvId] = row.Threshold
+		case mysql.ThresholdScopeRoi:
+			if t.Roi[row.CctvId] == nil {
+				t.Roi[row.CctvId] = make(map[int]float64)
+			}
+			t.Roi[row.CctvId][row.RoiId] = row.Threshold
+		}
+	}
+	return t
+}
+
+// For CCTV/ROI에 적용할 기준
+func (t Thresholds) For(cctvID string, roiID int) float64 {
+	if threshold, ok := t.Roi[cctvID][roiID]; ok {
+		return threshold
+	}
+	if threshold, ok := t.Cctv[cctvID]; ok {
+		return threshold
+	}
+	return t.Project
+}
+
+// Occupied 전경 비율로 점유 여부 판정 (적용한 기준도 함께 반환)
+func (t Thresholds) Occupied(cctvID string, roiID int, rate float64) (bool, float64) {
+	threshold := t.For(cctvID, roiID)
+	return rate >= threshold, threshold
+}
@@ -0,0 +1,20 @@
+//go:build !windows
+
+package common
+
+import (
+	"os/exec"
+	"syscall"
+	"time"
+)
+
+// BindProcessGroup context 취소 시 자식 프로세스가 만든 하위 프로세스까지 모두 종료되도록 설정
+// (exec.CommandContext로 만든 cmd에만 사용)
+func BindProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		// 음수 pid는 프로세스 그룹 전체를 의미
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+	cmd.WaitDelay = 5 * time.Second
+}
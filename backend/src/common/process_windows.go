@@ -0,0 +1,13 @@
+//go:build windows
+
+package common
+
+import (
+	"os/exec"
+	"time"
+)
+
+// BindProcessGroup windows는 프로세스 그룹 종료를 지원하지 않으므로 기본 종료(Kill)만 사용
+func BindProcessGroup(cmd *exec.Cmd) {
+	cmd.WaitDelay = 5 * time.Second
+}
@@ -0,0 +1,69 @@
+package roidoc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// fileLocks 파일 경로별 잠금 (같은 프로세스 안에서 같은 파일 수정을 한 번에 하나씩)
+var fileLocks sync.Map
+
+// Lock 파일 경로 잠금 (반환된 함수로 해제)
+func Lock(path string) func() {
+	mu, _ := fileLocks.LoadOrStore(filepath.Clean(path), &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	return mu.(*sync.Mutex).Unlock
+}
+
+// ETag 파일 내용의 버전 (내용이 같으면 같은 값, 따옴표 포함)
+func ETag(data []byte) string {
+	sum := sha256.Sum256(data)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// MatchETag If-Match 헤더 값이 현재 ETag와 맞는지 ("*"는 항상 일치)
+func MatchETag(ifMatch string, etag string) bool {
+	return ifMatch == "*" || ifMatch == etag || ifMatch == "W/"+etag
+}
+
+// ReadFile 파일을 읽어 검증한 문서와 ETag 반환
+func ReadFile(path string) (*Document, string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", err
+	}
+	doc, err := Parse(data)
+	if err != nil {
+		return nil, "", err
+	}
+	return doc, ETag(data), nil
+}
+
+// WriteFileAtomic 같은 폴더의 임시 파일에 쓰고 rename으로 교체 (쓰는 도중 종료돼도 기존 파일 유지)
+func WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath)
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmpPath, perm); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
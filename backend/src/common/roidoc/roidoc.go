@@ -0,0 +1,243 @@
+package roidoc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ROI 파일 구조
+//
+//	{
+//	    "<IP 키>": {
+//	        "cctv_id": "...",
+//	        "matches": [
+//	            {"parking_id": "..._1", "original_roi": [x1, y1, x2, y2, ...], "img_center_roi": [...]}
+//	        ]
+//	    }
+//	}
+//
+// 모르는 필드는 그대로 보관했다가 저장할 때 다시 씀
+
+// Document ROI 파일 전체 (IP 키 순으로 정렬된 카메라 목록)
+type Document struct {
+	Cameras []*Camera
+}
+
+// Camera IP 키 하나에 해당하는 CCTV
+type Camera struct {
+	Key     string
+	CctvID  string
+	Matches []*Match
+
+	extra map[string]json.RawMessage
+}
+
+// Match 주차면 하나 (좌표 필드는 파일에 없으면 nil, 삭제된 ROI는 빈 배열)
+type Match struct {
+	ParkingID    ParkingID
+	OriginalRoi  []float64
+	ImgCenterRoi []float64
+	Roi          []float64
+
+	extra map[string]json.RawMessage
+}
+
+// ParkingID 주차면 ID (파일에 숫자로 적혀 있으면 저장할 때도 숫자로 씀)
+type ParkingID struct {
+	Value   string
+	numeric bool
+}
+
+// NewParkingID 문자열 주차면 ID
+func NewParkingID(value string) ParkingID {
+	return ParkingID{Value: value}
+}
+
+func (p ParkingID) String() string {
+	return p.Value
+}
+
+// Number OpenCV 결과의 ROI 번호
+func (p ParkingID) Number() (int, bool) {
+	return ParkingNumber(p.Value)
+}
+
+// ParkingNumber 주차면 ID 문자열을 OpenCV 결과의 ROI 번호로 변환
+// OpenCV와 같은 규칙: "_"가 있으면 뒤쪽 숫자, 없으면 전체를 숫자로 사용
+func ParkingNumber(parkingID string) (int, bool) {
+	parkingID = strings.TrimSpace(parkingID)
+	if _, after, found := strings.Cut(parkingID, "_"); found && after != "" {
+		parkingID = after
+	}
+	// stoi처럼 앞쪽 숫자만 사용 ("3_a" -> 3)
+	end := 0
+	for end < len(parkingID) && parkingID[end] >= '0' && parkingID[end] <= '9' {
+		end++
+	}
+	id, err := strconv.Atoi(parkingID[:end])
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
+// Coords OpenCV와 같은 우선순위로 좌표 선택 (original_roi > img_center_roi > roi)
+func (m *Match) Coords() []float64 {
+	switch {
+	case m.OriginalRoi != nil:
+		return m.OriginalRoi
+	case m.ImgCenterRoi != nil:
+		return m.ImgCenterRoi
+	default:
+		return m.Roi
+	}
+}
+
+// SetCoords 좌표 변경 (편집 화면과 OpenCV가 같은 값을 보도록 original_roi, img_center_roi 모두 변경)
+func (m *Match) SetCoords(coords []float64) {
+	m.OriginalRoi = append([]float64{}, coords...)
+	m.ImgCenterRoi = append([]float64{}, coords...)
+	if m.Roi != nil {
+		m.Roi = append([]float64{}, coords...)
+	}
+}
+
+// SetRoiID OpenCV가 parking_id 대신 사용할 ROI 번호 기록 (roi_id 필드)
+func (m *Match) SetRoiID(roiID int) {
+	if m.extra == nil {
+		m.extra = map[string]json.RawMessage{}
+	}
+	m.extra["roi_id"] = json.RawMessage(strconv.Itoa(roiID))
+}
+
+// Camera cctv_id로 카메라 조회
+func (d *Document) Camera(cctvID string) *Camera {
+	for _, camera := range d.Cameras {
+		if camera.CctvID == cctvID {
+			return camera
+		}
+	}
+	return nil
+}
+
+// Match parking_id로 주차면 조회
+func (c *Camera) Match(parkingID string) *Match {
+	for _, match := range c.Matches {
+		if match.ParkingID.Value == parkingID {
+			return match
+		}
+	}
+	return nil
+}
+
+// AddMatch 주차면 추가
+func (c *Camera) AddMatch(parkingID string, coords []float64) *Match {
+	match := &Match{ParkingID: NewParkingID(parkingID)}
+	match.SetCoords(coords)
+	c.Matches = append(c.Matches, match)
+	return match
+}
+
+// Load 파일을 읽어 검증까지 마친 문서 반환
+func Load(path string) (*Document, error) {
+	doc, _, err := ReadFile(path)
+	return doc, err
+}
+
+// Parse JSON을 문서로 변환 (구조가 잘못된 곳은 모두 모아 ValidationError로 반환)
+func Parse(data []byte) (*Document, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("JSON 파싱 실패: %v", err)
+	}
+	if raw == nil {
+		return nil, &ValidationError{Issues: []Issue{{Message: "최상위 값은 객체여야 합니다"}}}
+	}
+
+	keys := make([]string, 0, len(raw))
+	for key := range raw {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	v := &validator{}
+	doc := &Document{Cameras: make([]*Camera, 0, len(keys))}
+	for _, key := range keys {
+		if camera := v.camera(key, raw[key]); camera != nil {
+			doc.Cameras = append(doc.Cameras, camera)
+		}
+	}
+	v.document(doc)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// Marshal 문서를 JSON으로 변환 (기존 파일과 같은 4칸 들여쓰기, 키 정렬)
+func Marshal(doc *Document) ([]byte, error) {
+	out := make(map[string]interface{}, len(doc.Cameras))
+	for _, camera := range doc.Cameras {
+		out[camera.Key] = camera.fields()
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(out); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
+
+// Save 문서를 파일로 저장 (임시 파일에 쓴 뒤 교체, 저장한 내용의 ETag 반환)
+func Save(path string, doc *Document) (string, error) {
+	data, err := Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	if err := WriteFileAtomic(path, data, 0644); err != nil {
+		return "", err
+	}
+	return ETag(data), nil
+}
+
+func (c *Camera) fields() map[string]interface{} {
+	fields := make(map[string]interface{}, len(c.extra)+2)
+	for key, value := range c.extra {
+		fields[key] = value
+	}
+	fields["cctv_id"] = c.CctvID
+	matches := make([]map[string]interface{}, 0, len(c.Matches))
+	for _, match := range c.Matches {
+		matches = append(matches, match.fields())
+	}
+	fields["matches"] = matches
+	return fields
+}
+
+func (m *Match) fields() map[string]interface{} {
+	fields := make(map[string]interface{}, len(m.extra)+4)
+	for key, value := range m.extra {
+		fields[key] = value
+	}
+	if m.ParkingID.numeric {
+		fields["parking_id"] = json.Number(m.ParkingID.Value)
+	} else {
+		fields["parking_id"] = m.ParkingID.Value
+	}
+	for key, coords := range map[string][]float64{
+		"original_roi":   m.OriginalRoi,
+		"img_center_roi": m.ImgCenterRoi,
+		"roi":            m.Roi,
+	} {
+		if coords != nil {
+			fields[key] = coords
+		}
+	}
+	return fields
+}
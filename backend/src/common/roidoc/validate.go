@@ -0,0 +1,197 @@
+package roidoc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// coordFields 좌표를 담는 필드 (OpenCV가 읽는 우선순위 순)
+var coordFields = []string{"original_roi", "img_center_roi", "roi"}
+
+// Issue 검증 실패 위치와 사유
+type Issue struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+func (i Issue) String() string {
+	if i.Path == "" {
+		return i.Message
+	}
+	return i.Path + ": " + i.Message
+}
+
+// ValidationError ROI 파일 구조 오류 목록
+type ValidationError struct {
+	Issues []Issue
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Issues))
+	for _, issue := range e.Issues {
+		messages = append(messages, issue.String())
+	}
+	return "ROI 파일 형식 오류: " + strings.Join(messages, "; ")
+}
+
+type validator struct {
+	issues []Issue
+}
+
+func (v *validator) add(path string, format string, args ...interface{}) {
+	v.issues = append(v.issues, Issue{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) err() error {
+	if len(v.issues) == 0 {
+		return nil
+	}
+	return &ValidationError{Issues: v.issues}
+}
+
+// object JSON 객체를 필드별로 분리 (객체가 아니면 nil)
+func object(raw json.RawMessage) map[string]json.RawMessage {
+	if !bytes.HasPrefix(bytes.TrimSpace(raw), []byte("{")) {
+		return nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil
+	}
+	return fields
+}
+
+func (v *validator) camera(key string, raw json.RawMessage) *Camera {
+	path := fmt.Sprintf("[%q]", key)
+	fields := object(raw)
+	if fields == nil {
+		v.add(path, "카메라 정보는 객체여야 합니다")
+		return nil
+	}
+
+	camera := &Camera{Key: key}
+	if value, ok := fields["cctv_id"]; !ok {
+		v.add(path, "cctv_id가 없습니다")
+	} else if err := json.Unmarshal(value, &camera.CctvID); err != nil {
+		v.add(path+".cctv_id", "문자열이어야 합니다")
+	} else if strings.TrimSpace(camera.CctvID) == "" {
+		v.add(path+".cctv_id", "비어 있습니다")
+	}
+	delete(fields, "cctv_id")
+
+	var matches []json.RawMessage
+	if value, ok := fields["matches"]; !ok {
+		v.add(path, "matches가 없습니다")
+	} else if err := json.Unmarshal(value, &matches); err != nil || matches == nil {
+		v.add(path+".matches", "배열이어야 합니다")
+	}
+	delete(fields, "matches")
+
+	parkingIDs := make(map[string]bool)
+	for i, value := range matches {
+		matchPath := fmt.Sprintf("%s.matches[%d]", path, i)
+		match := v.match(matchPath, value)
+		if match == nil {
+			continue
+		}
+		if id := match.ParkingID.Value; id != "" {
+			if parkingIDs[id] {
+				v.add(matchPath+".parking_id", "parking_id %q가 중복됩니다", id)
+			}
+			parkingIDs[id] = true
+		}
+		camera.Matches = append(camera.Matches, match)
+	}
+	camera.extra = fields
+	return camera
+}
+
+func (v *validator) match(path string, raw json.RawMessage) *Match {
+	fields := object(raw)
+	if fields == nil {
+		v.add(path, "주차면 정보는 객체여야 합니다")
+		return nil
+	}
+
+	match := &Match{}
+	if value, ok := fields["parking_id"]; !ok {
+		v.add(path, "parking_id가 없습니다")
+	} else {
+		var number json.Number
+		if err := json.Unmarshal(value, &match.ParkingID.Value); err == nil {
+			if strings.TrimSpace(match.ParkingID.Value) == "" {
+				v.add(path+".parking_id", "비어 있습니다")
+			}
+		} else if err := json.Unmarshal(value, &number); err == nil {
+			match.ParkingID = ParkingID{Value: number.String(), numeric: true}
+		} else {
+			v.add(path+".parking_id", "문자열 또는 숫자여야 합니다")
+		}
+	}
+	delete(fields, "parking_id")
+
+	found := false
+	for _, field := range coordFields {
+		value, ok := fields[field]
+		if !ok {
+			continue
+		}
+		found = true
+		coords := v.coords(path+"."+field, value)
+		switch field {
+		case "original_roi":
+			match.OriginalRoi = coords
+		case "img_center_roi":
+			match.ImgCenterRoi = coords
+		case "roi":
+			match.Roi = coords
+		}
+		delete(fields, field)
+	}
+	if !found {
+		v.add(path, "좌표 필드(%s)가 없습니다", strings.Join(coordFields, ", "))
+	}
+	match.extra = fields
+	return match
+}
+
+// coords [x1, y1, x2, y2, ...] 형식의 좌표 배열 검증
+func (v *validator) coords(path string, raw json.RawMessage) []float64 {
+	var values []json.RawMessage
+	if err := json.Unmarshal(raw, &values); err != nil || values == nil {
+		v.add(path, "좌표 배열이어야 합니다")
+		return []float64{}
+	}
+	coords := make([]float64, 0, len(values))
+	valid := true
+	for i, value := range values {
+		var n float64
+		if err := json.Unmarshal(value, &n); err != nil {
+			v.add(fmt.Sprintf("%s[%d]", path, i), "숫자여야 합니다")
+			valid = false
+			continue
+		}
+		coords = append(coords, n)
+	}
+	if valid && len(coords)%2 != 0 {
+		v.add(path, "좌표 개수가 홀수입니다 (%d개, x/y 쌍이어야 함)", len(coords))
+	}
+	return coords
+}
+
+// document 카메라 사이에 cctv_id가 겹치는지 검사 (겹치면 어느 카메라를 수정할지 알 수 없음)
+func (v *validator) document(doc *Document) {
+	cctvKeys := make(map[string]string)
+	for _, camera := range doc.Cameras {
+		if camera.CctvID == "" {
+			continue
+		}
+		if other, ok := cctvKeys[camera.CctvID]; ok {
+			v.add(fmt.Sprintf("[%q].cctv_id", camera.Key), "cctv_id %q가 [%q]와 중복됩니다", camera.CctvID, other)
+			continue
+		}
+		cctvKeys[camera.CctvID] = camera.Key
+	}
+}
@@ -0,0 +1,180 @@
+package roiformat
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"main/common/roidoc"
+	"strconv"
+	"strings"
+)
+
+// CVAT for images 1.1 (필요한 요소만)
+type cvatAnnotations struct {
+	XMLName xml.Name    `xml:"annotations"`
+	Version string      `xml:"version"`
+	Meta    *cvatMeta   `xml:"meta,omitempty"`
+	Images  []cvatImage `xml:"image"`
+}
+
+type cvatMeta struct {
+	Task cvatTask `xml:"task"`
+}
+
+type cvatTask struct {
+	Name   string      `xml:"name"`
+	Size   int         `xml:"size"`
+	Labels []cvatLabel `xml:"labels>label"`
+}
+
+type cvatLabel struct {
+	Name       string              `xml:"name"`
+	Type       string              `xml:"type"`
+	Attributes []cvatAttributeSpec `xml:"attributes>attribute"`
+}
+
+type cvatAttributeSpec struct {
+	Name      string `xml:"name"`
+	Mutable   string `xml:"mutable"`
+	InputType string `xml:"input_type"`
+}
+
+type cvatImage struct {
+	ID       int           `xml:"id,attr"`
+	Name     string        `xml:"name,attr"`
+	Width    int           `xml:"width,attr"`
+	Height   int           `xml:"height,attr"`
+	Polygons []cvatPolygon `xml:"polygon"`
+}
+
+type cvatPolygon struct {
+	Label      string          `xml:"label,attr"`
+	Source     string          `xml:"source,attr,omitempty"`
+	Occluded   int             `xml:"occluded,attr"`
+	Points     string          `xml:"points,attr"`
+	ZOrder     int             `xml:"z_order,attr"`
+	Attributes []cvatAttribute `xml:"attribute"`
+}
+
+type cvatAttribute struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:",chardata"`
+}
+
+func (p cvatPolygon) attribute(name string) string {
+	for _, attr := range p.Attributes {
+		if attr.Name == name {
+			return strings.TrimSpace(attr.Value)
+		}
+	}
+	return ""
+}
+
+func exportCVAT(doc *roidoc.Document, name string, images map[string]Image) (File, error) {
+	annotations := cvatAnnotations{
+		Version: "1.1",
+		Meta: &cvatMeta{Task: cvatTask{
+			Name: name,
+			Size: len(doc.Cameras),
+			Labels: []cvatLabel{{
+				Name: parkingLabel,
+				Type: "polygon",
+				Attributes: []cvatAttributeSpec{
+					{Name: "parking_id", Mutable: "False", InputType: "text"},
+					{Name: "cctv_id", Mutable: "False", InputType: "text"},
+					{Name: "camera_key", Mutable: "False", InputType: "text"},
+				},
+			}},
+		}},
+		Images: make([]cvatImage, 0, len(doc.Cameras)),
+	}
+	for i, camera := range doc.Cameras {
+		image := imageOf(camera.CctvID, images)
+		item := cvatImage{ID: i, Name: image.Name, Width: image.Width, Height: image.Height}
+		for _, shape := range shapesOf(camera) {
+			item.Polygons = append(item.Polygons, cvatPolygon{
+				Label:  parkingLabel,
+				Source: "manual",
+				Points: cvatPoints(shape.Coords),
+				Attributes: []cvatAttribute{
+					{Name: "parking_id", Value: shape.ParkingID},
+					{Name: "cctv_id", Value: shape.CctvID},
+					{Name: "camera_key", Value: shape.CameraKey},
+				},
+			})
+		}
+		annotations.Images = append(annotations.Images, item)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(annotations); err != nil {
+		return File{}, err
+	}
+	buf.WriteString("\n")
+	return File{Name: name + "_cvat.xml", ContentType: "application/xml", Data: buf.Bytes()}, nil
+}
+
+// importCVAT image마다 polygon을 주차면으로 (parking_id 속성이 없으면 "parking"이 아닌 라벨을 parking_id로 사용)
+func importCVAT(data []byte) ([]roiShape, error) {
+	var annotations cvatAnnotations
+	if err := xml.Unmarshal(data, &annotations); err != nil {
+		return nil, fmt.Errorf("CVAT XML 파싱 실패: %v", err)
+	}
+
+	var shapes []roiShape
+	for _, image := range annotations.Images {
+		for i, polygon := range image.Polygons {
+			coords, err := parseCVATPoints(polygon.Points)
+			if err != nil {
+				return nil, fmt.Errorf("%s의 %d번째 polygon: %v", image.Name, i+1, err)
+			}
+			shape := roiShape{
+				CameraKey: polygon.attribute("camera_key"),
+				CctvID:    polygon.attribute("cctv_id"),
+				ParkingID: polygon.attribute("parking_id"),
+				Coords:    coords,
+			}
+			if shape.CctvID == "" {
+				shape.CctvID = cctvIDFromImage(image.Name)
+			}
+			if shape.ParkingID == "" && polygon.Label != parkingLabel {
+				shape.ParkingID = strings.TrimSpace(polygon.Label)
+			}
+			shapes = append(shapes, shape)
+		}
+	}
+	return shapes, nil
+}
+
+// cvatPoints [x1, y1, x2, y2, ...] -> "x1,y1;x2,y2"
+func cvatPoints(coords []float64) string {
+	pairs := make([]string, 0, len(coords)/2)
+	for i := 0; i+1 < len(coords); i += 2 {
+		pairs = append(pairs, formatNumber(coords[i])+","+formatNumber(coords[i+1]))
+	}
+	return strings.Join(pairs, ";")
+}
+
+// parseCVATPoints "x1,y1;x2,y2" -> [x1, y1, x2, y2]
+func parseCVATPoints(points string) ([]float64, error) {
+	var coords []float64
+	for _, pair := range strings.Split(strings.TrimSpace(points), ";") {
+		x, y, ok := strings.Cut(strings.TrimSpace(pair), ",")
+		if !ok {
+			return nil, fmt.Errorf("좌표 형식이 잘못되었습니다: %q", pair)
+		}
+		xv, err := strconv.ParseFloat(strings.TrimSpace(x), 64)
+		if err != nil {
+			return nil, fmt.Errorf("좌표가 숫자가 아닙니다: %q", pair)
+		}
+		yv, err := strconv.ParseFloat(strings.TrimSpace(y), 64)
+		if err != nil {
+			return nil, fmt.Errorf("좌표가 숫자가 아닙니다: %q", pair)
+		}
+		coords = append(coords, roundCoord(xv), roundCoord(yv))
+	}
+	return coords, nil
+}
@@ -0,0 +1,121 @@
+package roiformat
+
+import (
+	"encoding/json"
+	"fmt"
+	"main/common/roidoc"
+	"strings"
+)
+
+// GeoJSON은 지리 좌표가 아닌 이미지 픽셀 좌표 (x 오른쪽, y 아래쪽)
+type geoJSONCollection struct {
+	Type       string                 `json:"type"`
+	Name       string                 `json:"name,omitempty"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+	Features   []geoJSONFeature       `json:"features"`
+}
+
+type geoJSONFeature struct {
+	Type       string                 `json:"type"`
+	Properties map[string]interface{} `json:"properties"`
+	Geometry   geoJSONGeometry        `json:"geometry"`
+}
+
+type geoJSONGeometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+func exportGeoJSON(doc *roidoc.Document, name string) (File, error) {
+	collection := geoJSONCollection{
+		Type:       "FeatureCollection",
+		Name:       name,
+		Properties: map[string]interface{}{"coordinate_space": "image_pixel"},
+		Features:   []geoJSONFeature{},
+	}
+	for _, camera := range doc.Cameras {
+		for _, shape := range shapesOf(camera) {
+			// 외곽선은 처음 점으로 닫음
+			ring := make([][]float64, 0, len(shape.Coords)/2+1)
+			for i := 0; i+1 < len(shape.Coords); i += 2 {
+				ring = append(ring, []float64{shape.Coords[i], shape.Coords[i+1]})
+			}
+			if len(ring) > 0 {
+				ring = append(ring, ring[0])
+			}
+			coordinates, err := json.Marshal([][][]float64{ring})
+			if err != nil {
+				return File{}, err
+			}
+			collection.Features = append(collection.Features, geoJSONFeature{
+				Type: "Feature",
+				Properties: map[string]interface{}{
+					"parking_id": shape.ParkingID,
+					"cctv_id":    shape.CctvID,
+					"camera_key": shape.CameraKey,
+				},
+				Geometry: geoJSONGeometry{Type: "Polygon", Coordinates: coordinates},
+			})
+		}
+	}
+
+	data, err := json.MarshalIndent(collection, "", "  ")
+	if err != nil {
+		return File{}, err
+	}
+	return File{Name: name + ".geojson", ContentType: "application/geo+json", Data: data}, nil
+}
+
+// importGeoJSON Polygon Feature의 외곽선을 주차면으로 (구멍은 무시)
+func importGeoJSON(data []byte) ([]roiShape, error) {
+	var collection geoJSONCollection
+	if err := json.Unmarshal(data, &collection); err != nil {
+		return nil, fmt.Errorf("GeoJSON 파싱 실패: %v", err)
+	}
+	if collection.Type != "FeatureCollection" {
+		return nil, fmt.Errorf("GeoJSON 최상위 type은 FeatureCollection이어야 합니다: %q", collection.Type)
+	}
+
+	shapes := make([]roiShape, 0, len(collection.Features))
+	for i, feature := range collection.Features {
+		if feature.Geometry.Type != "Polygon" {
+			return nil, fmt.Errorf("%d번째 feature가 Polygon이 아닙니다: %q", i+1, feature.Geometry.Type)
+		}
+		var rings [][][]float64
+		if err := json.Unmarshal(feature.Geometry.Coordinates, &rings); err != nil || len(rings) == 0 {
+			return nil, fmt.Errorf("%d번째 feature의 coordinates가 잘못되었습니다", i+1)
+		}
+
+		ring := rings[0]
+		// 닫는 점(처음 점과 같은 마지막 점)은 ROI 파일에 쓰지 않음
+		if n := len(ring); n > 1 && len(ring[0]) >= 2 && len(ring[n-1]) >= 2 && ring[0][0] == ring[n-1][0] && ring[0][1] == ring[n-1][1] {
+			ring = ring[:n-1]
+		}
+		coords := make([]float64, 0, len(ring)*2)
+		for _, p := range ring {
+			if len(p) < 2 {
+				return nil, fmt.Errorf("%d번째 feature의 좌표가 잘못되었습니다", i+1)
+			}
+			coords = append(coords, roundCoord(p[0]), roundCoord(p[1]))
+		}
+		shapes = append(shapes, roiShape{
+			CameraKey: geoJSONString(feature.Properties["camera_key"]),
+			CctvID:    geoJSONString(feature.Properties["cctv_id"]),
+			ParkingID: geoJSONString(feature.Properties["parking_id"]),
+			Coords:    coords,
+		})
+	}
+	return shapes, nil
+}
+
+// geoJSONString 속성 값을 문자열로 (숫자 parking_id 허용)
+func geoJSONString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case float64:
+		return formatNumber(v)
+	default:
+		return ""
+	}
+}
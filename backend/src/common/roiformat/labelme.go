@@ -0,0 +1,199 @@
+package roiformat
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"main/common/roidoc"
+	"path/filepath"
+	"strings"
+)
+
+// labelMeVersion 내보낼 때 쓰는 LabelMe 파일 버전
+const labelMeVersion = "5.2.1"
+
+// zip 가져오기 제한 (압축 해제 크기는 헤더 값을 믿지 않고 실제로 읽은 바이트로 확인)
+const (
+	maxZipEntries   = 1000     // zip 안 파일 수
+	maxZipEntrySize = 16 << 20 // JSON 하나의 압축 해제 크기 (imageData 포함)
+	maxZipTotalSize = 64 << 20 // 전체 압축 해제 크기
+)
+
+// labelMeFile LabelMe 이미지 하나의 라벨 파일 (cctv_id, camera_key는 LabelMe가 그대로 보관하는 추가 필드)
+type labelMeFile struct {
+	Version     string          `json:"version"`
+	Flags       map[string]bool `json:"flags"`
+	Shapes      []labelMeShape  `json:"shapes"`
+	ImagePath   string          `json:"imagePath"`
+	ImageData   *string         `json:"imageData"`
+	ImageHeight int             `json:"imageHeight"`
+	ImageWidth  int             `json:"imageWidth"`
+	CctvID      string          `json:"cctv_id,omitempty"`
+	CameraKey   string          `json:"camera_key,omitempty"`
+}
+
+type labelMeShape struct {
+	Label       string          `json:"label"`
+	Points      [][]float64     `json:"points"`
+	GroupID     *int            `json:"group_id"`
+	Description string          `json:"description"`
+	ShapeType   string          `json:"shape_type"`
+	Flags       map[string]bool `json:"flags"`
+}
+
+// exportLabelMe CCTV마다 {cctv_id}.json (CCTV가 둘 이상이면 zip)
+func exportLabelMe(doc *roidoc.Document, name string, images map[string]Image) (File, error) {
+	files := make([]File, 0, len(doc.Cameras))
+	for _, camera := range doc.Cameras {
+		image := imageOf(camera.CctvID, images)
+		file := labelMeFile{
+			Version:     labelMeVersion,
+			Flags:       map[string]bool{},
+			Shapes:      []labelMeShape{},
+			ImagePath:   image.Name,
+			ImageHeight: image.Height,
+			ImageWidth:  image.Width,
+			CctvID:      camera.CctvID,
+			CameraKey:   camera.Key,
+		}
+		for _, shape := range shapesOf(camera) {
+			points := make([][]float64, 0, len(shape.Coords)/2)
+			for i := 0; i+1 < len(shape.Coords); i += 2 {
+				points = append(points, []float64{shape.Coords[i], shape.Coords[i+1]})
+			}
+			file.Shapes = append(file.Shapes, labelMeShape{
+				Label:     shape.ParkingID,
+				Points:    points,
+				ShapeType: "polygon",
+				Flags:     map[string]bool{},
+			})
+		}
+		data, err := json.MarshalIndent(file, "", "  ")
+		if err != nil {
+			return File{}, err
+		}
+		files = append(files, File{Name: camera.CctvID + ".json", ContentType: "application/json", Data: data})
+	}
+
+	if len(files) == 1 {
+		return files[0], nil
+	}
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, file := range files {
+		w, err := zw.Create(file.Name)
+		if err != nil {
+			return File{}, err
+		}
+		if _, err := w.Write(file.Data); err != nil {
+			return File{}, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return File{}, err
+	}
+	return File{Name: name + "_labelme.zip", ContentType: "application/zip", Data: buf.Bytes()}, nil
+}
+
+// importLabelMe LabelMe JSON 하나 또는 여러 JSON을 묶은 zip
+func importLabelMe(fileName string, data []byte) ([]roiShape, error) {
+	if !strings.EqualFold(filepath.Ext(fileName), ".zip") {
+		return labelMeShapes(fileName, data)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("zip 파일 읽기 실패: %v", err)
+	}
+	if len(zr.File) > maxZipEntries {
+		return nil, fmt.Errorf("zip 안의 파일이 너무 많습니다 (%d개, 최대 %d개)", len(zr.File), maxZipEntries)
+	}
+	var shapes []roiShape
+	remaining := int64(maxZipTotalSize)
+	for _, entry := range zr.File {
+		if entry.FileInfo().IsDir() || !strings.EqualFold(filepath.Ext(entry.Name), ".json") {
+			continue
+		}
+		content, err := readZipEntry(entry, remaining)
+		if err != nil {
+			return nil, err
+		}
+		remaining -= int64(len(content))
+		fileShapes, err := labelMeShapes(entry.Name, content)
+		if err != nil {
+			return nil, err
+		}
+		shapes = append(shapes, fileShapes...)
+	}
+	return shapes, nil
+}
+
+// readZipEntry zip 항목을 최대 maxZipEntrySize, 남은 전체 한도 budget까지만 읽음
+func readZipEntry(entry *zip.File, budget int64) ([]byte, error) {
+	limit := min(int64(maxZipEntrySize), budget)
+	r, err := entry.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%s 읽기 실패: %v", entry.Name, err)
+	}
+	defer r.Close()
+	content, err := io.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("%s 읽기 실패: %v", entry.Name, err)
+	}
+	if int64(len(content)) > limit {
+		if limit < maxZipEntrySize {
+			return nil, fmt.Errorf("zip 압축 해제 크기가 너무 큽니다 (최대 %d bytes)", maxZipTotalSize)
+		}
+		return nil, fmt.Errorf("%s 파일이 너무 큽니다 (최대 %d bytes)", entry.Name, maxZipEntrySize)
+	}
+	return content, nil
+}
+
+// labelMeShapes LabelMe 파일 하나의 polygon (rectangle은 네 꼭짓점으로 변환, 라벨이 parking_id)
+func labelMeShapes(fileName string, data []byte) ([]roiShape, error) {
+	var file labelMeFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("%s: LabelMe JSON 파싱 실패: %v", fileName, err)
+	}
+	cctvID := file.CctvID
+	if cctvID == "" && file.ImagePath != "" {
+		cctvID = cctvIDFromImage(file.ImagePath)
+	}
+	if cctvID == "" {
+		cctvID = cctvIDFromImage(fileName)
+	}
+
+	shapes := make([]roiShape, 0, len(file.Shapes))
+	for i, shape := range file.Shapes {
+		points := shape.Points
+		switch shape.ShapeType {
+		case "", "polygon":
+		case "rectangle":
+			if len(points) != 2 || len(points[0]) < 2 || len(points[1]) < 2 {
+				return nil, fmt.Errorf("%s의 %d번째 rectangle 좌표가 잘못되었습니다", fileName, i+1)
+			}
+			a, b := points[0], points[1]
+			points = [][]float64{{a[0], a[1]}, {b[0], a[1]}, {b[0], b[1]}, {a[0], b[1]}}
+		default:
+			// 점, 선 등 주차면이 아닌 도형은 건너뜀
+			continue
+		}
+
+		coords := make([]float64, 0, len(points)*2)
+		for _, p := range points {
+			if len(p) < 2 {
+				return nil, fmt.Errorf("%s의 %d번째 도형 좌표가 잘못되었습니다", fileName, i+1)
+			}
+			coords = append(coords, roundCoord(p[0]), roundCoord(p[1]))
+		}
+		shapes = append(shapes, roiShape{
+			CameraKey: file.CameraKey,
+			CctvID:    cctvID,
+			ParkingID: strings.TrimSpace(shape.Label),
+			Coords:    coords,
+		})
+	}
+	return shapes, nil
+}
@@ -0,0 +1,172 @@
+package roiformat
+
+import (
+	"fmt"
+	"main/common/roidoc"
+	"math"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// 외부 라벨링 도구 형식과 ROI 문서 변환
+//
+//	cvat    CVAT for images 1.1 XML (CCTV 하나가 image 하나, 주차면은 "parking" 라벨 polygon)
+//	labelme LabelMe JSON (CCTV마다 파일 하나, 주차면은 parking_id를 라벨로 쓴 polygon, 여러 CCTV는 zip)
+//	geojson GeoJSON FeatureCollection (이미지 픽셀 좌표, 주차면마다 Polygon Feature)
+//
+// 어느 형식이든 parking_id, cctv_id, camera_key(ROI 파일의 IP 키)를 라벨/속성으로 남겨 다시 가져올 수 있게 함
+
+const (
+	FormatCVAT    = "cvat"
+	FormatLabelMe = "labelme"
+	FormatGeoJSON = "geojson"
+)
+
+// parkingLabel CVAT에서 주차면에 쓰는 라벨 이름
+const parkingLabel = "parking"
+
+// Image CCTV 기준 이미지 (CVAT, LabelMe는 이미지 단위로 ROI를 묶음, 크기를 모르면 0)
+type Image struct {
+	Name   string
+	Width  int
+	Height int
+}
+
+// File 내보낸 파일
+type File struct {
+	Name        string
+	ContentType string
+	Data        []byte
+}
+
+// roiShape 형식 사이에서 주고받는 주차면 하나
+type roiShape struct {
+	CameraKey string
+	CctvID    string
+	ParkingID string
+	Coords    []float64
+}
+
+// IsFormat 지원하는 형식인지
+func IsFormat(format string) bool {
+	return format == FormatCVAT || format == FormatLabelMe || format == FormatGeoJSON
+}
+
+// Export ROI 문서를 format 형식 파일로 변환 (name은 확장자 없는 파일 이름)
+// images는 cctv_id별 기준 이미지 (없는 CCTV는 {cctv_id}.jpg, 크기 0)
+func Export(doc *roidoc.Document, format string, name string, images map[string]Image) (File, error) {
+	switch format {
+	case FormatCVAT:
+		return exportCVAT(doc, name, images)
+	case FormatLabelMe:
+		return exportLabelMe(doc, name, images)
+	case FormatGeoJSON:
+		return exportGeoJSON(doc, name)
+	default:
+		return File{}, fmt.Errorf("지원하지 않는 형식입니다: %s (cvat, labelme, geojson)", format)
+	}
+}
+
+// Import format 형식 파일을 ROI 문서로 변환
+// camera_key가 없는 CCTV는 cctv_id를 키로 사용 (호출하는 쪽에서 기존 ROI 파일 키로 바꿀 수 있음)
+func Import(format string, fileName string, data []byte) (*roidoc.Document, error) {
+	var (
+		shapes []roiShape
+		err    error
+	)
+	switch format {
+	case FormatCVAT:
+		shapes, err = importCVAT(data)
+	case FormatLabelMe:
+		shapes, err = importLabelMe(fileName, data)
+	case FormatGeoJSON:
+		shapes, err = importGeoJSON(data)
+	default:
+		return nil, fmt.Errorf("지원하지 않는 형식입니다: %s (cvat, labelme, geojson)", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(shapes) == 0 {
+		return nil, fmt.Errorf("가져올 주차면 polygon이 없습니다")
+	}
+	return buildDocument(shapes)
+}
+
+// shapesOf 문서의 주차면 목록 (좌표가 빈 삭제된 ROI 제외)
+func shapesOf(camera *roidoc.Camera) []roiShape {
+	shapes := make([]roiShape, 0, len(camera.Matches))
+	for _, match := range camera.Matches {
+		coords := match.Coords()
+		if len(coords) == 0 {
+			continue
+		}
+		shapes = append(shapes, roiShape{
+			CameraKey: camera.Key,
+			CctvID:    camera.CctvID,
+			ParkingID: match.ParkingID.Value,
+			Coords:    coords,
+		})
+	}
+	return shapes
+}
+
+// imageOf CCTV 기준 이미지 (모르면 {cctv_id}.jpg)
+func imageOf(cctvID string, images map[string]Image) Image {
+	if image, ok := images[cctvID]; ok && image.Name != "" {
+		return image
+	}
+	return Image{Name: cctvID + ".jpg"}
+}
+
+// cctvIDFromImage 이미지 이름에서 CCTV ID (P1_B2_3_1_Current.jpg -> P1_B2_3_1)
+func cctvIDFromImage(name string) string {
+	base := filepath.Base(strings.ReplaceAll(name, "\\", "/"))
+	return strings.TrimSuffix(strings.TrimSuffix(base, filepath.Ext(base)), "_Current")
+}
+
+// buildDocument 주차면 목록을 ROI 문서로 묶고 ROI 파일 규칙으로 검증 (parking_id 중복 등)
+func buildDocument(shapes []roiShape) (*roidoc.Document, error) {
+	doc := &roidoc.Document{}
+	cameras := map[string]*roidoc.Camera{}
+	keys := map[string]string{}
+	for i, shape := range shapes {
+		if shape.CctvID == "" {
+			return nil, fmt.Errorf("%d번째 polygon의 cctv_id를 알 수 없습니다", i+1)
+		}
+		if shape.ParkingID == "" {
+			return nil, fmt.Errorf("%d번째 polygon(%s)의 parking_id가 없습니다", i+1, shape.CctvID)
+		}
+		camera, ok := cameras[shape.CctvID]
+		if !ok {
+			key := shape.CameraKey
+			if key == "" {
+				key = shape.CctvID
+			}
+			if other, ok := keys[key]; ok {
+				return nil, fmt.Errorf("camera_key %q가 %s와 %s에 함께 쓰였습니다", key, other, shape.CctvID)
+			}
+			keys[key] = shape.CctvID
+			camera = &roidoc.Camera{Key: key, CctvID: shape.CctvID}
+			cameras[shape.CctvID] = camera
+			doc.Cameras = append(doc.Cameras, camera)
+		}
+		camera.AddMatch(shape.ParkingID, shape.Coords)
+	}
+
+	data, err := roidoc.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	return roidoc.Parse(data)
+}
+
+// roundCoord 외부 도구의 소수 좌표를 ROI 파일과 같은 정수 픽셀로 (OpenCV가 정수로 읽음)
+func roundCoord(v float64) float64 {
+	return math.Round(v)
+}
+
+func formatNumber(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -0,0 +1,143 @@
+package roigeom
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Affine 2x3 아핀 변환 행렬 (OpenCV와 같은 행 우선 [a, b, tx, c, d, ty])
+//
+//	x' = a*x + b*y + tx
+//	y' = c*x + d*y + ty
+type Affine [6]float64
+
+// Translation 평행 이동
+func Translation(dx float64, dy float64) Affine {
+	return Affine{1, 0, dx, 0, 1, dy}
+}
+
+// AffineFromMatrix [a, b, tx, c, d, ty] 배열을 변환으로 (역변환이 없는 행렬은 오류)
+func AffineFromMatrix(matrix []float64) (Affine, error) {
+	if len(matrix) != 6 {
+		return Affine{}, fmt.Errorf("matrix는 2x3 행렬 값 6개여야 합니다 (입력: %d개)", len(matrix))
+	}
+	var m Affine
+	copy(m[:], matrix)
+	for _, v := range m {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return Affine{}, errors.New("matrix에 숫자가 아닌 값이 있습니다")
+		}
+	}
+	if math.Abs(m.Det()) < 1e-9 {
+		return Affine{}, errors.New("matrix가 점들을 한 직선(또는 한 점)으로 모으는 변환입니다")
+	}
+	return m, nil
+}
+
+// EstimateAffine 대응점(src -> dst) 3쌍 이상으로 최소제곱 아핀 변환 추정
+// rms는 추정한 변환을 적용했을 때 dst와의 평균제곱근 오차 (픽셀)
+func EstimateAffine(src []Point, dst []Point) (m Affine, rms float64, err error) {
+	if len(src) != len(dst) {
+		return Affine{}, 0, errors.New("대응점의 이전/이후 개수가 다릅니다")
+	}
+	if len(src) < 3 {
+		return Affine{}, 0, fmt.Errorf("대응점이 %d쌍입니다 (3쌍 이상 필요)", len(src))
+	}
+
+	// 정규 방정식 (AᵀA)p = Aᵀb, A의 행은 [x, y, 1]
+	var ata [3][3]float64
+	var atx, aty [3]float64
+	for i, p := range src {
+		row := [3]float64{p.X, p.Y, 1}
+		for r := 0; r < 3; r++ {
+			for c := 0; c < 3; c++ {
+				ata[r][c] += row[r] * row[c]
+			}
+			atx[r] += row[r] * dst[i].X
+			aty[r] += row[r] * dst[i].Y
+		}
+	}
+	px, ok := solve3(ata, atx)
+	if !ok {
+		return Affine{}, 0, errors.New("이전 대응점이 한 직선 위에 있어 변환을 구할 수 없습니다")
+	}
+	py, _ := solve3(ata, aty)
+	m = Affine{px[0], px[1], px[2], py[0], py[1], py[2]}
+	if math.Abs(m.Det()) < 1e-9 {
+		return Affine{}, 0, errors.New("이후 대응점이 한 직선 위에 있어 변환을 구할 수 없습니다")
+	}
+
+	sum := 0.0
+	for i, p := range src {
+		q := m.Apply(p)
+		sum += (q.X-dst[i].X)*(q.X-dst[i].X) + (q.Y-dst[i].Y)*(q.Y-dst[i].Y)
+	}
+	return m, math.Sqrt(sum / float64(len(src))), nil
+}
+
+// Det 선형 부분의 행렬식 (넓이 배율, 음수면 뒤집힘)
+func (m Affine) Det() float64 {
+	return m[0]*m[4] - m[1]*m[3]
+}
+
+// Apply 점 하나 변환
+func (m Affine) Apply(p Point) Point {
+	return Point{
+		X: m[0]*p.X + m[1]*p.Y + m[2],
+		Y: m[3]*p.X + m[4]*p.Y + m[5],
+	}
+}
+
+// ApplyCoords [x1, y1, x2, y2, ...] 좌표 배열 변환 (결과는 정수 픽셀로 반올림)
+func (m Affine) ApplyCoords(coords []float64) []float64 {
+	points := Points(coords)
+	for i, p := range points {
+		q := m.Apply(p)
+		points[i] = Point{X: math.Round(q.X), Y: math.Round(q.Y)}
+	}
+	return Coords(points)
+}
+
+// solve3 3x3 연립방정식 (부분 피벗 가우스 소거, 특이 행렬이면 ok=false)
+func solve3(a [3][3]float64, b [3]float64) ([3]float64, bool) {
+	// 좌표 크기에 비례한 허용 오차
+	scale := 0.0
+	for r := 0; r < 3; r++ {
+		for c := 0; c < 3; c++ {
+			scale = math.Max(scale, math.Abs(a[r][c]))
+		}
+	}
+	eps := 1e-9 * math.Max(scale, 1)
+
+	for col := 0; col < 3; col++ {
+		pivot := col
+		for r := col + 1; r < 3; r++ {
+			if math.Abs(a[r][col]) > math.Abs(a[pivot][col]) {
+				pivot = r
+			}
+		}
+		if math.Abs(a[pivot][col]) < eps {
+			return [3]float64{}, false
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+		b[col], b[pivot] = b[pivot], b[col]
+		for r := col + 1; r < 3; r++ {
+			f := a[r][col] / a[col][col]
+			for c := col; c < 3; c++ {
+				a[r][c] -= f * a[col][c]
+			}
+			b[r] -= f * b[col]
+		}
+	}
+
+	var x [3]float64
+	for r := 2; r >= 0; r-- {
+		sum := b[r]
+		for c := r + 1; c < 3; c++ {
+			sum -= a[r][c] * x[c]
+		}
+		x[r] = sum / a[r][r]
+	}
+	return x, true
+}
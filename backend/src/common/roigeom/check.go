@@ -0,0 +1,159 @@
+package roigeom
+
+import "fmt"
+
+const (
+	// MinArea 주차면으로 인정하는 최소 넓이 (픽셀²)
+	MinArea = 100.0
+	// OverlapWarnRatio 이 비율 이상 겹치면 경고
+	OverlapWarnRatio = 0.05
+	// OverlapErrorRatio 이 비율 이상 겹치면 같은 주차면을 두 번 그린 것으로 보고 저장 차단
+	OverlapErrorRatio = 0.8
+	// MaxCoordinate 기준 이미지가 없을 때 허용하는 최대 좌표 (8K 해상도보다 넉넉하게)
+	MaxCoordinate = 10000.0
+)
+
+// Severity 검사 결과 등급
+type Severity string
+
+const (
+	SeverityError   = Severity("error")
+	SeverityWarning = Severity("warning")
+)
+
+// 검사 항목 코드
+const (
+	CodeTooFewPoints     = "TOO_FEW_POINTS"
+	CodeSelfIntersection = "SELF_INTERSECTION"
+	CodeTooSmall         = "TOO_SMALL"
+	CodeOutOfBounds      = "OUT_OF_BOUNDS"
+	CodeOverlap          = "OVERLAP"
+	CodeNoReference      = "NO_REFERENCE_IMAGE"
+)
+
+// Roi 검사 대상 주차면 (좌표가 비어 있으면 삭제된 ROI로 보고 건너뜀)
+type Roi struct {
+	ParkingID string
+	Coords    []float64
+}
+
+// Frame 기준 이미지 해상도
+type Frame struct {
+	Width  int
+	Height int
+}
+
+// Issue 검사에서 발견한 문제
+type Issue struct {
+	Severity       Severity
+	Code           string
+	ParkingID      string
+	OtherParkingID string
+	Ratio          float64
+	Message        string
+}
+
+// Check 한 카메라의 ROI 형상 검사
+// target이 비어 있으면 전체, 아니면 해당 주차면과 그 주차면이 얽힌 겹침만 검사
+// frame이 nil이면 기준 이미지가 없어 음수 좌표와 MaxCoordinate를 넘는 좌표만 범위 오류로 처리
+func Check(rois []Roi, frame *Frame, target string) []Issue {
+	var issues []Issue
+	if frame == nil {
+		issues = append(issues, Issue{
+			Severity: SeverityWarning,
+			Code:     CodeNoReference,
+			Message:  fmt.Sprintf("기준 이미지를 찾을 수 없어 이미지 범위 검사는 음수 좌표와 최대 좌표 %.0f만 확인합니다", MaxCoordinate),
+		})
+	}
+
+	valid := make([]bool, len(rois))
+	for i, roi := range rois {
+		if len(roi.Coords) == 0 {
+			continue
+		}
+		shapeIssues := checkShape(roi, frame)
+		valid[i] = len(shapeIssues) == 0
+		if target == "" || roi.ParkingID == target {
+			issues = append(issues, shapeIssues...)
+		}
+	}
+
+	// 형상이 올바른 ROI끼리만 겹침 비율 계산
+	for i := range rois {
+		if !valid[i] {
+			continue
+		}
+		for j := i + 1; j < len(rois); j++ {
+			if !valid[j] {
+				continue
+			}
+			if target != "" && rois[i].ParkingID != target && rois[j].ParkingID != target {
+				continue
+			}
+			if issue, ok := checkOverlap(rois[i], rois[j]); ok {
+				issues = append(issues, issue)
+			}
+		}
+	}
+	return issues
+}
+
+// HasError 저장을 막아야 하는 문제가 있는지
+func HasError(issues []Issue) bool {
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// checkShape 주차면 하나의 꼭짓점 수, 꼬임, 넓이, 이미지 범위 검사
+func checkShape(roi Roi, frame *Frame) []Issue {
+	points := Points(roi.Coords)
+	if len(points) < 3 {
+		return []Issue{shapeError(roi, CodeTooFewPoints, fmt.Sprintf("꼭짓점이 %d개입니다 (3개 이상 필요)", len(points)))}
+	}
+
+	var issues []Issue
+	if !IsSimple(points) {
+		issues = append(issues, shapeError(roi, CodeSelfIntersection, "변이 서로 교차하거나 겹치는 다각형입니다"))
+	} else if area := Area(points); area < MinArea {
+		issues = append(issues, shapeError(roi, CodeTooSmall, fmt.Sprintf("넓이가 %.1f로 최소 넓이 %.0f보다 작습니다", area, MinArea)))
+	}
+
+	lo, hi := Bounds(points)
+	switch {
+	case lo.X < 0 || lo.Y < 0:
+		issues = append(issues, shapeError(roi, CodeOutOfBounds, fmt.Sprintf("음수 좌표가 있습니다 (%.0f, %.0f)", lo.X, lo.Y)))
+	case frame != nil && (hi.X > float64(frame.Width) || hi.Y > float64(frame.Height)):
+		issues = append(issues, shapeError(roi, CodeOutOfBounds, fmt.Sprintf("좌표 (%.0f, %.0f)가 이미지 크기 %dx%d를 벗어납니다", hi.X, hi.Y, frame.Width, frame.Height)))
+	case frame == nil && (hi.X > MaxCoordinate || hi.Y > MaxCoordinate):
+		issues = append(issues, shapeError(roi, CodeOutOfBounds, fmt.Sprintf("좌표 (%.0f, %.0f)가 최대 좌표 %.0f를 벗어납니다", hi.X, hi.Y, MaxCoordinate)))
+	}
+	return issues
+}
+
+func shapeError(roi Roi, code string, message string) Issue {
+	return Issue{Severity: SeverityError, Code: code, ParkingID: roi.ParkingID, Message: message}
+}
+
+// checkOverlap 두 주차면의 겹침 비율 (경고 기준 미만이면 ok=false)
+func checkOverlap(a Roi, b Roi) (Issue, bool) {
+	ratio := OverlapRatio(Points(a.Coords), Points(b.Coords))
+	if ratio < OverlapWarnRatio {
+		return Issue{}, false
+	}
+	severity := SeverityWarning
+	if ratio >= OverlapErrorRatio {
+		severity = SeverityError
+	}
+	return Issue{
+		Severity:       severity,
+		Code:           CodeOverlap,
+		ParkingID:      a.ParkingID,
+		OtherParkingID: b.ParkingID,
+		Ratio:          ratio,
+		Message:        fmt.Sprintf("%s와 %s가 %.0f%% 겹칩니다", a.ParkingID, b.ParkingID, ratio*100),
+	}, true
+}
@@ -0,0 +1,174 @@
+package roigeom
+
+import (
+	"math"
+)
+
+// Point 이미지 좌표 (픽셀)
+type Point struct {
+	X float64
+	Y float64
+}
+
+// Points [x1, y1, x2, y2, ...] 좌표 배열을 점 목록으로 변환 (짝이 없는 마지막 값은 무시)
+func Points(coords []float64) []Point {
+	points := make([]Point, 0, len(coords)/2)
+	for i := 0; i+1 < len(coords); i += 2 {
+		points = append(points, Point{X: coords[i], Y: coords[i+1]})
+	}
+	return points
+}
+
+// Coords 점 목록을 [x1, y1, x2, y2, ...] 좌표 배열로 변환
+func Coords(points []Point) []float64 {
+	coords := make([]float64, 0, len(points)*2)
+	for _, p := range points {
+		coords = append(coords, p.X, p.Y)
+	}
+	return coords
+}
+
+// Area 다각형 넓이 (신발끈 공식, 꼬인 다각형은 의미 없는 값)
+func Area(points []Point) float64 {
+	if len(points) < 3 {
+		return 0
+	}
+	sum := 0.0
+	for i := range points {
+		j := (i + 1) % len(points)
+		sum += points[i].X*points[j].Y - points[j].X*points[i].Y
+	}
+	return math.Abs(sum) / 2
+}
+
+// IsSimple 변끼리 겹치거나 교차하지 않는 단순 다각형인지 (나비 모양, 같은 점 반복 등은 false)
+func IsSimple(points []Point) bool {
+	n := len(points)
+	if n < 3 {
+		return false
+	}
+	for i := 0; i < n; i++ {
+		if points[i] == points[(i+1)%n] {
+			return false
+		}
+	}
+	for i := 0; i < n; i++ {
+		a1, a2 := points[i], points[(i+1)%n]
+		for j := i + 1; j < n; j++ {
+			// 이웃한 변은 꼭짓점 하나를 공유하므로 제외
+			if j == i+1 || (i == 0 && j == n-1) {
+				continue
+			}
+			b1, b2 := points[j], points[(j+1)%n]
+			if segmentsIntersect(a1, a2, b1, b2) {
+				return false
+			}
+		}
+	}
+	// 삼각형은 이웃하지 않은 변이 없으므로 한 직선 위에 있는지만 확인
+	return Area(points) > 0
+}
+
+// Bounds 다각형을 감싸는 사각형
+func Bounds(points []Point) (lo Point, hi Point) {
+	if len(points) == 0 {
+		return Point{}, Point{}
+	}
+	lo, hi = points[0], points[0]
+	for _, p := range points[1:] {
+		lo.X = math.Min(lo.X, p.X)
+		lo.Y = math.Min(lo.Y, p.Y)
+		hi.X = math.Max(hi.X, p.X)
+		hi.Y = math.Max(hi.Y, p.Y)
+	}
+	return lo, hi
+}
+
+// Contains 점이 다각형 안에 있는지 (ray casting)
+func Contains(points []Point, p Point) bool {
+	inside := false
+	for i, j := 0, len(points)-1; i < len(points); j, i = i, i+1 {
+		a, b := points[i], points[j]
+		if (a.Y > p.Y) != (b.Y > p.Y) && p.X < (b.X-a.X)*(p.Y-a.Y)/(b.Y-a.Y)+a.X {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// maxOverlapSamples 겹침 계산에서 사각형 하나당 확인하는 최대 픽셀 수
+// 넘으면 step 픽셀 간격으로 건너뛰며 세고 step²을 곱해 넓이를 추정 (좌표가 매우 커도 계산량이 일정)
+const maxOverlapSamples = 1 << 20
+
+// OverlapRatio 두 다각형이 겹치는 비율 (겹친 픽셀 수 / 작은 쪽 픽셀 수)
+// 오목한 ROI도 다룰 수 있도록 픽셀 중심을 세어 계산
+func OverlapRatio(a []Point, b []Point) float64 {
+	aMin, aMax := Bounds(a)
+	bMin, bMax := Bounds(b)
+	if aMax.X <= bMin.X || bMax.X <= aMin.X || aMax.Y <= bMin.Y || bMax.Y <= aMin.Y {
+		return 0
+	}
+
+	areaA := pixelCount(a, aMin, aMax, nil)
+	areaB := pixelCount(b, bMin, bMax, nil)
+	smaller := math.Min(areaA, areaB)
+	if smaller == 0 {
+		return 0
+	}
+
+	from := Point{X: math.Max(aMin.X, bMin.X), Y: math.Max(aMin.Y, bMin.Y)}
+	to := Point{X: math.Min(aMax.X, bMax.X), Y: math.Min(aMax.Y, bMax.Y)}
+	overlap := pixelCount(a, from, to, b)
+	return math.Min(overlap/smaller, 1)
+}
+
+// pixelCount from~to 범위에서 다각형(과 also가 있으면 also까지) 안에 들어가는 픽셀 중심 수
+// 범위가 maxOverlapSamples보다 넓으면 간격을 두고 센 추정값
+func pixelCount(points []Point, from Point, to Point, also []Point) float64 {
+	startX, startY := math.Floor(from.X), math.Floor(from.Y)
+	step := 1.0
+	if cells := (to.X - startX) * (to.Y - startY); cells > maxOverlapSamples {
+		step = math.Ceil(math.Sqrt(cells / maxOverlapSamples))
+	}
+
+	count := 0
+	for y := startY; y < to.Y; y += step {
+		for x := startX; x < to.X; x += step {
+			center := Point{X: x + step/2, Y: y + step/2}
+			if !Contains(points, center) {
+				continue
+			}
+			if also != nil && !Contains(also, center) {
+				continue
+			}
+			count++
+		}
+	}
+	return float64(count) * step * step
+}
+
+// segmentsIntersect 두 선분이 닿거나 교차하는지
+func segmentsIntersect(p1, p2, q1, q2 Point) bool {
+	d1 := cross(q1, q2, p1)
+	d2 := cross(q1, q2, p2)
+	d3 := cross(p1, p2, q1)
+	d4 := cross(p1, p2, q2)
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return true
+	}
+	return (d1 == 0 && onSegment(q1, q2, p1)) ||
+		(d2 == 0 && onSegment(q1, q2, p2)) ||
+		(d3 == 0 && onSegment(p1, p2, q1)) ||
+		(d4 == 0 && onSegment(p1, p2, q2))
+}
+
+// cross (b - a) x (c - a)
+func cross(a, b, c Point) float64 {
+	return (b.X-a.X)*(c.Y-a.Y) - (b.Y-a.Y)*(c.X-a.X)
+}
+
+// onSegment 한 직선 위의 점 p가 선분 a-b 범위 안에 있는지
+func onSegment(a, b, p Point) bool {
+	return math.Min(a.X, b.X) <= p.X && p.X <= math.Max(a.X, b.X) &&
+		math.Min(a.Y, b.Y) <= p.Y && p.Y <= math.Max(a.Y, b.Y)
+}
@@ -0,0 +1,48 @@
+package roirender
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// labelPadding 글자 주변 여백 (배율 적용 전 픽셀)
+const labelPadding = 2
+
+// drawLabel anchor를 중심으로 상태 색 배경에 흰 글자 라벨 (scale배 확대, 이미지 밖으로 나가지 않게 조정)
+func drawLabel(dst *image.RGBA, text string, anchor image.Point, background color.RGBA, scale int) {
+	face := basicfont.Face7x13
+	textWidth := font.MeasureString(face, text).Ceil()
+	metrics := face.Metrics()
+	textHeight := (metrics.Ascent + metrics.Descent).Ceil()
+
+	// 1배 크기로 그린 뒤 최근접 확대 (비트맵 글꼴이라 흐려지지 않음)
+	label := image.NewRGBA(image.Rect(0, 0, textWidth+labelPadding*2, textHeight+labelPadding*2))
+	draw.Draw(label, label.Bounds(), image.NewUniform(background), image.Point{}, draw.Src)
+	drawer := font.Drawer{
+		Dst:  label,
+		Src:  image.NewUniform(color.White),
+		Face: face,
+		Dot:  fixed.P(labelPadding, labelPadding+metrics.Ascent.Ceil()),
+	}
+	drawer.DrawString(text)
+
+	w, h := label.Bounds().Dx()*scale, label.Bounds().Dy()*scale
+	origin := image.Pt(anchor.X-w/2, anchor.Y-h/2)
+	b := dst.Bounds()
+	origin.X = max(b.Min.X, min(origin.X, b.Max.X-w))
+	origin.Y = max(b.Min.Y, min(origin.Y, b.Max.Y-h))
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			p := image.Pt(origin.X+x, origin.Y+y)
+			if p.In(b) {
+				dst.SetRGBA(p.X, p.Y, label.RGBAAt(x/scale, y/scale))
+			}
+		}
+	}
+}
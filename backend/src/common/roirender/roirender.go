@@ -0,0 +1,218 @@
+package roirender
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"main/common/roigeom"
+	"math"
+	"sort"
+)
+
+// 이미지 위에 ROI 다각형, parking_id 라벨, 점유 색상을 그림
+// OpenCV의 roi_result.jpg와 달리 학습 없이 아무 이미지에나 현재 ROI를 겹쳐 볼 수 있음
+
+// State 주차면 점유 상태 (색상 결정)
+type State int
+
+const (
+	// StateUnknown 점유 결과 없음 (ROI만 표시)
+	StateUnknown State = iota
+	StateFree
+	StateOccupied
+)
+
+// 상태별 색상 (외곽선은 불투명, 채우기는 fillAlpha로 섞음)
+var stateColors = map[State]color.RGBA{
+	StateUnknown:  {R: 52, G: 152, B: 219, A: 255},
+	StateFree:     {R: 46, G: 204, B: 113, A: 255},
+	StateOccupied: {R: 231, G: 76, B: 60, A: 255},
+}
+
+// fillAlpha 다각형 내부를 칠하는 불투명도 (0~255)
+const fillAlpha = 70
+
+// Roi 그릴 주차면 (Rate가 있으면 라벨에 전경 비율도 표시)
+type Roi struct {
+	ParkingID string
+	Coords    []float64
+	State     State
+	Rate      *float64
+}
+
+// Render 원본 이미지를 복사해 ROI를 그린 이미지 반환 (선 굵기와 글자 크기는 이미지 크기에 맞춤)
+func Render(src image.Image, rois []Roi) *image.RGBA {
+	bounds := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Bounds(), src, bounds.Min, draw.Src)
+
+	// 긴 변 640px마다 1배씩 키움 (1920x1080이면 선 3px, 글자 3배)
+	scale := max(1, max(bounds.Dx(), bounds.Dy())/640)
+
+	shapes := make([][]roigeom.Point, len(rois))
+	for i, roi := range rois {
+		shapes[i] = roigeom.Points(roi.Coords)
+		if len(shapes[i]) >= 3 {
+			fillPolygon(dst, shapes[i], withAlpha(stateColors[roi.State], fillAlpha))
+		}
+	}
+	// 겹친 ROI의 채우기에 외곽선이 묻히지 않도록 외곽선과 라벨은 나중에 그림
+	for i, roi := range rois {
+		if len(shapes[i]) < 2 {
+			continue
+		}
+		strokePolygon(dst, shapes[i], stateColors[roi.State], scale)
+	}
+	for i, roi := range rois {
+		if len(shapes[i]) == 0 {
+			continue
+		}
+		drawLabel(dst, labelText(roi), labelAnchor(shapes[i]), stateColors[roi.State], scale)
+	}
+	return dst
+}
+
+// Encode png 또는 jpeg로 인코딩 (반환값은 Content-Type)
+func Encode(img image.Image, format string) ([]byte, string, error) {
+	var buf bytes.Buffer
+	switch format {
+	case "", "png":
+		if err := png.Encode(&buf, img); err != nil {
+			return nil, "", err
+		}
+		return buf.Bytes(), "image/png", nil
+	case "jpeg", "jpg":
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 90}); err != nil {
+			return nil, "", err
+		}
+		return buf.Bytes(), "image/jpeg", nil
+	default:
+		return nil, "", fmt.Errorf("지원하지 않는 이미지 형식입니다: %s (png, jpeg)", format)
+	}
+}
+
+func labelText(roi Roi) string {
+	if roi.Rate == nil {
+		return roi.ParkingID
+	}
+	return fmt.Sprintf("%s %.0f%%", roi.ParkingID, *roi.Rate*100)
+}
+
+// labelAnchor 라벨 위치 (꼭짓점 평균, 오목한 ROI에서도 대략 안쪽)
+func labelAnchor(points []roigeom.Point) image.Point {
+	var x, y float64
+	for _, p := range points {
+		x += p.X
+		y += p.Y
+	}
+	n := float64(len(points))
+	return image.Pt(int(math.Round(x/n)), int(math.Round(y/n)))
+}
+
+func withAlpha(c color.RGBA, alpha uint8) color.RGBA {
+	// image/draw의 Over는 premultiplied 색상을 기대함
+	return color.RGBA{
+		R: uint8(uint16(c.R) * uint16(alpha) / 255),
+		G: uint8(uint16(c.G) * uint16(alpha) / 255),
+		B: uint8(uint16(c.B) * uint16(alpha) / 255),
+		A: alpha,
+	}
+}
+
+// fillPolygon 짝홀 규칙 스캔라인 채우기 (픽셀 중심 기준, 오목/꼬인 다각형도 처리)
+func fillPolygon(dst *image.RGBA, points []roigeom.Point, c color.RGBA) {
+	lo, hi := roigeom.Bounds(points)
+	b := dst.Bounds()
+	yStart := max(int(math.Floor(lo.Y)), b.Min.Y)
+	yEnd := min(int(math.Ceil(hi.Y)), b.Max.Y)
+	src := image.NewUniform(c)
+
+	xs := make([]float64, 0, len(points))
+	for y := yStart; y < yEnd; y++ {
+		cy := float64(y) + 0.5
+		xs = xs[:0]
+		for i, j := 0, len(points)-1; i < len(points); j, i = i, i+1 {
+			a, p := points[i], points[j]
+			if (a.Y > cy) != (p.Y > cy) {
+				xs = append(xs, a.X+(cy-a.Y)*(p.X-a.X)/(p.Y-a.Y))
+			}
+		}
+		sort.Float64s(xs)
+		for k := 0; k+1 < len(xs); k += 2 {
+			x0 := max(int(math.Ceil(xs[k]-0.5)), b.Min.X)
+			x1 := min(int(math.Ceil(xs[k+1]-0.5)), b.Max.X)
+			if x0 < x1 {
+				draw.Draw(dst, image.Rect(x0, y, x1, y+1), src, image.Point{}, draw.Over)
+			}
+		}
+	}
+}
+
+// strokePolygon 닫힌 외곽선 (width 픽셀 굵기)
+func strokePolygon(dst *image.RGBA, points []roigeom.Point, c color.RGBA, width int) {
+	for i := range points {
+		drawLine(dst, points[i], points[(i+1)%len(points)], c, width)
+	}
+}
+
+// drawLine 선분 위를 width 크기 정사각형 붓으로 찍어 그림
+// 이미지 밖 좌표도 그대로 저장될 수 있으므로 붓이 닿을 수 있는 범위로 선분을 먼저 잘라 찍는 횟수를 이미지 크기로 제한
+func drawLine(dst *image.RGBA, a roigeom.Point, b roigeom.Point, c color.RGBA, width int) {
+	bounds := dst.Bounds()
+	pad := float64(width)
+	a, b, ok := clipSegment(a, b,
+		roigeom.Point{X: float64(bounds.Min.X) - pad, Y: float64(bounds.Min.Y) - pad},
+		roigeom.Point{X: float64(bounds.Max.X) + pad, Y: float64(bounds.Max.Y) + pad})
+	if !ok {
+		return
+	}
+	steps := int(math.Ceil(math.Max(math.Abs(b.X-a.X), math.Abs(b.Y-a.Y))))
+	half := width / 2
+	src := image.NewUniform(c)
+	for s := 0; s <= steps; s++ {
+		t := 0.0
+		if steps > 0 {
+			t = float64(s) / float64(steps)
+		}
+		x := int(math.Round(a.X + (b.X-a.X)*t))
+		y := int(math.Round(a.Y + (b.Y-a.Y)*t))
+		r := image.Rect(x-half, y-half, x-half+width, y-half+width).Intersect(dst.Bounds())
+		if !r.Empty() {
+			draw.Draw(dst, r, src, image.Point{}, draw.Src)
+		}
+	}
+}
+
+// clipSegment 선분 a-b를 lo~hi 사각형 안쪽 부분으로 자름 (Liang-Barsky, 사각형과 만나지 않으면 false)
+func clipSegment(a roigeom.Point, b roigeom.Point, lo roigeom.Point, hi roigeom.Point) (roigeom.Point, roigeom.Point, bool) {
+	dx, dy := b.X-a.X, b.Y-a.Y
+	t0, t1 := 0.0, 1.0
+	for _, edge := range [][2]float64{
+		{-dx, a.X - lo.X},
+		{dx, hi.X - a.X},
+		{-dy, a.Y - lo.Y},
+		{dy, hi.Y - a.Y},
+	} {
+		p, q := edge[0], edge[1]
+		if p == 0 {
+			if q < 0 {
+				return a, b, false
+			}
+			continue
+		}
+		t := q / p
+		if p < 0 {
+			t0 = math.Max(t0, t)
+		} else {
+			t1 = math.Min(t1, t)
+		}
+		if t0 > t1 {
+			return a, b, false
+		}
+	}
+	return roigeom.Point{X: a.X + t0*dx, Y: a.Y + t0*dy}, roigeom.Point{X: a.X + t1*dx, Y: a.Y + t1*dy}, true
+}
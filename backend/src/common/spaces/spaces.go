@@ -0,0 +1,63 @@
+package spaces
+
+import (
+	"main/common/db/mysql"
+	"main/common/roidoc"
+)
+
+// 주차면 번호 대응: CCTV별 parking_id 문자열 ↔ OpenCV ROI 번호 (roi_results.roi_id)
+//
+// OpenCV는 ROI 번호를 정수로만 다루므로 parking_id마다 CCTV 안에서 겹치지 않는 번호를 정해 ROI 파일의 roi_id로 넘김.
+// 번호는 처음 학습에 쓰일 때 한 번 정하고 바꾸지 않음. 예전 규칙("_" 뒤 숫자)으로 저장된 결과가 그대로 맞도록
+// 예전 번호가 비어 있으면 그 번호를 쓰고, 겹치거나 숫자가 없으면(A_1과 B_1, "entrance") CCTV의 최대 번호 + 1을 씀.
+
+// Index 프로젝트의 주차면 번호 대응
+type Index struct {
+	roiIDs     map[string]map[string]int
+	parkingIDs map[string]map[int]string
+}
+
+// NewIndex 저장된 대응으로 조회용 인덱스 생성
+func NewIndex(rows []mysql.ParkingSpaces) Index {
+	index := Index{roiIDs: map[string]map[string]int{}, parkingIDs: map[string]map[int]string{}}
+	for _, row := range rows {
+		index.add(row.CctvId, row.ParkingId, row.RoiId)
+	}
+	return index
+}
+
+func (x Index) add(cctvID string, parkingID string, roiID int) {
+	if x.roiIDs[cctvID] == nil {
+		x.roiIDs[cctvID] = map[string]int{}
+		x.parkingIDs[cctvID] = map[int]string{}
+	}
+	x.roiIDs[cctvID][parkingID] = roiID
+	x.parkingIDs[cctvID][roiID] = parkingID
+}
+
+// RoiID parking_id의 ROI 번호 (대응이 없으면 false, 예전 규칙으로 추측하면 다른 주차면과 겹칠 수 있음)
+func (x Index) RoiID(cctvID string, parkingID string) (int, bool) {
+	roiID, ok := x.roiIDs[cctvID][parkingID]
+	return roiID, ok
+}
+
+// ParkingID ROI 번호의 parking_id (대응이 없으면 빈 문자열)
+func (x Index) ParkingID(cctvID string, roiID int) string {
+	return x.parkingIDs[cctvID][roiID]
+}
+
+// Allocate 대응이 없는 parking_id에 번호를 정해 인덱스에 추가하고 저장할 행 반환 (이미 있으면 false)
+func (x Index) Allocate(projectID string, cctvID string, parkingID string) (mysql.ParkingSpaces, bool) {
+	if _, ok := x.roiIDs[cctvID][parkingID]; ok {
+		return mysql.ParkingSpaces{}, false
+	}
+	roiID, ok := roidoc.ParkingNumber(parkingID)
+	if _, used := x.parkingIDs[cctvID][roiID]; !ok || roiID <= 0 || used {
+		roiID = 1
+		for used := range x.parkingIDs[cctvID] {
+			roiID = max(roiID, used+1)
+		}
+	}
+	x.add(cctvID, parkingID, roiID)
+	return mysql.ParkingSpaces{ProjectId: projectID, CctvId: cctvID, ParkingId: parkingID, RoiId: roiID}, true
+}
@@ -0,0 +1,50 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// StartSSE Server-Sent Events 응답 헤더 설정
+func StartSSE(c echo.Context) {
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	// nginx 등 프록시에서 버퍼링하지 않도록 설정
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+}
+
+// WriteSSE 이벤트 하나 전송 (data는 JSON으로 직렬화)
+func WriteSSE(c echo.Context, id int, event string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	res := c.Response()
+	if id > 0 {
+		if _, err := fmt.Fprintf(res, "id: %d\n", id); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(res, "event: %s\ndata: %s\n\n", event, payload); err != nil {
+		return err
+	}
+	res.Flush()
+	return nil
+}
+
+// WriteSSEComment 연결 유지용 주석 전송
+func WriteSSEComment(c echo.Context, comment string) error {
+	res := c.Response()
+	if _, err := fmt.Fprintf(res, ": %s\n\n", comment); err != nil {
+		return err
+	}
+	res.Flush()
+	return nil
+}
@@ -0,0 +1,193 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 구독할 수 있는 이벤트 종류
+const (
+	// EventSpaceChanged 주차면 점유 여부 변경 (빈 자리가 생기면 occupied=false)
+	EventSpaceChanged = "space.changed"
+	// EventZoneThreshold 구역(또는 프로젝트 전체) 점유율이 구독 기준을 넘거나 내려감 (100이면 만차)
+	EventZoneThreshold = "zone.threshold"
+	// EventCameraStale CCTV의 마지막 관측 이미지가 구독의 stale_minutes보다 오래됨
+	EventCameraStale = "camera.stale"
+	// EventLearningJobFinished 학습 작업 종료 (성공/실패/취소)
+	EventLearningJobFinished = "learning_job.finished"
+)
+
+// EventTest 테스트 전송 전용 이벤트 (구독 대상 아님)
+const EventTest = "webhook.test"
+
+// EventTypes 구독할 수 있는 이벤트 종류 목록
+var EventTypes = []string{EventSpaceChanged, EventZoneThreshold, EventCameraStale, EventLearningJobFinished}
+
+// IsEventType 구독할 수 있는 이벤트 종류인지 확인
+func IsEventType(eventType string) bool {
+	for _, t := range EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// 전송 요청 헤더 (서명은 "{timestamp}.{본문}"의 HMAC-SHA256)
+const (
+	HeaderEvent     = "X-Parking-Event"
+	HeaderDelivery  = "X-Parking-Delivery"
+	HeaderTimestamp = "X-Parking-Timestamp"
+	HeaderSignature = "X-Parking-Signature"
+)
+
+// Event 서버 안에서 발생한 알림 이벤트 (구독과 맞춰 전송 기록을 만드는 것은 webhook 기능에서 처리)
+type Event struct {
+	ProjectID string
+	Type      string
+	Data      interface{}
+	Time      time.Time
+}
+
+// Envelope 전송 본문 (ID는 이벤트 ID, 같은 이벤트를 재시도하면 같은 ID)
+type Envelope struct {
+	ID         string      `json:"id"`
+	Type       string      `json:"type"`
+	ProjectID  string      `json:"project_id"`
+	OccurredAt string      `json:"occurred_at"`
+	Data       interface{} `json:"data"`
+}
+
+// ZoneOccupancy 실시간 학습 전후 구역 점유율 (ZoneID 0은 프로젝트 전체, 비율은 %)
+type ZoneOccupancy struct {
+	ZoneID        uint    `json:"zone_id"`
+	Lot           string  `json:"lot"`
+	Floor         string  `json:"floor"`
+	Zone          string  `json:"zone"`
+	Capacity      int     `json:"capacity"`
+	Occupied      int     `json:"occupied"`
+	Free          int     `json:"free"`
+	Unknown       int     `json:"unknown"`
+	OccupancyRate float64 `json:"occupancy_rate"`
+	PreviousRate  float64 `json:"previous_rate"`
+}
+
+// Crossed 점유율이 threshold를 넘었으면 "above", 내려갔으면 "below"
+func (z ZoneOccupancy) Crossed(threshold float64) (string, bool) {
+	switch {
+	case z.PreviousRate < threshold && z.OccupancyRate >= threshold:
+		return "above", true
+	case z.PreviousRate >= threshold && z.OccupancyRate < threshold:
+		return "below", true
+	}
+	return "", false
+}
+
+// 대기열이 가득 차면 이벤트를 버림 (전송 처리가 멈춰도 실시간 학습은 막히지 않도록)
+const queueSize = 1000
+
+var queue = make(chan Event, queueSize)
+
+// Emit 알림 이벤트 발행
+func Emit(projectID string, eventType string, data interface{}) {
+	select {
+	case queue <- Event{ProjectID: projectID, Type: eventType, Data: data, Time: time.Now()}:
+	default:
+		fmt.Printf("웹훅 이벤트 대기열이 가득 차 이벤트를 버립니다: %s %s\n", projectID, eventType)
+	}
+}
+
+// Events 발행된 알림 이벤트 (전송 처리에서 하나만 구독)
+func Events() <-chan Event {
+	return queue
+}
+
+// NewSecret 서명용 비밀 키 생성
+func NewSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(buf), nil
+}
+
+// Sign 전송 본문 서명 ("sha256=" + hex)
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify 수신 측 서명 확인 (timestamp는 헤더 값 그대로)
+func Verify(secret string, timestamp string, body []byte, signature string) bool {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal([]byte(Sign(secret, ts, body)), []byte(signature))
+}
+
+// 응답 본문은 기록용으로 앞부분만 읽음
+const maxResponseBody = 1000
+
+// Result 전송 한 번의 결과 (2xx가 아니면 Err 설정)
+type Result struct {
+	StatusCode int
+	Body       string
+	Err        error
+}
+
+// Send 서명한 본문을 POST로 전송
+func Send(ctx context.Context, client *http.Client, url string, secret string, eventType string, deliveryID string, body []byte) Result {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return Result{Err: err}
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "parking-manage-webhook/1.0")
+	req.Header.Set(HeaderEvent, eventType)
+	req.Header.Set(HeaderDelivery, deliveryID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(secret, timestamp, body))
+
+	res, err := client.Do(req)
+	if err != nil {
+		return Result{Err: err}
+	}
+	defer res.Body.Close()
+	data, _ := io.ReadAll(io.LimitReader(res.Body, maxResponseBody))
+	// 연결을 재사용할 수 있도록 남은 본문은 버림
+	io.Copy(io.Discard, res.Body)
+
+	// 잘린 본문도 DB에 저장할 수 있도록 깨진 UTF-8은 제거
+	result := Result{StatusCode: res.StatusCode, Body: strings.ToValidUTF8(string(data), "")}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		result.Err = fmt.Errorf("응답 상태 %d", res.StatusCode)
+	}
+	return result
+}
+
+// Backoff attempt번째 실패 후 다음 전송까지 기다릴 시간 (30초부터 두 배씩, 최대 1시간)
+func Backoff(attempt int) time.Duration {
+	delay := 30 * time.Second
+	for i := 1; i < attempt && delay < time.Hour; i++ {
+		delay *= 2
+	}
+	if delay > time.Hour {
+		delay = time.Hour
+	}
+	return delay
+}
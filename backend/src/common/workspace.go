@@ -0,0 +1,89 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// 프로젝트 ID는 업로드 경로의 폴더명으로 그대로 쓰이므로 안전한 문자만 허용
+var projectIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,50}$`)
+
+type workspaceKey struct{}
+
+// Workspace 프로젝트 작업 폴더 ({UPLOAD_PATH}/{projectId}) 정보
+type Workspace struct {
+	ProjectID string
+	Root      string
+}
+
+// IsValidProjectID 프로젝트 ID 형식 검사
+func IsValidProjectID(projectID string) bool {
+	return projectIDPattern.MatchString(projectID)
+}
+
+// ValidatePathSegment 경로 한 칸(폴더명/파일명)으로 쓰일 값 검사
+func ValidatePathSegment(segment string) error {
+	values := []string{segment}
+	if unescaped, err := url.PathUnescape(segment); err == nil && unescaped != segment {
+		values = append(values, unescaped)
+	}
+	for _, value := range values {
+		if value == "" || value == "." || value == ".." {
+			return fmt.Errorf("잘못된 경로 값입니다: %q", segment)
+		}
+		if strings.ContainsAny(value, "/\\\x00") {
+			return fmt.Errorf("경로 구분자는 사용할 수 없습니다: %q", segment)
+		}
+	}
+	return nil
+}
+
+// NewWorkspace 프로젝트 ID로 작업 폴더 루트(절대 경로) 생성
+func NewWorkspace(projectID string) (Workspace, error) {
+	if !IsValidProjectID(projectID) {
+		return Workspace{}, fmt.Errorf("잘못된 프로젝트 ID입니다: %q", projectID)
+	}
+	root, err := filepath.Abs(filepath.Join(Env.UploadPath, projectID))
+	if err != nil {
+		return Workspace{}, fmt.Errorf("작업 폴더 경로 변환 실패: %v", err)
+	}
+	return Workspace{ProjectID: projectID, Root: root}, nil
+}
+
+// Resolve 작업 폴더 기준으로 경로를 만들고 루트 밖으로 벗어나면 에러
+func (w Workspace) Resolve(elem ...string) (string, error) {
+	for _, e := range elem {
+		if strings.ContainsRune(e, '\x00') {
+			return "", fmt.Errorf("잘못된 경로 값입니다: %q", e)
+		}
+	}
+	target := filepath.Join(append([]string{w.Root}, elem...)...)
+	rel, err := filepath.Rel(w.Root, target)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("작업 폴더 밖의 경로는 사용할 수 없습니다: %s", filepath.Join(elem...))
+	}
+	return target, nil
+}
+
+// WithWorkspace context에 작업 폴더 저장
+func WithWorkspace(ctx context.Context, ws Workspace) context.Context {
+	return context.WithValue(ctx, workspaceKey{}, ws)
+}
+
+// WorkspaceFromContext context에 저장된 작업 폴더 조회
+func WorkspaceFromContext(ctx context.Context) (Workspace, bool) {
+	ws, ok := ctx.Value(workspaceKey{}).(Workspace)
+	return ws, ok
+}
+
+// ResolveWorkspace 미들웨어가 넣어둔 작업 폴더를 쓰고, 없으면 프로젝트 ID로 생성
+func ResolveWorkspace(ctx context.Context, projectID string) (Workspace, error) {
+	if ws, ok := WorkspaceFromContext(ctx); ok && ws.ProjectID == projectID {
+		return ws, nil
+	}
+	return NewWorkspace(projectID)
+}
@@ -0,0 +1,51 @@
+package zones
+
+import (
+	"fmt"
+	"main/common/db/mysql"
+	"regexp"
+)
+
+// 주차면 종류 (일반, 장애인, 전기차 등). 구역과 따로 저장해 구역을 다시 만들어도 유지됨
+
+// DefaultCategory 종류를 지정하지 않은 주차면
+const DefaultCategory = "general"
+
+// MaxCategoryLength 종류 이름 최대 길이 (parking_space_categories.category)
+const MaxCategoryLength = 30
+
+// 공개 API 응답의 키로도 쓰이므로 영문 소문자, 숫자, _, -만 허용
+var categoryPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// ValidateCategory 종류 이름 검사
+func ValidateCategory(category string) error {
+	switch {
+	case category == "":
+		return fmt.Errorf("category가 필요합니다")
+	case len(category) > MaxCategoryLength:
+		return fmt.Errorf("category는 %d자 이하여야 합니다: %s", MaxCategoryLength, category)
+	case !categoryPattern.MatchString(category):
+		return fmt.Errorf("category는 영문 소문자, 숫자, _, -만 사용할 수 있습니다: %s", category)
+	}
+	return nil
+}
+
+// Categories 주차면별 종류
+type Categories map[Space]string
+
+// NewCategories 저장된 종류로 조회용 맵 생성
+func NewCategories(rows []mysql.ParkingSpaceCategories) Categories {
+	categories := make(Categories, len(rows))
+	for _, row := range rows {
+		categories[Space{CctvID: row.CctvId, ParkingID: row.ParkingId}] = row.Category
+	}
+	return categories
+}
+
+// Of 주차면의 종류 (지정하지 않았으면 DefaultCategory)
+func (c Categories) Of(space Space) string {
+	if category, ok := c[space]; ok {
+		return category
+	}
+	return DefaultCategory
+}
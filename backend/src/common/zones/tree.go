@@ -0,0 +1,135 @@
+package zones
+
+import (
+	"main/common/db/mysql"
+	"sort"
+)
+
+// Status 주차면 점유 상태
+type Status int
+
+const (
+	// StatusUnknown 결과 없음 (점유율 계산에서 제외)
+	StatusUnknown Status = iota
+	StatusFree
+	StatusOccupied
+)
+
+// Counts 주차면 수 (Capacity = Occupied + Free + Unknown)
+type Counts struct {
+	Capacity int
+	Occupied int
+	Free     int
+	Unknown  int
+}
+
+func (c *Counts) add(status Status) {
+	c.Capacity++
+	switch status {
+	case StatusOccupied:
+		c.Occupied++
+	case StatusFree:
+		c.Free++
+	default:
+		c.Unknown++
+	}
+}
+
+// OccupancyRate 결과가 있는 주차면 중 점유 비율 (%, 결과가 하나도 없으면 0)
+func (c Counts) OccupancyRate() float64 {
+	known := c.Occupied + c.Free
+	if known == 0 {
+		return 0
+	}
+	return float64(c.Occupied) * 100 / float64(known)
+}
+
+// Tree 프로젝트 전체 구역 계층 (단계마다 이름 순)
+type Tree struct {
+	Counts
+	Lots []*Lot
+}
+
+type Lot struct {
+	Name string
+	Counts
+	Floors []*Floor
+}
+
+type Floor struct {
+	Name string
+	Counts
+	Zones []*Zone
+}
+
+type Zone struct {
+	ID   uint
+	Name string
+	Counts
+	Spaces []Space
+}
+
+// Build 구역과 주차면으로 계층을 만들고 상태별로 집계 (status가 nil이면 모두 Unknown)
+// 주차면이 없는 구역도 포함, 없는 구역을 가리키는 주차면은 무시
+func Build(zoneRows []mysql.ParkingZones, spaceRows []mysql.ParkingZoneSpaces, status func(Space) Status) Tree {
+	var tree Tree
+	lots := map[string]*Lot{}
+	floors := map[[2]string]*Floor{}
+	zonesByID := map[uint]*Zone{}
+	// 구역마다 함께 집계할 상위 단계 (프로젝트, 주차장, 층)
+	parents := map[uint][]*Counts{}
+
+	for _, row := range zoneRows {
+		lot, ok := lots[row.Lot]
+		if !ok {
+			lot = &Lot{Name: row.Lot}
+			lots[row.Lot] = lot
+			tree.Lots = append(tree.Lots, lot)
+		}
+		floorKey := [2]string{row.Lot, row.Floor}
+		floor, ok := floors[floorKey]
+		if !ok {
+			floor = &Floor{Name: row.Floor}
+			floors[floorKey] = floor
+			lot.Floors = append(lot.Floors, floor)
+		}
+		zone := &Zone{ID: row.ID, Name: row.Zone, Spaces: []Space{}}
+		zonesByID[row.ID] = zone
+		parents[row.ID] = []*Counts{&tree.Counts, &lot.Counts, &floor.Counts}
+		floor.Zones = append(floor.Zones, zone)
+	}
+
+	for _, row := range spaceRows {
+		zone, ok := zonesByID[row.ZoneId]
+		if !ok {
+			continue
+		}
+		space := Space{CctvID: row.CctvId, ParkingID: row.ParkingId}
+		spaceStatus := StatusUnknown
+		if status != nil {
+			spaceStatus = status(space)
+		}
+		zone.Spaces = append(zone.Spaces, space)
+		zone.add(spaceStatus)
+		for _, counts := range parents[row.ZoneId] {
+			counts.add(spaceStatus)
+		}
+	}
+
+	sort.Slice(tree.Lots, func(i, j int) bool { return tree.Lots[i].Name < tree.Lots[j].Name })
+	for _, lot := range tree.Lots {
+		sort.Slice(lot.Floors, func(i, j int) bool { return lot.Floors[i].Name < lot.Floors[j].Name })
+		for _, floor := range lot.Floors {
+			sort.Slice(floor.Zones, func(i, j int) bool { return floor.Zones[i].Name < floor.Zones[j].Name })
+			for _, zone := range floor.Zones {
+				sort.Slice(zone.Spaces, func(i, j int) bool {
+					if zone.Spaces[i].CctvID != zone.Spaces[j].CctvID {
+						return zone.Spaces[i].CctvID < zone.Spaces[j].CctvID
+					}
+					return zone.Spaces[i].ParkingID < zone.Spaces[j].ParkingID
+				})
+			}
+		}
+	}
+	return tree
+}
@@ -0,0 +1,186 @@
+package zones
+
+import (
+	"fmt"
+	"main/common/db/mysql"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// 주차장 구역 계층: 주차장(lot) → 층(floor) → 구역(zone) → 주차면(CCTV별 parking_id)
+//
+// 구역은 API로 직접 지정하거나 이름 규칙으로 만듦. 규칙은 CCTV ID와 parking_id에 정규식을 맞춰 보고
+// 이름 그룹(lot, floor, zone 등)을 템플릿에 채워 넣음.
+// 예) cctv_pattern "^(?P<lot>[^_]+)_(?P<floor>[^_]+)_(?P<zone>[^_]+)_" → P1_B3_1_3은 P1 / B3 / 1
+
+// DefaultName 규칙으로 이름을 정하지 못한 단계에 쓰는 이름
+const DefaultName = "default"
+
+// MaxNameLength lot/floor/zone, cctv_id, parking_id 최대 길이 (parking_zones, parking_zone_spaces)
+const MaxNameLength = 100
+
+// MaxPatternLength 규칙 정규식 최대 길이 (parking_zone_rules)
+const MaxPatternLength = 255
+
+// Key 구역 위치
+type Key struct {
+	Lot   string
+	Floor string
+	Zone  string
+}
+
+func (k Key) String() string {
+	return k.Lot + " / " + k.Floor + " / " + k.Zone
+}
+
+// Space 주차면 (CCTV별 parking_id)
+type Space struct {
+	CctvID    string
+	ParkingID string
+}
+
+// InUseError 웹훅 구독이 걸린 구역을 없애려 할 때의 에러 (구독을 먼저 지우거나 옮겨야 함)
+type InUseError struct {
+	Keys            []Key
+	SubscriptionIDs []uint
+}
+
+func (e *InUseError) Error() string {
+	names := make([]string, 0, len(e.Keys))
+	for _, key := range e.Keys {
+		names = append(names, key.String())
+	}
+	ids := make([]string, 0, len(e.SubscriptionIDs))
+	for _, id := range e.SubscriptionIDs {
+		ids = append(ids, fmt.Sprint(id))
+	}
+	return fmt.Sprintf("웹훅 구독(id %s)이 걸린 구역은 삭제할 수 없습니다: %s", strings.Join(ids, ", "), strings.Join(names, ", "))
+}
+
+// Rule 컴파일한 이름 규칙
+type Rule struct {
+	cctv    *regexp.Regexp
+	parking *regexp.Regexp
+	lot     string
+	floor   string
+	zone    string
+}
+
+// CompileRule 저장된 규칙의 정규식 컴파일 (lot/floor/zone이 비어 있으면 같은 이름의 그룹 사용)
+func CompileRule(row mysql.ParkingZoneRules) (Rule, error) {
+	rule := Rule{
+		lot:   defaultTemplate(row.Lot, "lot"),
+		floor: defaultTemplate(row.Floor, "floor"),
+		zone:  defaultTemplate(row.Zone, "zone"),
+	}
+	var err error
+	if rule.cctv, err = compilePattern(row.CctvPattern); err != nil {
+		return Rule{}, fmt.Errorf("cctv_pattern 오류: %v", err)
+	}
+	if rule.parking, err = compilePattern(row.ParkingPattern); err != nil {
+		return Rule{}, fmt.Errorf("parking_pattern 오류: %v", err)
+	}
+	return rule, nil
+}
+
+// CompileRules priority 순으로 정렬해 컴파일 (몇 번째 규칙이 틀렸는지 알려줌)
+func CompileRules(rows []mysql.ParkingZoneRules) ([]Rule, error) {
+	sorted := append([]mysql.ParkingZoneRules{}, rows...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Priority < sorted[j].Priority })
+
+	rules := make([]Rule, 0, len(sorted))
+	for i, row := range sorted {
+		rule, err := CompileRule(row)
+		if err != nil {
+			return nil, fmt.Errorf("%d번째 규칙: %v", i+1, err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// Match 주차면이 규칙에 맞으면 구역 위치 반환
+func (r Rule) Match(space Space) (Key, bool) {
+	groups := map[string]string{}
+	if !matchGroups(r.cctv, space.CctvID, groups) || !matchGroups(r.parking, space.ParkingID, groups) {
+		return Key{}, false
+	}
+	expand := func(template string) string {
+		value := strings.TrimSpace(os.Expand(template, func(name string) string { return groups[name] }))
+		if value == "" {
+			return DefaultName
+		}
+		return value
+	}
+	return Key{Lot: expand(r.lot), Floor: expand(r.floor), Zone: expand(r.zone)}, true
+}
+
+// Classify 처음 맞는 규칙의 구역 위치
+func Classify(rules []Rule, space Space) (Key, bool) {
+	for _, rule := range rules {
+		if key, ok := rule.Match(space); ok {
+			return key, true
+		}
+	}
+	return Key{}, false
+}
+
+// ValidateKey 구역 이름 검사 (비어 있거나 너무 길면 오류)
+func ValidateKey(key Key) error {
+	for _, name := range []struct{ field, value string }{{"lot", key.Lot}, {"floor", key.Floor}, {"zone", key.Zone}} {
+		if strings.TrimSpace(name.value) == "" {
+			return fmt.Errorf("%s가 필요합니다", name.field)
+		}
+		if len(name.value) > MaxNameLength {
+			return fmt.Errorf("%s는 %d자 이하여야 합니다: %s", name.field, MaxNameLength, name.value)
+		}
+	}
+	return nil
+}
+
+// ValidateSpace 주차면 검사
+func ValidateSpace(space Space) error {
+	switch {
+	case space.CctvID == "" || space.ParkingID == "":
+		return fmt.Errorf("cctv_id와 parking_id가 필요합니다")
+	case len(space.CctvID) > MaxNameLength || len(space.ParkingID) > MaxNameLength:
+		return fmt.Errorf("cctv_id와 parking_id는 %d자 이하여야 합니다: %s/%s", MaxNameLength, space.CctvID, space.ParkingID)
+	}
+	return nil
+}
+
+func defaultTemplate(template string, group string) string {
+	if strings.TrimSpace(template) == "" {
+		return "${" + group + "}"
+	}
+	return template
+}
+
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	if len(pattern) > MaxPatternLength {
+		return nil, fmt.Errorf("정규식은 %d자 이하여야 합니다", MaxPatternLength)
+	}
+	return regexp.Compile(pattern)
+}
+
+// matchGroups 정규식이 없으면 항상 일치, 있으면 이름 그룹 값을 groups에 기록
+func matchGroups(re *regexp.Regexp, value string, groups map[string]string) bool {
+	if re == nil {
+		return true
+	}
+	match := re.FindStringSubmatch(value)
+	if match == nil {
+		return false
+	}
+	for i, name := range re.SubexpNames() {
+		if name != "" && match[i] != "" {
+			groups[name] = match[i]
+		}
+	}
+	return true
+}
@@ -1,8 +1,12 @@
 package features
 
 import (
+	jobHandler "main/features/job/handler"
 	parkingHandler "main/features/parking/handler"
+	projectHandler "main/features/project/handler"
+	publicHandler "main/features/public/handler"
 	roiHandler "main/features/roi/handler"
+	webhookHandler "main/features/webhook/handler"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -14,8 +18,12 @@ func InitHandler(e *echo.Echo) error {
 		return c.NoContent(http.StatusOK)
 	})
 
+	projectHandler.NewProjectHandler(e)
 	parkingHandler.NewParkingHandler(e)
+	jobHandler.NewJobHandler(e)
 	roiHandler.NewRoiHandler(e)
+	webhookHandler.NewWebhookHandler(e)
+	publicHandler.NewPublicHandler(e)
 
 	return nil
 }
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/job/model/interface"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CancelJobHandler struct {
+	UseCase _interface.ICancelJobUseCase
+}
+
+func NewCancelJobHandler(c *echo.Echo, useCase _interface.ICancelJobUseCase) _interface.ICancelJobHandler {
+	handler := &CancelJobHandler{
+		UseCase: useCase,
+	}
+	c.DELETE("/v0.1/jobs/:jobId", handler.CancelJob)
+	return handler
+}
+
+// CancelJob 학습 작업 취소
+// @Router /v0.1/jobs/{jobId} [delete]
+// @Summary 학습 작업 취소
+// @Description
+// @Description 대기 중인 작업은 바로 cancelled 처리하고, 실행 중인 작업은 OpenCV 프로세스 그룹을 종료합니다.
+// @Description 실행 중인 작업은 종료가 끝나면 cancelled 상태가 되며 결과 폴더는 삭제됩니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 잘못된 작업 ID
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 작업 없음
+// @Description
+// @Description ■ errCode with 409
+// @Description CONFLICT : 이미 종료된 작업
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : DB 처리 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param        jobId   path      int  true  "Job ID"
+// @Success 202 {object} response.ResJob
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags job
+func (d *CancelJobHandler) CancelJob(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	jobID := c.Param("jobId")
+	if jobID == "" {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "jobId가 필요합니다",
+		})
+	}
+
+	res, err := d.UseCase.CancelJob(ctx, jobID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusAccepted, res)
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/job/model/interface"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type GetJobHandler struct {
+	UseCase _interface.IGetJobUseCase
+}
+
+func NewGetJobHandler(c *echo.Echo, useCase _interface.IGetJobUseCase) _interface.IGetJobHandler {
+	handler := &GetJobHandler{
+		UseCase: useCase,
+	}
+	c.GET("/v0.1/jobs/:jobId", handler.GetJob)
+	return handler
+}
+
+// GetJob 학습 작업 조회
+// @Router /v0.1/jobs/{jobId} [get]
+// @Summary 학습 작업 조회
+// @Description
+// @Description 학습 작업의 상태(queued/running/succeeded/failed/cancelled)와 실행 결과를 조회합니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 잘못된 작업 ID
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 작업 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : DB 처리 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param        jobId   path      int  true  "Job ID"
+// @Success 200 {object} response.ResJob
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags job
+func (d *GetJobHandler) GetJob(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	jobID := c.Param("jobId")
+	if jobID == "" {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "jobId가 필요합니다",
+		})
+	}
+
+	res, err := d.UseCase.GetJob(ctx, jobID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
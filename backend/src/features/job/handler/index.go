@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"main/common/db/mysql"
+	"main/features/job/repository"
+	"main/features/job/usecase"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+func NewJobHandler(e *echo.Echo) error {
+	// Repository 초기화
+	getJobRepo := repository.NewGetJobRepository(mysql.GormMysqlDB)
+	listProjectJobRepo := repository.NewListProjectJobRepository(mysql.GormMysqlDB)
+	cancelJobRepo := repository.NewCancelJobRepository(mysql.GormMysqlDB)
+
+	// UseCase 초기화
+	getJobUseCase := usecase.NewGetJobUseCase(getJobRepo, 30*time.Second)
+	listProjectJobUseCase := usecase.NewListProjectJobUseCase(listProjectJobRepo, 30*time.Second)
+	cancelJobUseCase := usecase.NewCancelJobUseCase(cancelJobRepo, 30*time.Second)
+
+	// Handler 초기화
+	NewGetJobHandler(e, getJobUseCase)
+	NewListProjectJobHandler(e, listProjectJobUseCase)
+	NewCancelJobHandler(e, cancelJobUseCase)
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/job/model/interface"
+	"main/features/job/model/request"
+	"main/features/job/usecase"
+	_middleware "main/middleware"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ListProjectJobHandler struct {
+	UseCase _interface.IListProjectJobUseCase
+}
+
+func NewListProjectJobHandler(c *echo.Echo, useCase _interface.IListProjectJobUseCase) _interface.IListProjectJobHandler {
+	handler := &ListProjectJobHandler{
+		UseCase: useCase,
+	}
+	c.GET("/v0.1/projects/:projectId/jobs", handler.ListProjectJob, _middleware.ProjectScope)
+	return handler
+}
+
+// ListProjectJob 프로젝트 학습 작업 목록 조회
+// @Router /v0.1/projects/{projectId}/jobs [get]
+// @Summary 프로젝트 학습 작업 목록 조회
+// @Description
+// @Description 프로젝트의 학습 작업을 최신 순으로 조회합니다. status로 필터링할 수 있습니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 잘못된 파라미터
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 프로젝트 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : DB 처리 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param        projectId   path      string  true   "Project ID"
+// @Param        status      query     string  false  "queued | running | succeeded | failed | cancelled"
+// @Param        limit       query     int     false  "최대 개수 (기본 50, 최대 200)"
+// @Success 200 {object} response.ResListJob
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags job
+func (d *ListProjectJobHandler) ListProjectJob(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	projectID := c.Param("projectId")
+
+	var req request.ReqListJob
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "요청 파라미터 파싱 실패",
+		})
+	}
+	if err := usecase.ValidateListJobRequest(req); err != nil {
+		return err
+	}
+
+	res, err := d.UseCase.ListProjectJob(ctx, projectID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
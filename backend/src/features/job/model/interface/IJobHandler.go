@@ -0,0 +1,15 @@
+package _interface
+
+import "github.com/labstack/echo/v4"
+
+type IGetJobHandler interface {
+	GetJob(c echo.Context) error
+}
+
+type IListProjectJobHandler interface {
+	ListProjectJob(c echo.Context) error
+}
+
+type ICancelJobHandler interface {
+	CancelJob(c echo.Context) error
+}
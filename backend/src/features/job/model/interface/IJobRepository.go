@@ -0,0 +1,19 @@
+package _interface
+
+import (
+	"context"
+	"main/common/db/mysql"
+)
+
+type IGetJobRepository interface {
+	FindLearningJobByID(ctx context.Context, jobID uint) (mysql.LearningJobs, error)
+}
+
+type IListProjectJobRepository interface {
+	FindLearningJobsByProject(ctx context.Context, projectID string, status string, limit int) ([]mysql.LearningJobs, error)
+}
+
+type ICancelJobRepository interface {
+	FindLearningJobByID(ctx context.Context, jobID uint) (mysql.LearningJobs, error)
+	CancelUnfinishedLearningJob(ctx context.Context, jobID uint, message string) error
+}
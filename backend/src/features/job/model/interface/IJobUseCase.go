@@ -0,0 +1,19 @@
+package _interface
+
+import (
+	"context"
+	"main/features/job/model/request"
+	"main/features/job/model/response"
+)
+
+type IGetJobUseCase interface {
+	GetJob(ctx context.Context, jobID string) (response.ResJob, error)
+}
+
+type IListProjectJobUseCase interface {
+	ListProjectJob(ctx context.Context, projectID string, req request.ReqListJob) (response.ResListJob, error)
+}
+
+type ICancelJobUseCase interface {
+	CancelJob(ctx context.Context, jobID string) (response.ResJob, error)
+}
@@ -0,0 +1,6 @@
+package request
+
+type ReqListJob struct {
+	Status string `query:"status"`
+	Limit  int    `query:"limit"`
+}
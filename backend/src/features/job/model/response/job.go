@@ -0,0 +1,38 @@
+package response
+
+type ResListJob struct {
+	Success bool      `json:"success"`
+	Data    []JobItem `json:"data"`
+}
+
+type ResJob struct {
+	Success bool    `json:"success"`
+	Data    JobItem `json:"data"`
+}
+
+type JobItem struct {
+	ID                  uint      `json:"id"`
+	ProjectID           string    `json:"project_id"`
+	SweepID             *uint     `json:"sweep_id"`
+	Status              string    `json:"status"`
+	Params              JobParams `json:"params"`
+	ResultFolder        string    `json:"result_folder"`
+	ExperimentSessionID *int      `json:"experiment_session_id"`
+	ExitCode            *int      `json:"exit_code"`
+	StdoutTail          string    `json:"stdout_tail"`
+	StderrTail          string    `json:"stderr_tail"`
+	ErrorMessage        string    `json:"error_message"`
+	DurationMs          int64     `json:"duration_ms"`
+	CreatedAt           string    `json:"created_at"`
+	StartedAt           string    `json:"started_at"`
+	FinishedAt          string    `json:"finished_at"`
+}
+
+type JobParams struct {
+	VarThreshold float64 `json:"var_threshold"`
+	LearningRate float64 `json:"learning_rate"`
+	Iterations   int     `json:"iterations"`
+	LearningPath string  `json:"learning_path"`
+	TestPath     string  `json:"test_path"`
+	RoiPath      string  `json:"roi_path"`
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/job/model/interface"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func NewCancelJobRepository(gormDB *gorm.DB) _interface.ICancelJobRepository {
+	return &CancelJobRepository{GormDB: gormDB}
+}
+
+func (r *CancelJobRepository) FindLearningJobByID(ctx context.Context, jobID uint) (mysql.LearningJobs, error) {
+	return findLearningJobByID(ctx, r.GormDB, jobID)
+}
+
+// CancelUnfinishedLearningJob 대기/실행 중인 작업만 취소 상태로 변경 (이미 끝난 작업은 그대로)
+func (r *CancelJobRepository) CancelUnfinishedLearningJob(ctx context.Context, jobID uint, message string) error {
+	result := r.GormDB.WithContext(ctx).Model(&mysql.LearningJobs{}).
+		Where("id = ? AND status IN ?", jobID, []string{mysql.LearningJobStatusQueued, mysql.LearningJobStatusRunning}).
+		Updates(map[string]interface{}{
+			"status":        mysql.LearningJobStatusCancelled,
+			"error_message": message,
+			"finished_at":   time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/job/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewGetJobRepository(gormDB *gorm.DB) _interface.IGetJobRepository {
+	return &GetJobRepository{GormDB: gormDB}
+}
+
+func (r *GetJobRepository) FindLearningJobByID(ctx context.Context, jobID uint) (mysql.LearningJobs, error) {
+	return findLearningJobByID(ctx, r.GormDB, jobID)
+}
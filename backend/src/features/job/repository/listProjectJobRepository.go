@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/job/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewListProjectJobRepository(gormDB *gorm.DB) _interface.IListProjectJobRepository {
+	return &ListProjectJobRepository{GormDB: gormDB}
+}
+
+func (r *ListProjectJobRepository) FindLearningJobsByProject(ctx context.Context, projectID string, status string, limit int) ([]mysql.LearningJobs, error) {
+	var jobs []mysql.LearningJobs
+	query := r.GormDB.WithContext(ctx).Where("project_id = ?", projectID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	result := query.Order("id DESC").Limit(limit).Find(&jobs)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return jobs, nil
+}
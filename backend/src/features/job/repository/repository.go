@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+
+	"gorm.io/gorm"
+)
+
+type GetJobRepository struct {
+	GormDB *gorm.DB
+}
+
+type ListProjectJobRepository struct {
+	GormDB *gorm.DB
+}
+
+type CancelJobRepository struct {
+	GormDB *gorm.DB
+}
+
+// findLearningJobByID 작업 단건 조회 (없으면 gorm.ErrRecordNotFound)
+func findLearningJobByID(ctx context.Context, db *gorm.DB, jobID uint) (mysql.LearningJobs, error) {
+	var job mysql.LearningJobs
+	result := db.WithContext(ctx).Where("id = ?", jobID).First(&job)
+	if result.Error != nil {
+		return mysql.LearningJobs{}, result.Error
+	}
+	return job, nil
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"main/common"
+	"main/common/db/mysql"
+	"main/common/jobevents"
+	"main/common/jobqueue"
+	_interface "main/features/job/model/interface"
+	"main/features/job/model/response"
+	"strconv"
+	"time"
+)
+
+type CancelJobUseCase struct {
+	Repository     _interface.ICancelJobRepository
+	ContextTimeout time.Duration
+}
+
+func NewCancelJobUseCase(repo _interface.ICancelJobRepository, timeout time.Duration) _interface.ICancelJobUseCase {
+	return &CancelJobUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+func (d *CancelJobUseCase) CancelJob(c context.Context, jobID string) (response.ResJob, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	job, err := findJob(ctx, d.Repository.FindLearningJobByID, jobID)
+	if err != nil {
+		return response.ResJob{}, err
+	}
+	if isFinishedJob(job) {
+		return response.ResJob{}, common.ErrorMsg(ctx, common.ErrConflict, common.Trace(), fmt.Sprintf("이미 종료된 작업입니다: %s (%s)", jobID, job.Status), common.ErrFromClient)
+	}
+
+	// 실행 중이면 워커가 프로세스를 종료하고 cancelled로 기록, 그 외에는 여기서 바로 기록
+	key := strconv.FormatUint(uint64(job.ID), 10)
+	if jobqueue.LearningQueue.Cancel(key) != jobqueue.CancelSignalled {
+		message := "작업이 취소되었습니다"
+		if err := d.Repository.CancelUnfinishedLearningJob(ctx, job.ID, message); err != nil {
+			return response.ResJob{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("작업 취소 실패: %v", err), common.ErrFromMysqlDB)
+		}
+		jobevents.LearningEvents.Finish(key, jobevents.JobState{Status: mysql.LearningJobStatusCancelled, ErrorMessage: message})
+	}
+
+	job, err = findJob(ctx, d.Repository.FindLearningJobByID, jobID)
+	if err != nil {
+		return response.ResJob{}, err
+	}
+
+	return response.ResJob{
+		Success: true,
+		Data:    toJobItem(job),
+	}, nil
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+	_interface "main/features/job/model/interface"
+	"main/features/job/model/response"
+	"time"
+)
+
+type GetJobUseCase struct {
+	Repository     _interface.IGetJobRepository
+	ContextTimeout time.Duration
+}
+
+func NewGetJobUseCase(repo _interface.IGetJobRepository, timeout time.Duration) _interface.IGetJobUseCase {
+	return &GetJobUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+func (d *GetJobUseCase) GetJob(c context.Context, jobID string) (response.ResJob, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	job, err := findJob(ctx, d.Repository.FindLearningJobByID, jobID)
+	if err != nil {
+		return response.ResJob{}, err
+	}
+
+	return response.ResJob{
+		Success: true,
+		Data:    toJobItem(job),
+	}, nil
+}
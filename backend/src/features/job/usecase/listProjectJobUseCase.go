@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"main/common"
+	_interface "main/features/job/model/interface"
+	"main/features/job/model/request"
+	"main/features/job/model/response"
+	"time"
+)
+
+type ListProjectJobUseCase struct {
+	Repository     _interface.IListProjectJobRepository
+	ContextTimeout time.Duration
+}
+
+func NewListProjectJobUseCase(repo _interface.IListProjectJobRepository, timeout time.Duration) _interface.IListProjectJobUseCase {
+	return &ListProjectJobUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+func (d *ListProjectJobUseCase) ListProjectJob(c context.Context, projectID string, req request.ReqListJob) (response.ResListJob, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultJobListLimit
+	}
+
+	jobs, err := d.Repository.FindLearningJobsByProject(ctx, projectID, req.Status, limit)
+	if err != nil {
+		return response.ResListJob{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("작업 목록 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	items := []response.JobItem{}
+	for _, job := range jobs {
+		items = append(items, toJobItem(job))
+	}
+
+	return response.ResListJob{
+		Success: true,
+		Data:    items,
+	}, nil
+}
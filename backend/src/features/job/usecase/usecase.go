@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/common"
+	"main/common/db/mysql"
+	"main/features/job/model/request"
+	"main/features/job/model/response"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultJobListLimit = 50
+	maxJobListLimit     = 200
+)
+
+// 파라미터 검증 함수
+func ValidateListJobRequest(req request.ReqListJob) error {
+	if req.Status != "" && !isValidJobStatus(req.Status) {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("status는 queued, running, succeeded, failed, cancelled 중 하나여야 합니다. %s", req.Status))
+	}
+	if req.Limit < 0 || req.Limit > maxJobListLimit {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("limit는 0 이상 %d 이하여야 합니다. %d", maxJobListLimit, req.Limit))
+	}
+	return nil
+}
+
+func isValidJobStatus(status string) bool {
+	switch status {
+	case mysql.LearningJobStatusQueued, mysql.LearningJobStatusRunning, mysql.LearningJobStatusSucceeded, mysql.LearningJobStatusFailed, mysql.LearningJobStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// parseJobID path 파라미터의 작업 ID 변환
+func parseJobID(jobID string) (uint, error) {
+	id, err := strconv.ParseUint(jobID, 10, 32)
+	if err != nil || id == 0 {
+		return 0, fmt.Errorf("잘못된 작업 ID입니다: %s", jobID)
+	}
+	return uint(id), nil
+}
+
+// findJob 작업 ID 검사 후 조회 (없으면 NOT_FOUND 에러로 변환)
+func findJob(ctx context.Context, find func(context.Context, uint) (mysql.LearningJobs, error), jobID string) (mysql.LearningJobs, error) {
+	id, err := parseJobID(jobID)
+	if err != nil {
+		return mysql.LearningJobs{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+	job, err := find(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return mysql.LearningJobs{}, common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), fmt.Sprintf("작업을 찾을 수 없습니다: %s", jobID), common.ErrFromClient)
+	}
+	if err != nil {
+		return mysql.LearningJobs{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("작업 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	return job, nil
+}
+
+func isFinishedJob(job mysql.LearningJobs) bool {
+	return job.Status != mysql.LearningJobStatusQueued && job.Status != mysql.LearningJobStatusRunning
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// toJobItem 작업 응답 변환 (경로는 서버 경로 대신 폴더명/파일명만 노출)
+func toJobItem(job mysql.LearningJobs) response.JobItem {
+	return response.JobItem{
+		ID:        job.ID,
+		ProjectID: job.ProjectId,
+		SweepID:   job.SweepId,
+		Status:    job.Status,
+		Params: response.JobParams{
+			VarThreshold: job.VarThreshold,
+			LearningRate: job.LearningRate,
+			Iterations:   job.Iterations,
+			LearningPath: filepath.Base(job.LearningPath),
+			TestPath:     filepath.Base(job.TestImagePath),
+			RoiPath:      filepath.Base(job.RoiPath),
+		},
+		ResultFolder:        job.ResultFolder,
+		ExperimentSessionID: job.ExperimentSessionId,
+		ExitCode:            job.ExitCode,
+		StdoutTail:          job.StdoutTail,
+		StderrTail:          job.StderrTail,
+		ErrorMessage:        job.ErrorMessage,
+		DurationMs:          job.DurationMs,
+		CreatedAt:           job.CreatedAt.Format(time.RFC3339),
+		StartedAt:           formatTime(job.StartedAt),
+		FinishedAt:          formatTime(job.FinishedAt),
+	}
+}
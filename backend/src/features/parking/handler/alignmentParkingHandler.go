@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"main/common"
+	"main/common/camshift"
+	_interface "main/features/parking/model/interface"
+
+	"github.com/labstack/echo/v4"
+)
+
+type AlignmentParkingHandler struct {
+	UseCase _interface.IAlignmentParkingUseCase
+}
+
+func NewAlignmentParkingHandler(c *echo.Group, useCase _interface.IAlignmentParkingUseCase) _interface.IAlignmentParkingHandler {
+	handler := &AlignmentParkingHandler{
+		UseCase: useCase,
+	}
+	c.GET("/:projectId/alignment", handler.GetAlignment)
+	return handler
+}
+
+// 카메라 흔들림 검사
+// @Router /v0.1/parking/{projectId}/alignment [get]
+// @Summary 카메라 흔들림 검사
+// @Description CCTV별 최신 이미지를 ROI 저장 시 기록한 기준 프레임과 위상 상관으로 비교해 화면이 움직인 양을 추정합니다.
+// @Description 움직인 거리가 tolerance(원본 픽셀)보다 크면 misaligned로 표시하고 suggested_translate를 반환합니다.
+// @Description suggested_translate는 ROI 일괄 변환(POST /v0.1/roi/{projectId}/transform)의 translate에 그대로 넣으면 됩니다.
+// @Description 기준 프레임이 없으면 no_reference, 장면이 많이 달라 추정할 수 없으면 uncertain, 해상도가 다르면 size_mismatch 입니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 잘못된 source, folder 또는 tolerance
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_SERVER : 이미지 폴더 읽기 실패
+// @Description
+// @Produce json
+// @Param projectId path string true "프로젝트 ID"
+// @Param source query string false "current(currentImages, 기본) 또는 test(uploads/testImages)"
+// @Param folder query string false "source=test일 때 테스트 폴더 이름 (없으면 전체)"
+// @Param tolerance query number false "허용 이동 거리 (원본 픽셀, 기본 5)"
+// @Success 200 {object} response.ResAlignment
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags parking
+func (d *AlignmentParkingHandler) GetAlignment(c echo.Context) error {
+	source := c.QueryParam("source")
+	if source == "" {
+		source = "current"
+	}
+	tolerance := camshift.DefaultTolerance
+	if raw := c.QueryParam("tolerance"); raw != "" {
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil || value < 0 {
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"success": false,
+				"message": "tolerance는 0 이상의 숫자여야 합니다",
+			})
+		}
+		tolerance = value
+	}
+
+	ctx, _, _ := common.CtxGenerate(c)
+	result, err := d.UseCase.GetAlignment(ctx, c.Param("projectId"), source, c.QueryParam("folder"), tolerance)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
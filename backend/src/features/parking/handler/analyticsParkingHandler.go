@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"net/http"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/request"
+	"main/features/parking/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type AnalyticsParkingHandler struct {
+	UseCase _interface.IAnalyticsParkingUseCase
+}
+
+func NewAnalyticsParkingHandler(c *echo.Group, useCase _interface.IAnalyticsParkingUseCase) _interface.IAnalyticsParkingHandler {
+	handler := &AnalyticsParkingHandler{
+		UseCase: useCase,
+	}
+	c.GET("/:projectId/analytics", handler.GetAnalytics)
+	return handler
+}
+
+// 주차 분석 (시간대별 점유율, 체류 시간, 회전율)
+// @Router /v0.1/parking/{projectId}/analytics [get]
+// @Summary 주차 분석
+// @Description 점유 이력으로 기간(from ~ to, 서버 시간대 기준 날짜) 동안의 주차 현황을 분석합니다.
+// @Description hour_of_day / day_of_week : 시간대별, 요일별 점유율 (관측된 점 중 점유 비율 %)
+// @Description peak : 점유율이 가장 높았던 5분 구간
+// @Description dwell : 빈 자리 → 점유 → 빈 자리가 모두 기간 안에서 관측된 구간의 평균/중앙 체류 시간 (분)
+// @Description turnover : 날짜별 입차 수(빈 자리 → 점유)와 주차면당 회전율, space_stats : 주차면별 입차 수, 일평균 회전율, 체류 시간
+// @Description 체류 시간과 회전율은 원본 점으로만 계산하므로 raw_from(원본 점이 남아 있는 첫 날짜) 이후만 계산합니다.
+// @Description 보관 기간(OCCUPANCY_RAW_RETENTION_DAYS)이 지나 시간 단위로 줄인 날짜는 turnover의 arrivals/turnover_per_space가 null이고, turnover_per_day는 raw_from 이후 날짜 수로 나눕니다.
+// @Description format=csv면 같은 내용을 표마다 머리글이 있는 CSV(빈 줄로 구분)로 내려받습니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 잘못된 기간 또는 format
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 구역 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 조회 실패
+// @Description
+// @Produce json
+// @Produce text/csv
+// @Param projectId path string true "프로젝트 ID"
+// @Param zoneId query int false "구역 ID (구역의 주차면만)"
+// @Param cctvId query string false "CCTV ID"
+// @Param from query string false "시작 날짜 YYYY-MM-DD (기본 to의 6일 전)"
+// @Param to query string false "끝 날짜 YYYY-MM-DD, 포함 (기본 오늘, 최대 92일)"
+// @Param format query string false "json (기본) | csv"
+// @Success 200 {object} response.ResParkingAnalytics
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags parking
+func (d *AnalyticsParkingHandler) GetAnalytics(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	var req request.ReqParkingAnalytics
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "요청 파라미터 파싱 실패",
+		})
+	}
+	if req.Format != "" && req.Format != "json" && req.Format != "csv" {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "format은 json 또는 csv여야 합니다",
+		})
+	}
+
+	result, err := d.UseCase.GetAnalytics(ctx, c.Param("projectId"), req)
+	if err != nil {
+		return err
+	}
+	if req.Format != "csv" {
+		return c.JSON(http.StatusOK, result)
+	}
+
+	data, err := usecase.ParkingAnalyticsCSV(result)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"success": false,
+			"message": "CSV 생성 실패: " + err.Error(),
+		})
+	}
+	c.Response().Header().Set("Content-Disposition", `attachment; filename="`+result.ProjectID+`_analytics_`+result.From+`_`+result.To+`.csv"`)
+	return c.Blob(http.StatusOK, "text/csv; charset=utf-8", data)
+}
@@ -13,11 +13,11 @@ type BatchImagesParkingHandler struct {
 	UseCase _interface.IBatchImagesParkingUseCase
 }
 
-func NewBatchImagesParkingHandler(c *echo.Echo, useCase _interface.IBatchImagesParkingUseCase) _interface.IBatchImagesParkingHandler {
+func NewBatchImagesParkingHandler(c *echo.Group, useCase _interface.IBatchImagesParkingUseCase) _interface.IBatchImagesParkingHandler {
 	handler := &BatchImagesParkingHandler{
 		UseCase: useCase,
 	}
-	c.POST("/v0.1/parking/:projectId/images/batch", handler.BatchImages)
+	c.POST("/:projectId/images/batch", handler.BatchImages)
 	return handler
 }
 
@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"net/http"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CategoryGetParkingHandler struct {
+	UseCase _interface.ICategoryGetParkingUseCase
+}
+
+func NewCategoryGetParkingHandler(c *echo.Group, useCase _interface.ICategoryGetParkingUseCase) _interface.ICategoryGetParkingHandler {
+	handler := &CategoryGetParkingHandler{
+		UseCase: useCase,
+	}
+	c.GET("/:projectId/categories", handler.GetCategories)
+	return handler
+}
+
+// 주차면 종류 조회
+// @Router /v0.1/parking/{projectId}/categories [get]
+// @Summary 주차면 종류 조회
+// @Description 종류를 지정한 주차면 목록과 사용 중인 종류를 반환합니다. 목록에 없는 주차면은 general입니다.
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 조회 실패
+// @Description
+// @Produce json
+// @Param projectId path string true "프로젝트 ID"
+// @Success 200 {object} response.ResCategories
+// @Failure 500 {object} map[string]interface{}
+// @Tags parking
+func (d *CategoryGetParkingHandler) GetCategories(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	result, err := d.UseCase.GetCategories(ctx, c.Param("projectId"))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"net/http"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/request"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CategorySaveParkingHandler struct {
+	UseCase _interface.ICategorySaveParkingUseCase
+}
+
+func NewCategorySaveParkingHandler(c *echo.Group, useCase _interface.ICategorySaveParkingUseCase) _interface.ICategorySaveParkingHandler {
+	handler := &CategorySaveParkingHandler{
+		UseCase: useCase,
+	}
+	c.PUT("/:projectId/categories", handler.SaveCategories)
+	return handler
+}
+
+// 주차면 종류 저장
+// @Router /v0.1/parking/{projectId}/categories [put]
+// @Summary 주차면 종류 저장
+// @Description 주차면 종류 전체를 교체합니다. 목록에 없는 주차면은 general로 집계됩니다.
+// @Description category : 영문 소문자, 숫자, _, - (30자 이하, 예: general, disabled, ev)
+// @Description 구역과 따로 저장되어 zones/derive로 구역을 다시 만들어도 유지됩니다. 공개 가용 현황의 종류별 집계에 사용됩니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 잘못된 종류 이름, 같은 주차면 중복, 길이 초과
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 저장 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param projectId path string true "프로젝트 ID"
+// @Param request body request.ReqSaveCategories true "주차면 종류"
+// @Success 200 {object} response.ResCategories
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags parking
+func (d *CategorySaveParkingHandler) SaveCategories(c echo.Context) error {
+	var req request.ReqSaveCategories
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "Invalid request body",
+		})
+	}
+
+	ctx, _, _ := common.CtxGenerate(c)
+	result, err := d.UseCase.SaveCategories(ctx, c.Param("projectId"), req)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
@@ -13,11 +13,11 @@ type CctvImageParkingHandler struct {
 	UseCase _interface.ICctvImageParkingUseCase
 }
 
-func NewCctvImageParkingHandler(c *echo.Echo, useCase _interface.ICctvImageParkingUseCase) _interface.ICctvImageParkingHandler {
+func NewCctvImageParkingHandler(c *echo.Group, useCase _interface.ICctvImageParkingUseCase) _interface.ICctvImageParkingHandler {
 	handler := &CctvImageParkingHandler{
 		UseCase: useCase,
 	}
-	c.GET("/v0.1/parking/:projectId/:cctvId/images/:imageType", handler.GetCctvImage)
+	c.GET("/:projectId/:cctvId/images/:imageType", handler.GetCctvImage)
 	return handler
 }
 
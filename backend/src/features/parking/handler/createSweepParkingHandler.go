@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"net/http"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/request"
+	"main/features/parking/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CreateSweepParkingHandler struct {
+	UseCase _interface.ICreateSweepParkingUseCase
+}
+
+func NewCreateSweepParkingHandler(c *echo.Group, useCase _interface.ICreateSweepParkingUseCase) _interface.ICreateSweepParkingHandler {
+	handler := &CreateSweepParkingHandler{
+		UseCase: useCase,
+	}
+	c.POST("/:projectId/sweeps", handler.CreateSweep)
+	return handler
+}
+
+// 하이퍼파라미터 스윕 실행
+// @Router /v0.1/parking/{projectId}/sweeps [post]
+// @Summary 하이퍼파라미터 스윕 실행
+// @Description learningRate / iterations / varThreshold의 모든 조합을 각각 학습 작업으로 등록합니다.
+// @Description 각 파라미터는 {"values":[...]} 또는 {"min":..,"max":..,"step":..} 형식입니다. (최대 50개 조합)
+// @Description 조합별 진행 상태는 GET /v0.1/jobs/{jobId}, 순위는 GET /v0.1/parking/{projectId}/sweeps/{sweepId} 로 조회합니다.
+// @Description occupancyThreshold(기본 0.4)는 정답 라벨과 비교할 때 차량 있음으로 판정하는 전경 비율입니다.
+// @Description 등록 도중 실패하면 그때까지 대기열에 넣은 작업만 job_ids에 담아 반환하고(total < requested) error에 실패 이유를 넣습니다.
+// @Description 이 작업들은 DELETE /v0.1/jobs/{jobId}로 취소할 수 있습니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 잘못된 파라미터 또는 경로
+// @Description
+// @Description ■ errCode with 503
+// @Description QUEUE_FULL : 작업 대기열 여유 부족
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 스윕/작업 등록 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param projectId path string true "프로젝트 ID"
+// @Param request body request.ReqSweep true "스윕 요청 데이터"
+// @Success 202 {object} response.ResCreateSweep
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Failure 503 {object} map[string]interface{}
+// @Tags parking
+func (d *CreateSweepParkingHandler) CreateSweep(c echo.Context) error {
+	// 요청 데이터 파싱
+	var req request.ReqSweep
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"message": "요청 데이터 파싱 실패",
+			"error":   err.Error(),
+		})
+	}
+
+	// 프로젝트는 경로 기준으로 고정 (본문 값은 무시)
+	req.ProjectID = c.Param("projectId")
+
+	// 파라미터 검증
+	if err := usecase.ValidateSweepRequest(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"message": "파라미터 검증 실패",
+			"error":   err.Error(),
+		})
+	}
+
+	// UseCase 호출 (조합별 작업 등록)
+	ctx, _, _ := common.CtxGenerate(c)
+	result, err := d.UseCase.CreateSweep(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusAccepted, result)
+}
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"net/http"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CurvesParkingHandler struct {
+	UseCase _interface.ICurvesParkingUseCase
+}
+
+func NewCurvesParkingHandler(c *echo.Group, useCase _interface.ICurvesParkingUseCase) _interface.ICurvesParkingHandler {
+	handler := &CurvesParkingHandler{
+		UseCase: useCase,
+	}
+	c.GET("/:projectId/experiments/:experimentId/curves", handler.GetCurves)
+	return handler
+}
+
+// 실험 ROC / PR 곡선 조회
+// @Router /v0.1/parking/{projectId}/experiments/{experimentId}/curves [get]
+// @Summary 실험 ROC / PR 곡선 조회
+// @Description 차량 있음 판정 임계값을 0부터 1까지 step 간격으로 바꿔가며 저장된 정답 라벨과 비교합니다.
+// @Description 전체와 CCTV별로 ROC/PR 곡선 점, roc_auc, pr_auc(average precision), F1 최대 임계값(best_f1), Youden J 최대 임계값(best_youden_j)을 반환합니다.
+// @Description AUC는 곡선 점이 아니라 전체 ROI 점수로 정확히 계산합니다.
+// @Description 라벨이 없는 ROI는 제외되며, 라벨이 하나도 없는 CCTV는 목록에서 빠집니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 잘못된 실험 ID 또는 step
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 실험 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 조회 실패
+// @Description
+// @Produce json
+// @Param projectId path string true "프로젝트 ID"
+// @Param experimentId path int true "실험(ExperimentSession) ID"
+// @Param step query number false "임계값 간격 (0.001 ~ 0.5, 기본 0.01)"
+// @Success 200 {object} response.ResCurves
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags parking
+func (d *CurvesParkingHandler) GetCurves(c echo.Context) error {
+	step, err := usecase.ParseCurveStep(c.QueryParam("step"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": err.Error(),
+		})
+	}
+
+	ctx, _, _ := common.CtxGenerate(c)
+	result, err := d.UseCase.GetCurves(ctx, c.Param("projectId"), c.Param("experimentId"), step)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
@@ -14,11 +14,11 @@ type DeleteFileParkingHandler struct {
 	UseCase _interface.IDeleteFileParkingUseCase
 }
 
-func NewDeleteFileParkingHandler(c *echo.Echo, useCase _interface.IDeleteFileParkingUseCase) _interface.IDeleteFileParkingHandler {
+func NewDeleteFileParkingHandler(c *echo.Group, useCase _interface.IDeleteFileParkingUseCase) _interface.IDeleteFileParkingHandler {
 	handler := &DeleteFileParkingHandler{
 		UseCase: useCase,
 	}
-	c.DELETE("/v0.1/parking/:projectId/:folderPath", handler.DeleteFile)
+	c.DELETE("/:projectId/:folderPath", handler.DeleteFile)
 	return handler
 }
 
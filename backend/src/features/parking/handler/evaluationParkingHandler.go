@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"net/http"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type EvaluationParkingHandler struct {
+	UseCase _interface.IEvaluationParkingUseCase
+}
+
+func NewEvaluationParkingHandler(c *echo.Group, useCase _interface.IEvaluationParkingUseCase) _interface.IEvaluationParkingHandler {
+	handler := &EvaluationParkingHandler{
+		UseCase: useCase,
+	}
+	c.GET("/:projectId/experiments/:experimentId/evaluation", handler.GetEvaluation)
+	return handler
+}
+
+// 실험 결과 평가
+// @Router /v0.1/parking/{projectId}/experiments/{experimentId}/evaluation [get]
+// @Summary 실험 결과 평가
+// @Description 실험의 ROI 전경 비율을 저장된 정답 라벨({cctvId}_labels.json)과 비교합니다.
+// @Description 전경 비율이 threshold 이상이면 차량 있음(positive)으로 판정합니다.
+// @Description threshold를 생략하면 프로젝트에 저장된 점유 판정 기준(parking_id > CCTV > 프로젝트)을 ROI마다 적용합니다.
+// @Description 전체/CCTV별 혼동 행렬과 accuracy, precision, recall, f1, ROI별 판정 결과, 틀린 ROI 목록을 반환합니다.
+// @Description 라벨이 없는 ROI는 outcome이 unlabeled이며 지표 계산에서 제외됩니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 잘못된 실험 ID 또는 threshold
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 실험 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 조회 실패
+// @Description
+// @Produce json
+// @Param projectId path string true "프로젝트 ID"
+// @Param experimentId path int true "실험(ExperimentSession) ID"
+// @Param threshold query number false "차량 있음 판정 전경 비율 (0 초과 1 이하, 생략 시 저장된 기준)"
+// @Success 200 {object} response.ResEvaluation
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags parking
+func (d *EvaluationParkingHandler) GetEvaluation(c echo.Context) error {
+	threshold, err := usecase.ParseOccupancyThreshold(c.QueryParam("threshold"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": err.Error(),
+		})
+	}
+
+	ctx, _, _ := common.CtxGenerate(c)
+	result, err := d.UseCase.GetEvaluation(ctx, c.Param("projectId"), c.Param("experimentId"), threshold)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"net/http"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+
+	"github.com/labstack/echo/v4"
+)
+
+type GetSweepParkingHandler struct {
+	UseCase _interface.IGetSweepParkingUseCase
+}
+
+func NewGetSweepParkingHandler(c *echo.Group, useCase _interface.IGetSweepParkingUseCase) _interface.IGetSweepParkingHandler {
+	handler := &GetSweepParkingHandler{
+		UseCase: useCase,
+	}
+	c.GET("/:projectId/sweeps/:sweepId", handler.GetSweep)
+	return handler
+}
+
+// 하이퍼파라미터 스윕 결과 조회
+// @Router /v0.1/parking/{projectId}/sweeps/{sweepId} [get]
+// @Summary 하이퍼파라미터 스윕 결과 조회
+// @Description 조합별 작업 상태와 정답 라벨(_labels.json) 기준 정확도 순위를 반환합니다.
+// @Description best는 전체 정확도가 가장 높은 조합, best_per_cctv는 CCTV별로 가장 높은 조합입니다.
+// @Description 라벨이 없거나 아직 끝나지 않은 조합은 rank 0, accuracy null 입니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 잘못된 스윕 ID
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 스윕 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 조회 실패
+// @Description
+// @Produce json
+// @Param projectId path string true "프로젝트 ID"
+// @Param sweepId path int true "스윕 ID"
+// @Success 200 {object} response.ResSweep
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags parking
+func (d *GetSweepParkingHandler) GetSweep(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	result, err := d.UseCase.GetSweep(ctx, c.Param("projectId"), c.Param("sweepId"))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
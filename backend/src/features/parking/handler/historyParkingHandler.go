@@ -13,11 +13,11 @@ type HistoryParkingHandler struct {
 	UseCase _interface.IHistoryParkingUseCase
 }
 
-func NewHistoryParkingHandler(c *echo.Echo, useCase _interface.IHistoryParkingUseCase) _interface.IHistoryParkingHandler {
+func NewHistoryParkingHandler(c *echo.Group, useCase _interface.IHistoryParkingUseCase) _interface.IHistoryParkingHandler {
 	handler := &HistoryParkingHandler{
 		UseCase: useCase,
 	}
-	c.GET("/v0.1/parking/:projectId/history", handler.GetHistory)
+	c.GET("/:projectId/history", handler.GetHistory)
 	return handler
 }
 
@@ -25,6 +25,7 @@ func NewHistoryParkingHandler(c *echo.Echo, useCase _interface.IHistoryParkingUs
 // @Router /v0.1/parking/{projectId}/history [get]
 // @Summary Get Learning History
 // @Description Gets the learning history for a project
+// @Description Each item includes per-CCTV ROI rates with occupied flags based on the stored occupancy thresholds.
 // @Accept json
 // @Produce json
 // @Param projectId path string true "Project ID"
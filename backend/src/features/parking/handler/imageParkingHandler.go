@@ -13,11 +13,11 @@ type ImageParkingHandler struct {
 	UseCase _interface.IImageParkingUseCase
 }
 
-func NewImageParkingHandler(c *echo.Echo, useCase _interface.IImageParkingUseCase) _interface.IImageParkingHandler {
+func NewImageParkingHandler(c *echo.Group, useCase _interface.IImageParkingUseCase) _interface.IImageParkingHandler {
 	handler := &ImageParkingHandler{
 		UseCase: useCase,
 	}
-	c.GET("/v0.1/parking/:projectId/:folderPath/:cctvId/images/:imageType", handler.GetImage)
+	c.GET("/:projectId/:folderPath/:cctvId/images/:imageType", handler.GetImage)
 	return handler
 }
 
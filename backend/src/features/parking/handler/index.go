@@ -1,9 +1,14 @@
 package handler
 
 import (
+	"context"
+	"fmt"
+	"main/common"
 	"main/common/db/mysql"
+	"main/features/parking/model/entity"
 	"main/features/parking/repository"
 	"main/features/parking/usecase"
+	_middleware "main/middleware"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -18,7 +23,7 @@ func NewParkingHandler(e *echo.Echo) error {
 	testStatsRepo := repository.NewTestStatsParkingRepository(mysql.GormMysqlDB)
 	roiStatsRepo := repository.NewRoiStatsParkingRepository(mysql.GormMysqlDB)
 	learningRepo := repository.NewLearningParkingRepository(mysql.GormMysqlDB)
-	learningResultsRepo := repository.NewLearningResultsParkingRepository()
+	learningResultsRepo := repository.NewLearningResultsParkingRepository(mysql.GormMysqlDB)
 	cctvImagesRepo := repository.NewCctvImageParkingRepository(mysql.GormMysqlDB)
 	imageRepo := repository.NewImageParkingRepository(mysql.GormMysqlDB)
 	historyRepo := repository.NewHistoryParkingRepository(mysql.GormMysqlDB)
@@ -27,6 +32,29 @@ func NewParkingHandler(e *echo.Echo) error {
 	deleteFileRepo := repository.NewDeleteFileParkingRepository(mysql.GormMysqlDB)
 	batchImagesRepo := repository.NewBatchImagesParkingRepository(mysql.GormMysqlDB)
 	liveLearningRepo := repository.NewLiveLearningParkingRepository(mysql.GormMysqlDB)
+	jobEventsRepo := repository.NewJobEventsParkingRepository(mysql.GormMysqlDB)
+	createSweepRepo := repository.NewCreateSweepParkingRepository(mysql.GormMysqlDB)
+	getSweepRepo := repository.NewGetSweepParkingRepository(mysql.GormMysqlDB)
+	evaluationRepo := repository.NewEvaluationParkingRepository(mysql.GormMysqlDB)
+	curvesRepo := repository.NewCurvesParkingRepository(mysql.GormMysqlDB)
+	thresholdGetRepo := repository.NewThresholdGetParkingRepository(mysql.GormMysqlDB)
+	thresholdSaveRepo := repository.NewThresholdSaveParkingRepository(mysql.GormMysqlDB)
+	thresholdDeleteRepo := repository.NewThresholdDeleteParkingRepository(mysql.GormMysqlDB)
+	alignmentRepo := repository.NewAlignmentParkingRepository(mysql.GormMysqlDB)
+	zoneGetRepo := repository.NewZoneGetParkingRepository(mysql.GormMysqlDB)
+	zoneSaveRepo := repository.NewZoneSaveParkingRepository(mysql.GormMysqlDB)
+	zoneRulesGetRepo := repository.NewZoneRulesGetParkingRepository(mysql.GormMysqlDB)
+	zoneRulesSaveRepo := repository.NewZoneRulesSaveParkingRepository(mysql.GormMysqlDB)
+	categoryGetRepo := repository.NewCategoryGetParkingRepository(mysql.GormMysqlDB)
+	categorySaveRepo := repository.NewCategorySaveParkingRepository(mysql.GormMysqlDB)
+	zoneDeriveRepo := repository.NewZoneDeriveParkingRepository(mysql.GormMysqlDB)
+	zoneOccupancyRepo := repository.NewZoneOccupancyParkingRepository(mysql.GormMysqlDB)
+	liveStateRepo := repository.NewLiveStateParkingRepository(mysql.GormMysqlDB)
+	occupancySamplesRepo := repository.NewOccupancySamplesParkingRepository(mysql.GormMysqlDB)
+	occupancyBucketsRepo := repository.NewOccupancyBucketsParkingRepository(mysql.GormMysqlDB)
+	occupancyStateAtRepo := repository.NewOccupancyStateAtParkingRepository(mysql.GormMysqlDB)
+	liveEventsRepo := repository.NewLiveEventsParkingRepository(mysql.GormMysqlDB)
+	analyticsRepo := repository.NewAnalyticsParkingRepository(mysql.GormMysqlDB)
 
 	// UseCase 초기화
 	learningUploadUseCase := usecase.NewLearningUploadParkingUseCase(learningUploadRepo, 30*time.Second)
@@ -35,7 +63,7 @@ func NewParkingHandler(e *echo.Echo) error {
 	learningStatsUseCase := usecase.NewLearningStatsParkingUseCase(learningStatsRepo, 30*time.Second)
 	testStatsUseCase := usecase.NewTestStatsParkingUseCase(testStatsRepo, 30*time.Second)
 	roiStatsUseCase := usecase.NewRoiStatsParkingUseCase(roiStatsRepo, 30*time.Second)
-	learningUseCase := usecase.NewLearningParkingUseCase(learningRepo, 300*time.Second)
+	learningUseCase := usecase.NewLearningParkingUseCase(learningRepo, 30*time.Second)
 	learningResultsUseCase := usecase.NewLearningResultsParkingUseCase(learningResultsRepo, 30*time.Second)
 	cctvImagesUseCase := usecase.NewCctvImageParkingUseCase(cctvImagesRepo, 30*time.Second)
 	imageUseCase := usecase.NewImageParkingUseCase(imageRepo, 30*time.Second)
@@ -44,25 +72,90 @@ func NewParkingHandler(e *echo.Echo) error {
 	labelSaveUseCase := usecase.NewLabelSaveParkingUseCase(labelSaveRepo)
 	deleteFileUseCase := usecase.NewDeleteFileParkingUseCase(deleteFileRepo)
 	batchImagesUseCase := usecase.NewBatchImagesParkingUseCase(batchImagesRepo, 30*time.Second)
-	liveLearningUseCase := usecase.NewLiveLearningParkingUseCase(liveLearningRepo, 30*time.Second)
+	liveLearningUseCase := usecase.NewLiveLearningParkingUseCase(liveLearningRepo, 300*time.Second)
+	jobEventsUseCase := usecase.NewJobEventsParkingUseCase(jobEventsRepo, 30*time.Second)
+	createSweepUseCase := usecase.NewCreateSweepParkingUseCase(createSweepRepo, 30*time.Second)
+	getSweepUseCase := usecase.NewGetSweepParkingUseCase(getSweepRepo, 30*time.Second)
+	evaluationUseCase := usecase.NewEvaluationParkingUseCase(evaluationRepo, 30*time.Second)
+	curvesUseCase := usecase.NewCurvesParkingUseCase(curvesRepo, 30*time.Second)
+	thresholdGetUseCase := usecase.NewThresholdGetParkingUseCase(thresholdGetRepo, 30*time.Second)
+	thresholdSaveUseCase := usecase.NewThresholdSaveParkingUseCase(thresholdSaveRepo, 30*time.Second)
+	thresholdDeleteUseCase := usecase.NewThresholdDeleteParkingUseCase(thresholdDeleteRepo, 30*time.Second)
+	alignmentUseCase := usecase.NewAlignmentParkingUseCase(alignmentRepo, 60*time.Second)
+	zoneGetUseCase := usecase.NewZoneGetParkingUseCase(zoneGetRepo, 30*time.Second)
+	zoneSaveUseCase := usecase.NewZoneSaveParkingUseCase(zoneSaveRepo, 30*time.Second)
+	zoneRulesGetUseCase := usecase.NewZoneRulesGetParkingUseCase(zoneRulesGetRepo, 30*time.Second)
+	zoneRulesSaveUseCase := usecase.NewZoneRulesSaveParkingUseCase(zoneRulesSaveRepo, 30*time.Second)
+	categoryGetUseCase := usecase.NewCategoryGetParkingUseCase(categoryGetRepo, 30*time.Second)
+	categorySaveUseCase := usecase.NewCategorySaveParkingUseCase(categorySaveRepo, 30*time.Second)
+	zoneDeriveUseCase := usecase.NewZoneDeriveParkingUseCase(zoneDeriveRepo, 30*time.Second)
+	zoneOccupancyUseCase := usecase.NewZoneOccupancyParkingUseCase(zoneOccupancyRepo, 30*time.Second)
+	liveStateUseCase := usecase.NewLiveStateParkingUseCase(liveStateRepo, 30*time.Second)
+	occupancySamplesUseCase := usecase.NewOccupancySamplesParkingUseCase(occupancySamplesRepo, 30*time.Second)
+	occupancyBucketsUseCase := usecase.NewOccupancyBucketsParkingUseCase(occupancyBucketsRepo, 30*time.Second)
+	occupancyStateAtUseCase := usecase.NewOccupancyStateAtParkingUseCase(occupancyStateAtRepo, 30*time.Second)
+	liveEventsUseCase := usecase.NewLiveEventsParkingUseCase(liveEventsRepo, 30*time.Second)
+	analyticsUseCase := usecase.NewAnalyticsParkingUseCase(analyticsRepo, 60*time.Second)
+
+	// 서버 재시작으로 중단된 학습 작업 정리
+	if err := learningUseCase.RecoverLearningJobs(context.Background()); err != nil {
+		fmt.Printf("학습 작업 정리 실패 : %v\n", err)
+	}
+
+	// 주차면 번호 대응이 생기기 전의 결과를 위해 대응이 없는 프로젝트는 예전 규칙 번호로 채움
+	if err := learningUseCase.BackfillParkingSpaces(context.Background()); err != nil {
+		fmt.Printf("주차면 번호 대응 생성 실패 : %v\n", err)
+	}
+
+	// 점유 이력 보관 정책 (오래된 원본 점은 시간 단위로 줄이고, 오래된 시간 단위 이력은 삭제)
+	occupancySamplesUseCase.StartOccupancyRetention(entity.OccupancyRetention{
+		RawDays:    common.Env.OccupancyRawRetentionDays,
+		HourlyDays: common.Env.OccupancyHourlyRetentionDays,
+	}, time.Duration(common.Env.OccupancyRetentionMinutes)*time.Minute)
+
+	// 라우팅 그룹 (프로젝트 검증 및 작업 폴더 설정)
+	parkingGroup := e.Group("/v0.1/parking", _middleware.ProjectScope)
 
 	// Handler 초기화
-	NewLearningUploadParkingHandler(e, learningUploadUseCase)
-	NewTestUploadParkingHandler(e, testUploadUseCase)
-	NewRoiUploadParkingHandler(e, roiUploadUseCase)
-	NewLearningStatsParkingHandler(e, learningStatsUseCase)
-	NewTestStatsParkingHandler(e, testStatsUseCase)
-	NewRoiStatsParkingHandler(e, roiStatsUseCase)
-	NewLearningParkingHandler(e, learningUseCase)
-	NewLearningResultsParkingHandler(e, learningResultsUseCase)
-	NewImageParkingHandler(e, imageUseCase)
-	NewCctvImageParkingHandler(e, cctvImagesUseCase)
-	NewHistoryParkingHandler(e, historyUseCase)
-	NewLabelGetParkingHandler(e, labelGetUseCase)
-	NewLabelSaveParkingHandler(e, labelSaveUseCase)
-	NewDeleteFileParkingHandler(e, deleteFileUseCase)
-	NewBatchImagesParkingHandler(e, batchImagesUseCase)
-	NewLiveLearningParkingHandler(e, liveLearningUseCase)
+	NewLearningUploadParkingHandler(parkingGroup, learningUploadUseCase)
+	NewTestUploadParkingHandler(parkingGroup, testUploadUseCase)
+	NewRoiUploadParkingHandler(parkingGroup, roiUploadUseCase)
+	NewLearningStatsParkingHandler(parkingGroup, learningStatsUseCase)
+	NewTestStatsParkingHandler(parkingGroup, testStatsUseCase)
+	NewRoiStatsParkingHandler(parkingGroup, roiStatsUseCase)
+	NewLearningParkingHandler(parkingGroup, learningUseCase)
+	NewLearningResultsParkingHandler(parkingGroup, learningResultsUseCase)
+	NewImageParkingHandler(parkingGroup, imageUseCase)
+	NewCctvImageParkingHandler(parkingGroup, cctvImagesUseCase)
+	NewHistoryParkingHandler(parkingGroup, historyUseCase)
+	NewLabelGetParkingHandler(parkingGroup, labelGetUseCase)
+	NewLabelSaveParkingHandler(parkingGroup, labelSaveUseCase)
+	NewDeleteFileParkingHandler(parkingGroup, deleteFileUseCase)
+	NewBatchImagesParkingHandler(parkingGroup, batchImagesUseCase)
+	NewLiveLearningParkingHandler(parkingGroup, liveLearningUseCase)
+	NewJobEventsParkingHandler(parkingGroup, jobEventsUseCase)
+	NewCreateSweepParkingHandler(parkingGroup, createSweepUseCase)
+	NewGetSweepParkingHandler(parkingGroup, getSweepUseCase)
+	NewEvaluationParkingHandler(parkingGroup, evaluationUseCase)
+	NewCurvesParkingHandler(parkingGroup, curvesUseCase)
+	NewThresholdGetParkingHandler(parkingGroup, thresholdGetUseCase)
+	NewThresholdSaveParkingHandler(parkingGroup, thresholdSaveUseCase)
+	NewThresholdDeleteParkingHandler(parkingGroup, thresholdDeleteUseCase)
+	NewAlignmentParkingHandler(parkingGroup, alignmentUseCase)
+	NewZoneGetParkingHandler(parkingGroup, zoneGetUseCase)
+	NewZoneSaveParkingHandler(parkingGroup, zoneSaveUseCase)
+	NewZoneRulesGetParkingHandler(parkingGroup, zoneRulesGetUseCase)
+	NewZoneRulesSaveParkingHandler(parkingGroup, zoneRulesSaveUseCase)
+	NewCategoryGetParkingHandler(parkingGroup, categoryGetUseCase)
+	NewCategorySaveParkingHandler(parkingGroup, categorySaveUseCase)
+	NewZoneDeriveParkingHandler(parkingGroup, zoneDeriveUseCase)
+	NewZoneOccupancyParkingHandler(parkingGroup, zoneOccupancyUseCase)
+	NewLiveStateParkingHandler(parkingGroup, liveStateUseCase)
+	NewOccupancySamplesParkingHandler(parkingGroup, occupancySamplesUseCase)
+	NewOccupancyBucketsParkingHandler(parkingGroup, occupancyBucketsUseCase)
+	NewOccupancyStateAtParkingHandler(parkingGroup, occupancyStateAtUseCase)
+	NewLiveEventsParkingHandler(parkingGroup, liveEventsUseCase)
+	NewAnalyticsParkingHandler(parkingGroup, analyticsUseCase)
 
 	return nil
 }
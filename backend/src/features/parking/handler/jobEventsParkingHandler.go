@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"main/common"
+	"net/http"
+	"strconv"
+	"time"
+
+	_interface "main/features/parking/model/interface"
+
+	"github.com/labstack/echo/v4"
+)
+
+// SSE 연결 유지용 주석 전송 간격
+const sseHeartbeatInterval = 15 * time.Second
+
+type JobEventsParkingHandler struct {
+	UseCase _interface.IJobEventsParkingUseCase
+}
+
+func NewJobEventsParkingHandler(c *echo.Group, useCase _interface.IJobEventsParkingUseCase) _interface.IJobEventsParkingHandler {
+	handler := &JobEventsParkingHandler{
+		UseCase: useCase,
+	}
+	c.GET("/:projectId/jobs/:jobId/events", handler.JobEvents)
+	return handler
+}
+
+// 학습 작업 진행 이벤트 스트림
+// @Router /v0.1/parking/{projectId}/jobs/{jobId}/events [get]
+// @Summary 학습 작업 진행 이벤트 (SSE)
+// @Description Server-Sent Events로 학습 작업 진행 상황을 전달합니다.
+// @Description 접속 시 지금까지의 이벤트를 먼저 보내고(replay), 이후 이벤트를 실시간으로 보냅니다.
+// @Description Last-Event-ID 헤더(또는 lastEventId 쿼리)를 보내면 그 이후 이벤트부터 받습니다.
+// @Description
+// @Description ■ event 종류
+// @Description status : 상태 변경 {status, result_folder, error_message}
+// @Description progress : 진행 상황 {stage(start/learned/testing/tested), cctv_id, image, index, total, learned_images, roi_count}
+// @Description warning : OpenCV 경고 출력 {message}
+// @Description log : OpenCV 일반 출력 {message}
+// @Description 작업이 끝나면(succeeded/failed/cancelled) 마지막 status 이벤트를 보내고 연결을 닫습니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 잘못된 작업 ID
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 작업 없음
+// @Description
+// @Produce text/event-stream
+// @Param projectId path string true "프로젝트 ID"
+// @Param jobId path int true "작업 ID"
+// @Param Last-Event-ID header int false "마지막으로 받은 이벤트 ID"
+// @Param lastEventId query int false "마지막으로 받은 이벤트 ID (헤더를 보낼 수 없는 경우)"
+// @Success 200 {string} string "text/event-stream"
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Tags parking
+func (d *JobEventsParkingHandler) JobEvents(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	projectID := c.Param("projectId")
+	jobID := c.Param("jobId")
+
+	lastEventID := 0
+	if value := c.Request().Header.Get("Last-Event-ID"); value != "" {
+		lastEventID, _ = strconv.Atoi(value)
+	} else if value := c.QueryParam("lastEventId"); value != "" {
+		lastEventID, _ = strconv.Atoi(value)
+	}
+	if lastEventID < 0 {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "lastEventId는 0 이상이어야 합니다",
+		})
+	}
+
+	sub, err := d.UseCase.SubscribeJobEvents(ctx, projectID, jobID, lastEventID)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	common.StartSSE(c)
+	for _, event := range sub.Replay {
+		if err := common.WriteSSE(c, event.ID, event.Type, event.Data); err != nil {
+			return nil
+		}
+	}
+
+	heartbeat := time.NewTicker(sseHeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-heartbeat.C:
+			if err := common.WriteSSEComment(c, "keep-alive"); err != nil {
+				return nil
+			}
+		case event, ok := <-sub.Events:
+			if !ok {
+				return nil
+			}
+			if err := common.WriteSSE(c, event.ID, event.Type, event.Data); err != nil {
+				return nil
+			}
+		}
+	}
+}
@@ -12,9 +12,9 @@ type LabelGetParkingHandler struct {
 	UseCase _interface.ILabelGetParkingUseCase
 }
 
-func NewLabelGetParkingHandler(c *echo.Echo, useCase _interface.ILabelGetParkingUseCase) _interface.ILabelGetParkingHandler {
+func NewLabelGetParkingHandler(c *echo.Group, useCase _interface.ILabelGetParkingUseCase) _interface.ILabelGetParkingHandler {
 	handler := &LabelGetParkingHandler{UseCase: useCase}
-	c.GET("/v0.1/parking/:projectId/labels/:folderPath/:cctvId", handler.GetLabels)
+	c.GET("/:projectId/labels/:folderPath/:cctvId", handler.GetLabels)
 	return handler
 }
 
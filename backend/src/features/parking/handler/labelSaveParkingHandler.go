@@ -13,9 +13,9 @@ type LabelSaveParkingHandler struct {
 	UseCase _interface.ILabelSaveParkingUseCase
 }
 
-func NewLabelSaveParkingHandler(c *echo.Echo, useCase _interface.ILabelSaveParkingUseCase) _interface.ILabelSaveParkingHandler {
+func NewLabelSaveParkingHandler(c *echo.Group, useCase _interface.ILabelSaveParkingUseCase) _interface.ILabelSaveParkingHandler {
 	handler := &LabelSaveParkingHandler{UseCase: useCase}
-	c.POST("/v0.1/parking/:projectId/labels/:folderPath/:cctvId", handler.SaveLabels)
+	c.POST("/:projectId/labels/:folderPath/:cctvId", handler.SaveLabels)
 	return handler
 }
 
@@ -3,9 +3,9 @@ package handler
 import (
 	"net/http"
 
+	"main/common"
 	_interface "main/features/parking/model/interface"
 	"main/features/parking/model/request"
-	"main/features/parking/model/response"
 	"main/features/parking/usecase"
 
 	"github.com/labstack/echo/v4"
@@ -15,32 +15,41 @@ type LearningParkingHandler struct {
 	UseCase _interface.ILearningParkingUseCase
 }
 
-func NewLearningParkingHandler(c *echo.Echo, useCase _interface.ILearningParkingUseCase) _interface.ILearningParkingHandler {
+func NewLearningParkingHandler(c *echo.Group, useCase _interface.ILearningParkingUseCase) _interface.ILearningParkingHandler {
 	handler := &LearningParkingHandler{
 		UseCase: useCase,
 	}
-	c.POST("/v0.1/parking/:projectId/learning", handler.Learning)
+	c.POST("/:projectId/learning", handler.Learning)
 	return handler
 }
 
 // 학습 실행
 // @Router /v0.1/parking/{projectId}/learning [post]
 // @Summary 학습 실행
-// @Description OpenCV를 사용하여 주차면 학습을 실행합니다.
+// @Description OpenCV 학습 작업을 대기열에 등록하고 작업 ID를 바로 반환합니다.
+// @Description 진행 상태는 GET /v0.1/jobs/{jobId} 로 조회합니다.
+// @Description
+// @Description ■ errCode with 503
+// @Description QUEUE_FULL : 작업 대기열이 가득 참
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 작업 등록 실패
+// @Description
 // @Accept json
 // @Produce json
 // @Param projectId path string true "프로젝트 ID"
 // @Param request body request.ReqLearning true "학습 요청 데이터"
-// @Success 200 {object} response.ResLearning
+// @Success 202 {object} response.ResLearning
 // @Failure 400 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
+// @Failure 503 {object} map[string]interface{}
 // @Tags parking
 func (d *LearningParkingHandler) Learning(c echo.Context) error {
 	// 프로젝트 ID 가져오기
 	projectID := c.Param("projectId")
 	if projectID == "" {
-		return c.JSON(http.StatusBadRequest, response.ResLearning{
-			FolderPath: "",
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"message": "projectId가 필요합니다",
 		})
 	}
 
@@ -53,6 +62,9 @@ func (d *LearningParkingHandler) Learning(c echo.Context) error {
 		})
 	}
 
+	// 프로젝트는 경로 기준으로 고정 (본문 값은 무시)
+	req.ProjectID = projectID
+
 	// 파라미터 검증
 	if err := usecase.ValidateLearningRequest(req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{
@@ -61,13 +73,11 @@ func (d *LearningParkingHandler) Learning(c echo.Context) error {
 		})
 	}
 
-	// UseCase 호출
-	result, err := d.UseCase.Learning(c.Request().Context(), req)
+	// UseCase 호출 (작업 등록)
+	ctx, _, _ := common.CtxGenerate(c)
+	result, err := d.UseCase.Learning(ctx, req)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"message": "학습 실패",
-			"error":   err.Error(),
-		})
+		return err
 	}
-	return c.JSON(http.StatusOK, result)
+	return c.JSON(http.StatusAccepted, result)
 }
@@ -13,11 +13,11 @@ type LearningResultsParkingHandler struct {
 	UseCase _interface.ILearningResultsParkingUseCase
 }
 
-func NewLearningResultsParkingHandler(c *echo.Echo, useCase _interface.ILearningResultsParkingUseCase) _interface.ILearningResultsParkingHandler {
+func NewLearningResultsParkingHandler(c *echo.Group, useCase _interface.ILearningResultsParkingUseCase) _interface.ILearningResultsParkingHandler {
 	handler := &LearningResultsParkingHandler{
 		UseCase: useCase,
 	}
-	c.GET("/v0.1/parking/:projectId/learning-results/:folder", handler.GetLearningResults)
+	c.GET("/:projectId/learning-results/:folder", handler.GetLearningResults)
 	return handler
 }
 
@@ -25,6 +25,7 @@ func NewLearningResultsParkingHandler(c *echo.Echo, useCase _interface.ILearning
 // @Router /v0.1/parking/{projectId}/learning-results/{folder} [get]
 // @Summary Get Learning Results
 // @Description Gets the list of CCTV results for a specific learning session
+// @Description Each CCTV includes ROI rates with occupied flags when the session is stored in the DB.
 // @Accept json
 // @Produce json
 // @Param projectId path string true "Project ID"
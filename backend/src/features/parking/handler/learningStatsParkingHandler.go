@@ -12,11 +12,11 @@ type LearningStatsParkingHandler struct {
 	UseCase _interface.ILearningStatsParkingUseCase
 }
 
-func NewLearningStatsParkingHandler(c *echo.Echo, useCase _interface.ILearningStatsParkingUseCase) _interface.ILearningStatsParkingHandler {
+func NewLearningStatsParkingHandler(c *echo.Group, useCase _interface.ILearningStatsParkingUseCase) _interface.ILearningStatsParkingHandler {
 	handler := &LearningStatsParkingHandler{
 		UseCase: useCase,
 	}
-	c.GET("/v0.1/parking/:projectId/images/train-folders", handler.GetLearningStats)
+	c.GET("/:projectId/images/train-folders", handler.GetLearningStats)
 	return handler
 }
 
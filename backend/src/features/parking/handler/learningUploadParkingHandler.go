@@ -15,11 +15,11 @@ type LearningUploadParkingHandler struct {
 	UseCase _interface.ILearningUploadParkingUseCase
 }
 
-func NewLearningUploadParkingHandler(c *echo.Echo, useCase _interface.ILearningUploadParkingUseCase) _interface.ILearningUploadParkingHandler {
+func NewLearningUploadParkingHandler(c *echo.Group, useCase _interface.ILearningUploadParkingUseCase) _interface.ILearningUploadParkingHandler {
 	handler := &LearningUploadParkingHandler{
 		UseCase: useCase,
 	}
-	c.POST("/v0.1/parking/:projectId/train-images", handler.LearningUpload)
+	c.POST("/:projectId/train-images", handler.LearningUpload)
 	return handler
 }
 
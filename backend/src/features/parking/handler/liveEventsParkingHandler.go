@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"main/common"
+	"net/http"
+	"strconv"
+	"time"
+
+	_interface "main/features/parking/model/interface"
+
+	"github.com/labstack/echo/v4"
+)
+
+type LiveEventsParkingHandler struct {
+	UseCase _interface.ILiveEventsParkingUseCase
+}
+
+func NewLiveEventsParkingHandler(c *echo.Group, useCase _interface.ILiveEventsParkingUseCase) _interface.ILiveEventsParkingHandler {
+	handler := &LiveEventsParkingHandler{
+		UseCase: useCase,
+	}
+	c.GET("/:projectId/live/events", handler.LiveEvents)
+	return handler
+}
+
+// 실시간 점유 변경 이벤트 스트림
+// @Router /v0.1/parking/{projectId}/live/events [get]
+// @Summary 실시간 점유 변경 이벤트 (SSE)
+// @Description Server-Sent Events로 프로젝트의 실시간 주차면 변경을 전달합니다.
+// @Description 접속하면 먼저 현재 주차면 상태(snapshot)를 보내고, 이후 실시간 학습이 끝날 때마다 변경 이벤트를 보냅니다.
+// @Description Last-Event-ID 헤더(또는 lastEventId 쿼리)를 보내면 snapshot 다음에 그 이후 놓친 이벤트를 이어서 보냅니다.
+// @Description
+// @Description ■ event 종류
+// @Description snapshot : 현재 주차면 상태 (GET /v0.1/parking/{projectId}/live/state와 같은 형식, id 없음)
+// @Description space_changed : 점유 여부가 바뀌었거나 처음 관측된 주차면 {cctv_id, parking_id, roi_id, occupied, previous, rate, observed_at}
+// @Description image_updated : CCTV 결과 이미지 갱신 {cctv_id, image_types, source_image, updated_at}
+// @Description live_run_finished : 실시간 학습 완료 {success, cctvs, occupied, free, changed, misaligned, finished_at}
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 주차면 상태 조회 실패
+// @Description
+// @Produce text/event-stream
+// @Param projectId path string true "프로젝트 ID"
+// @Param Last-Event-ID header int false "마지막으로 받은 이벤트 ID"
+// @Param lastEventId query int false "마지막으로 받은 이벤트 ID (헤더를 보낼 수 없는 경우)"
+// @Success 200 {string} string "text/event-stream"
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags parking
+func (d *LiveEventsParkingHandler) LiveEvents(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	lastEventID := 0
+	if value := c.Request().Header.Get("Last-Event-ID"); value != "" {
+		lastEventID, _ = strconv.Atoi(value)
+	} else if value := c.QueryParam("lastEventId"); value != "" {
+		lastEventID, _ = strconv.Atoi(value)
+	}
+	if lastEventID < 0 {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "lastEventId는 0 이상이어야 합니다",
+		})
+	}
+
+	sub, err := d.UseCase.SubscribeLiveEvents(ctx, c.Param("projectId"), lastEventID)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	common.StartSSE(c)
+	for _, event := range sub.Replay {
+		if err := common.WriteSSE(c, event.ID, event.Type, event.Data); err != nil {
+			return nil
+		}
+	}
+
+	heartbeat := time.NewTicker(sseHeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-heartbeat.C:
+			if err := common.WriteSSEComment(c, "keep-alive"); err != nil {
+				return nil
+			}
+		case event, ok := <-sub.Events:
+			// 구독자가 너무 느려 끊긴 경우 클라이언트가 Last-Event-ID로 다시 접속
+			if !ok {
+				return nil
+			}
+			if err := common.WriteSSE(c, event.ID, event.Type, event.Data); err != nil {
+				return nil
+			}
+		}
+	}
+}
@@ -15,11 +15,11 @@ type LiveLearningParkingHandler struct {
 	UseCase _interface.ILiveLearningParkingUseCase
 }
 
-func NewLiveLearningParkingHandler(c *echo.Echo, useCase _interface.ILiveLearningParkingUseCase) _interface.ILiveLearningParkingHandler {
+func NewLiveLearningParkingHandler(c *echo.Group, useCase _interface.ILiveLearningParkingUseCase) _interface.ILiveLearningParkingHandler {
 	handler := &LiveLearningParkingHandler{
 		UseCase: useCase,
 	}
-	c.POST("/v0.1/parking/:projectId/learning/live", handler.LiveLearning)
+	c.POST("/:projectId/learning/live", handler.LiveLearning)
 	return handler
 }
 
@@ -27,6 +27,11 @@ func NewLiveLearningParkingHandler(c *echo.Echo, useCase _interface.ILiveLearnin
 // @Router /v0.1/parking/{projectId}/learning/live [post]
 // @Summary 실시간 이미지 학습 실행
 // @Description OpenCV를 사용하여 주차면 학습을 실행합니다.
+// @Description 성공 시 results에 CCTV별 ROI 점유율과 저장된 임계값(ROI > CCTV > 프로젝트 > 기본값) 기준 점유 여부를 포함합니다.
+// @Description 주차면별 결과는 space_state에 저장되어 GET /v0.1/parking/{projectId}/live/state로 조회할 수 있습니다.
+// @Description 같은 결과는 점유 이력(occupancy_samples)에도 추가되어 GET /v0.1/parking/{projectId}/occupancy/history로 조회할 수 있습니다.
+// @Description 실행이 끝나면 주차면 변경, 결과 이미지 갱신, 실행 완료 이벤트를 GET /v0.1/parking/{projectId}/live/events 구독자에게 보냅니다.
+// @Description currentImages 중 ROI 저장 시 기준 프레임에서 움직인 카메라는 misaligned에 이동량과 제안 이동값을 함께 반환합니다.
 // @Accept json
 // @Produce json
 // @Param projectId path string true "프로젝트 ID"
@@ -39,32 +44,27 @@ func (d *LiveLearningParkingHandler) LiveLearning(c echo.Context) error {
 	// 프로젝트 ID 가져오기
 	projectID := c.Param("projectId")
 	if projectID == "" {
-		return c.JSON(http.StatusBadRequest, response.ResLearning{
-			FolderPath: "",
-		})
+		return c.JSON(http.StatusBadRequest, response.ResLiveLearning{})
 	}
 
 	// 요청 데이터 파싱
 	var req request.ReqLiveLearning
 	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, response.ResLearning{
-			FolderPath: "",
-		})
+		return c.JSON(http.StatusBadRequest, response.ResLiveLearning{})
 	}
 
+	// 프로젝트는 경로 기준으로 고정 (본문 값은 무시)
+	req.ProjectID = projectID
+
 	// 파라미터 검증
 	if err := usecase.ValidateLiveLearningRequest(req); err != nil {
-		return c.JSON(http.StatusBadRequest, response.ResLearning{
-			FolderPath: "",
-		})
+		return c.JSON(http.StatusBadRequest, response.ResLiveLearning{})
 	}
 
 	// UseCase 호출
 	result, err := d.UseCase.LiveLearning(c.Request().Context(), req)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, response.ResLearning{
-			FolderPath: "",
-		})
+		return c.JSON(http.StatusInternalServerError, response.ResLiveLearning{})
 	}
 
 	return c.JSON(http.StatusOK, result)
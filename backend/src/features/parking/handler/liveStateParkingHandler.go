@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"net/http"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+
+	"github.com/labstack/echo/v4"
+)
+
+type LiveStateParkingHandler struct {
+	UseCase _interface.ILiveStateParkingUseCase
+}
+
+func NewLiveStateParkingHandler(c *echo.Group, useCase _interface.ILiveStateParkingUseCase) _interface.ILiveStateParkingHandler {
+	handler := &LiveStateParkingHandler{
+		UseCase: useCase,
+	}
+	c.GET("/:projectId/live/state", handler.GetLiveState)
+	return handler
+}
+
+// 주차면별 실시간 점유 상태
+// @Router /v0.1/parking/{projectId}/live/state [get]
+// @Summary 주차면별 실시간 점유 상태
+// @Description 실시간 학습을 실행할 때마다 저장되는 주차면별 최신 상태(점유 여부, 전경 비율, 적용 기준, 판정 이미지, 관측 시각)를 CCTV별로 반환합니다.
+// @Description 이번 실행에 결과가 없는 주차면은 마지막으로 관측된 상태를 유지하므로 observed_at으로 오래된 상태를 구분할 수 있습니다.
+// @Description 실시간 학습을 한 번도 실행하지 않았으면 cctvs가 비어 있고 updated_at은 null입니다.
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 조회 실패
+// @Description
+// @Produce json
+// @Param projectId path string true "프로젝트 ID"
+// @Param cctvId query string false "CCTV ID (생략 시 전체)"
+// @Success 200 {object} response.ResLiveState
+// @Failure 500 {object} map[string]interface{}
+// @Tags parking
+func (d *LiveStateParkingHandler) GetLiveState(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	result, err := d.UseCase.GetLiveState(ctx, c.Param("projectId"), c.QueryParam("cctvId"))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
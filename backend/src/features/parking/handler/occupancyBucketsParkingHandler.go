@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"net/http"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/request"
+
+	"github.com/labstack/echo/v4"
+)
+
+type OccupancyBucketsParkingHandler struct {
+	UseCase _interface.IOccupancyBucketsParkingUseCase
+}
+
+func NewOccupancyBucketsParkingHandler(c *echo.Group, useCase _interface.IOccupancyBucketsParkingUseCase) _interface.IOccupancyBucketsParkingHandler {
+	handler := &OccupancyBucketsParkingHandler{
+		UseCase: useCase,
+	}
+	c.GET("/:projectId/occupancy/history/buckets", handler.GetOccupancyBuckets)
+	return handler
+}
+
+// 구간별 점유 이력 집계
+// @Router /v0.1/parking/{projectId}/occupancy/history/buckets [get]
+// @Summary 구간별 점유 이력 집계
+// @Description 점유 이력을 interval(5m, hour, day) 구간으로 묶어 점 수, 점유 점 수, occupancy_rate(점유 점 비율 %), avg_rate(평균 전경 비율), spaces(관측된 주차면 수)를 반환합니다.
+// @Description 5m은 원본 점만 집계하고, hour/day는 보관 기간이 지나 시간 단위로 줄인 이력까지 합쳐 집계합니다.
+// @Description day 구간은 서버 시간대의 자정 기준입니다. 점이 없는 구간은 목록에서 빠집니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 잘못된 interval, 기간 또는 구간 수 초과
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 구역 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 조회 실패
+// @Description
+// @Produce json
+// @Param projectId path string true "프로젝트 ID"
+// @Param interval query string false "5m | hour | day (기본 hour)"
+// @Param cctvId query string false "CCTV ID"
+// @Param zoneId query int false "구역 ID (구역의 주차면만)"
+// @Param parkingId query string false "parking_id (cctvId 필요)"
+// @Param from query string false "시작 시각 RFC3339 (기본 to의 24시간 전)"
+// @Param to query string false "끝 시각 RFC3339, 미포함 (기본 현재)"
+// @Success 200 {object} response.ResOccupancyBuckets
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags parking
+func (d *OccupancyBucketsParkingHandler) GetOccupancyBuckets(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	var req request.ReqOccupancyHistory
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "요청 파라미터 파싱 실패",
+		})
+	}
+
+	result, err := d.UseCase.GetOccupancyBuckets(ctx, c.Param("projectId"), req)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"net/http"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/request"
+
+	"github.com/labstack/echo/v4"
+)
+
+type OccupancySamplesParkingHandler struct {
+	UseCase _interface.IOccupancySamplesParkingUseCase
+}
+
+func NewOccupancySamplesParkingHandler(c *echo.Group, useCase _interface.IOccupancySamplesParkingUseCase) _interface.IOccupancySamplesParkingHandler {
+	handler := &OccupancySamplesParkingHandler{
+		UseCase: useCase,
+	}
+	c.GET("/:projectId/occupancy/history", handler.GetOccupancySamples)
+	return handler
+}
+
+// 주차면 점유 이력 원본 점
+// @Router /v0.1/parking/{projectId}/occupancy/history [get]
+// @Summary 주차면 점유 이력 원본 점
+// @Description 실시간 학습을 실행할 때마다 주차면별로 기록된 점유 여부와 전경 비율을 관측 시각 순으로 반환합니다.
+// @Description 보관 기간(OCCUPANCY_RAW_RETENTION_DAYS)이 지난 점은 시간 단위로 줄어들어 여기에 나오지 않으므로 /occupancy/history/buckets를 사용합니다.
+// @Description limit보다 점이 많으면 앞쪽 limit개만 반환하고 truncated가 true입니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 잘못된 기간, limit 또는 cctvId 없는 parkingId
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 구역 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 조회 실패
+// @Description
+// @Produce json
+// @Param projectId path string true "프로젝트 ID"
+// @Param cctvId query string false "CCTV ID"
+// @Param zoneId query int false "구역 ID (구역의 주차면만)"
+// @Param parkingId query string false "parking_id (cctvId 필요)"
+// @Param from query string false "시작 시각 RFC3339 (기본 to의 24시간 전)"
+// @Param to query string false "끝 시각 RFC3339, 미포함 (기본 현재)"
+// @Param limit query int false "최대 개수 (기본 1000, 최대 10000)"
+// @Success 200 {object} response.ResOccupancySamples
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags parking
+func (d *OccupancySamplesParkingHandler) GetOccupancySamples(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	var req request.ReqOccupancyHistory
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "요청 파라미터 파싱 실패",
+		})
+	}
+
+	result, err := d.UseCase.GetOccupancySamples(ctx, c.Param("projectId"), req)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"net/http"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/request"
+
+	"github.com/labstack/echo/v4"
+)
+
+type OccupancyStateAtParkingHandler struct {
+	UseCase _interface.IOccupancyStateAtParkingUseCase
+}
+
+func NewOccupancyStateAtParkingHandler(c *echo.Group, useCase _interface.IOccupancyStateAtParkingUseCase) _interface.IOccupancyStateAtParkingHandler {
+	handler := &OccupancyStateAtParkingHandler{
+		UseCase: useCase,
+	}
+	c.GET("/:projectId/occupancy/history/state", handler.GetOccupancyStateAt)
+	return handler
+}
+
+// 특정 시각의 주차면 점유 상태
+// @Router /v0.1/parking/{projectId}/occupancy/history/state [get]
+// @Summary 특정 시각의 주차면 점유 상태
+// @Description at 시각 이전(포함)에 주차면마다 마지막으로 관측된 점유 상태를 반환합니다.
+// @Description 원본 점이 없고 시간 단위로 줄인 이력만 남은 주차면은 source가 hourly이며, 그 시간의 다수결 점유 여부와 평균 전경 비율을 사용합니다.
+// @Description at 이전에 한 번도 관측되지 않은 주차면은 목록에 나오지 않습니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 잘못된 at 또는 cctvId 없는 parkingId
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 구역 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 조회 실패
+// @Description
+// @Produce json
+// @Param projectId path string true "프로젝트 ID"
+// @Param at query string false "조회 시각 RFC3339 (기본 현재)"
+// @Param cctvId query string false "CCTV ID"
+// @Param zoneId query int false "구역 ID (구역의 주차면만)"
+// @Param parkingId query string false "parking_id (cctvId 필요)"
+// @Success 200 {object} response.ResOccupancyStateAt
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags parking
+func (d *OccupancyStateAtParkingHandler) GetOccupancyStateAt(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	var req request.ReqOccupancyStateAt
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "요청 파라미터 파싱 실패",
+		})
+	}
+
+	result, err := d.UseCase.GetOccupancyStateAt(ctx, c.Param("projectId"), req)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
@@ -12,11 +12,11 @@ type RoiStatsParkingHandler struct {
 	UseCase _interface.IRoiStatsParkingUseCase
 }
 
-func NewRoiStatsParkingHandler(c *echo.Echo, useCase _interface.IRoiStatsParkingUseCase) _interface.IRoiStatsParkingHandler {
+func NewRoiStatsParkingHandler(c *echo.Group, useCase _interface.IRoiStatsParkingUseCase) _interface.IRoiStatsParkingHandler {
 	handler := &RoiStatsParkingHandler{
 		UseCase: useCase,
 	}
-	c.GET("/v0.1/parking/:projectId/images/roi-folders", handler.GetRoiStats)
+	c.GET("/:projectId/images/roi-folders", handler.GetRoiStats)
 	return handler
 }
 
@@ -13,11 +13,11 @@ type RoiUploadParkingHandler struct {
 	UseCase _interface.IRoiUploadParkingUseCase
 }
 
-func NewRoiUploadParkingHandler(c *echo.Echo, useCase _interface.IRoiUploadParkingUseCase) _interface.IRoiUploadParkingHandler {
+func NewRoiUploadParkingHandler(c *echo.Group, useCase _interface.IRoiUploadParkingUseCase) _interface.IRoiUploadParkingHandler {
 	handler := &RoiUploadParkingHandler{
 		UseCase: useCase,
 	}
-	c.POST("/v0.1/parking/:projectId/roi-files", handler.RoiUpload)
+	c.POST("/:projectId/roi-files", handler.RoiUpload)
 	return handler
 }
 
@@ -27,6 +27,7 @@ func NewRoiUploadParkingHandler(c *echo.Echo, useCase _interface.IRoiUploadParki
 // @Description
 // @Description JSON 파일들을 서버에 저장합니다.
 // @Description 파일명이 그대로 유지되어 저장됩니다.
+// @Description cctv_id 누락, parking_id 중복, 홀수 개 좌표 등 구조가 잘못된 파일은 저장하지 않고 errors에 사유를 담습니다.
 // @Description
 // @Description ■ errCode with 400
 // @Description PARAM_BAD : 파라미터 오류
@@ -12,11 +12,11 @@ type TestStatsParkingHandler struct {
 	UseCase _interface.ITestStatsParkingUseCase
 }
 
-func NewTestStatsParkingHandler(c *echo.Echo, useCase _interface.ITestStatsParkingUseCase) _interface.ITestStatsParkingHandler {
+func NewTestStatsParkingHandler(c *echo.Group, useCase _interface.ITestStatsParkingUseCase) _interface.ITestStatsParkingHandler {
 	handler := &TestStatsParkingHandler{
 		UseCase: useCase,
 	}
-	c.GET("/v0.1/parking/:projectId/images/test-folders", handler.GetTestStats)
+	c.GET("/:projectId/images/test-folders", handler.GetTestStats)
 	return handler
 }
 
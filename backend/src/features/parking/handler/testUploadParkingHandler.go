@@ -15,11 +15,11 @@ type TestUploadParkingHandler struct {
 	UseCase _interface.ITestUploadParkingUseCase
 }
 
-func NewTestUploadParkingHandler(c *echo.Echo, useCase _interface.ITestUploadParkingUseCase) _interface.ITestUploadParkingHandler {
+func NewTestUploadParkingHandler(c *echo.Group, useCase _interface.ITestUploadParkingUseCase) _interface.ITestUploadParkingHandler {
 	handler := &TestUploadParkingHandler{
 		UseCase: useCase,
 	}
-	c.POST("/v0.1/parking/:projectId/test-images", handler.TestUpload)
+	c.POST("/:projectId/test-images", handler.TestUpload)
 	return handler
 }
 
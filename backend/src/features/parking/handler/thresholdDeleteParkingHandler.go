@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ThresholdDeleteParkingHandler struct {
+	UseCase _interface.IThresholdDeleteParkingUseCase
+}
+
+func NewThresholdDeleteParkingHandler(c *echo.Group, useCase _interface.IThresholdDeleteParkingUseCase) _interface.IThresholdDeleteParkingHandler {
+	handler := &ThresholdDeleteParkingHandler{
+		UseCase: useCase,
+	}
+	c.DELETE("/:projectId/thresholds", handler.DeleteThreshold)
+	return handler
+}
+
+// 점유 판정 기준 삭제
+// @Router /v0.1/parking/{projectId}/thresholds [delete]
+// @Summary 점유 판정 기준 삭제
+// @Description 저장 때와 같은 규칙으로 범위를 정합니다. (cctvId 없음: 프로젝트, cctvId: CCTV, cctvId+roiId 또는 cctvId+parkingId: parking_id)
+// @Description 삭제하면 상위 기준이 적용되고, 프로젝트 기준을 지우면 기본값으로 돌아갑니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 잘못된 범위, 번호를 찾을 수 없는 parkingId
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 저장된 기준 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 삭제 실패
+// @Description
+// @Produce json
+// @Param projectId path string true "프로젝트 ID"
+// @Param cctvId query string false "CCTV ID"
+// @Param roiId query int false "ROI(parking_id) 번호"
+// @Param parkingId query string false "주차면 ID (roiId 대신 사용)"
+// @Success 200 {object} response.ResThresholds
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags parking
+func (d *ThresholdDeleteParkingHandler) DeleteThreshold(c echo.Context) error {
+	var roiID *int
+	if value := c.QueryParam("roiId"); value != "" {
+		id, err := strconv.Atoi(value)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"success": false,
+				"message": "roiId는 숫자여야 합니다",
+			})
+		}
+		roiID = &id
+	}
+
+	ctx, _, _ := common.CtxGenerate(c)
+	result, err := d.UseCase.DeleteThreshold(ctx, c.Param("projectId"), c.QueryParam("cctvId"), roiID, c.QueryParam("parkingId"))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
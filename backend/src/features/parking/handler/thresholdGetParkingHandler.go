@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/http"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ThresholdGetParkingHandler struct {
+	UseCase _interface.IThresholdGetParkingUseCase
+}
+
+func NewThresholdGetParkingHandler(c *echo.Group, useCase _interface.IThresholdGetParkingUseCase) _interface.IThresholdGetParkingHandler {
+	handler := &ThresholdGetParkingHandler{
+		UseCase: useCase,
+	}
+	c.GET("/:projectId/thresholds", handler.GetThresholds)
+	return handler
+}
+
+// 점유 판정 기준 조회
+// @Router /v0.1/parking/{projectId}/thresholds [get]
+// @Summary 점유 판정 기준 조회
+// @Description ROI 전경 비율이 기준 이상이면 점유(occupied)로 판정합니다.
+// @Description 적용 순서는 parking_id 기준 > CCTV 기준 > 프로젝트 기준 > 기본값(default_threshold) 입니다.
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 조회 실패
+// @Description
+// @Produce json
+// @Param projectId path string true "프로젝트 ID"
+// @Success 200 {object} response.ResThresholds
+// @Failure 500 {object} map[string]interface{}
+// @Tags parking
+func (d *ThresholdGetParkingHandler) GetThresholds(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	result, err := d.UseCase.GetThresholds(ctx, c.Param("projectId"))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
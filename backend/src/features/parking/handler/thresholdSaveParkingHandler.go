@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"net/http"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/request"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ThresholdSaveParkingHandler struct {
+	UseCase _interface.IThresholdSaveParkingUseCase
+}
+
+func NewThresholdSaveParkingHandler(c *echo.Group, useCase _interface.IThresholdSaveParkingUseCase) _interface.IThresholdSaveParkingHandler {
+	handler := &ThresholdSaveParkingHandler{
+		UseCase: useCase,
+	}
+	c.PUT("/:projectId/thresholds", handler.SaveThreshold)
+	return handler
+}
+
+// 점유 판정 기준 저장
+// @Router /v0.1/parking/{projectId}/thresholds [put]
+// @Summary 점유 판정 기준 저장
+// @Description cctvId가 없으면 프로젝트 기준, cctvId만 있으면 CCTV 기준, roiId(parking_id 번호)까지 있으면 해당 ROI 기준을 저장합니다.
+// @Description roiId 대신 parkingId(ROI 파일의 주차면 ID)를 보내면 저장된 주차면 번호로 바꿔 저장합니다.
+// @Description 같은 범위의 기준이 이미 있으면 값을 바꿉니다. 저장 후 전체 기준을 반환합니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 잘못된 범위 또는 threshold (0 초과 1 이하), 번호를 찾을 수 없는 parkingId
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 저장 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param projectId path string true "프로젝트 ID"
+// @Param request body request.ReqSaveThreshold true "점유 판정 기준"
+// @Success 200 {object} response.ResThresholds
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags parking
+func (d *ThresholdSaveParkingHandler) SaveThreshold(c echo.Context) error {
+	var req request.ReqSaveThreshold
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "Invalid request body",
+		})
+	}
+
+	ctx, _, _ := common.CtxGenerate(c)
+	result, err := d.UseCase.SaveThreshold(ctx, c.Param("projectId"), req)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"net/http"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/request"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ZoneDeriveParkingHandler struct {
+	UseCase _interface.IZoneDeriveParkingUseCase
+}
+
+func NewZoneDeriveParkingHandler(c *echo.Group, useCase _interface.IZoneDeriveParkingUseCase) _interface.IZoneDeriveParkingHandler {
+	handler := &ZoneDeriveParkingHandler{
+		UseCase: useCase,
+	}
+	c.POST("/:projectId/zones/derive", handler.DeriveZones)
+	return handler
+}
+
+// 이름 규칙으로 구역 만들기
+// @Router /v0.1/parking/{projectId}/zones/derive [post]
+// @Summary 이름 규칙으로 구역 만들기
+// @Description ROI 파일(uploads/roi/{roiPath})의 모든 주차면에 구역 이름 규칙을 적용해 구역을 만듭니다.
+// @Description rules를 보내면 저장된 규칙 대신 사용합니다 (규칙을 저장하기 전에 시험할 때).
+// @Description preview가 true면 저장하지 않고 결과만 반환하며, 아니면 기존 구역 전체를 교체합니다 (같은 lot/floor/zone 구역은 ID 유지).
+// @Description 웹훅 구독이 걸린 구역이 없어지게 되면 저장하지 않고 CONFLICT를 반환합니다.
+// @Description 어떤 규칙에도 맞지 않는 주차면은 unmatched로 알려주고 구역에 넣지 않습니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : roiPath 없음, 규칙 없음/오류, 규칙으로 만든 이름이 100자 초과
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : ROI 파일 없음
+// @Description
+// @Description ■ errCode with 409
+// @Description CONFLICT : 웹훅 구독이 걸린 구역이 없어짐 (구독을 먼저 삭제)
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 조회/저장 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param projectId path string true "프로젝트 ID"
+// @Param request body request.ReqDeriveZones true "ROI 파일과 규칙"
+// @Success 200 {object} response.ResDeriveZones
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags parking
+func (d *ZoneDeriveParkingHandler) DeriveZones(c echo.Context) error {
+	var req request.ReqDeriveZones
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "Invalid request body",
+		})
+	}
+
+	ctx, _, _ := common.CtxGenerate(c)
+	result, err := d.UseCase.DeriveZones(ctx, c.Param("projectId"), req)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
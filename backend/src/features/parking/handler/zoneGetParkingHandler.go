@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/http"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ZoneGetParkingHandler struct {
+	UseCase _interface.IZoneGetParkingUseCase
+}
+
+func NewZoneGetParkingHandler(c *echo.Group, useCase _interface.IZoneGetParkingUseCase) _interface.IZoneGetParkingHandler {
+	handler := &ZoneGetParkingHandler{
+		UseCase: useCase,
+	}
+	c.GET("/:projectId/zones", handler.GetZones)
+	return handler
+}
+
+// 구역 조회
+// @Router /v0.1/parking/{projectId}/zones [get]
+// @Summary 구역 조회
+// @Description 프로젝트의 구역 계층(주차장 lot → 층 floor → 구역 zone → 주차면)과 단계별 주차면 수(capacity)를 반환합니다.
+// @Description 주차면은 CCTV별 parking_id이며 한 구역에만 속합니다.
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 조회 실패
+// @Description
+// @Produce json
+// @Param projectId path string true "프로젝트 ID"
+// @Success 200 {object} response.ResZones
+// @Failure 500 {object} map[string]interface{}
+// @Tags parking
+func (d *ZoneGetParkingHandler) GetZones(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	result, err := d.UseCase.GetZones(ctx, c.Param("projectId"))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
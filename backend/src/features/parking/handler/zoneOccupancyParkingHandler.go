@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"net/http"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ZoneOccupancyParkingHandler struct {
+	UseCase _interface.IZoneOccupancyParkingUseCase
+}
+
+func NewZoneOccupancyParkingHandler(c *echo.Group, useCase _interface.IZoneOccupancyParkingUseCase) _interface.IZoneOccupancyParkingHandler {
+	handler := &ZoneOccupancyParkingHandler{
+		UseCase: useCase,
+	}
+	c.GET("/:projectId/zones/occupancy", handler.GetZoneOccupancy)
+	return handler
+}
+
+// 구역별 점유 현황
+// @Router /v0.1/parking/{projectId}/zones/occupancy [get]
+// @Summary 구역별 점유 현황
+// @Description 실험(experimentId) 또는 가장 최근 실시간 학습 결과(experimentId 생략)의 ROI 결과를 구역, 층, 주차장별로 집계합니다.
+// @Description 점유 판정은 저장된 점유 판정 기준(parking_id > CCTV > 프로젝트)을 주차면마다 적용합니다.
+// @Description 결과가 없는 주차면은 unknown이며 occupancy_rate(결과가 있는 주차면 중 점유 비율 %) 계산에서 제외됩니다.
+// @Description unassigned는 결과는 있지만 어느 구역에도 속하지 않은 ROI 수입니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 잘못된 실험 ID
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 실험 또는 실시간 학습 결과 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 조회 실패
+// @Description INTERNAL_SERVER : 실시간 학습 결과 읽기 실패
+// @Description
+// @Produce json
+// @Param projectId path string true "프로젝트 ID"
+// @Param experimentId query int false "실험(ExperimentSession) ID (생략 시 실시간 학습 결과)"
+// @Success 200 {object} response.ResZoneOccupancy
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags parking
+func (d *ZoneOccupancyParkingHandler) GetZoneOccupancy(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	result, err := d.UseCase.GetZoneOccupancy(ctx, c.Param("projectId"), c.QueryParam("experimentId"))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"net/http"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ZoneRulesGetParkingHandler struct {
+	UseCase _interface.IZoneRulesGetParkingUseCase
+}
+
+func NewZoneRulesGetParkingHandler(c *echo.Group, useCase _interface.IZoneRulesGetParkingUseCase) _interface.IZoneRulesGetParkingHandler {
+	handler := &ZoneRulesGetParkingHandler{
+		UseCase: useCase,
+	}
+	c.GET("/:projectId/zones/rules", handler.GetZoneRules)
+	return handler
+}
+
+// 구역 이름 규칙 조회
+// @Router /v0.1/parking/{projectId}/zones/rules [get]
+// @Summary 구역 이름 규칙 조회
+// @Description CCTV ID와 parking_id로 구역을 정하는 규칙을 적용 순서(priority)대로 반환합니다.
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 조회 실패
+// @Description
+// @Produce json
+// @Param projectId path string true "프로젝트 ID"
+// @Success 200 {object} response.ResZoneRules
+// @Failure 500 {object} map[string]interface{}
+// @Tags parking
+func (d *ZoneRulesGetParkingHandler) GetZoneRules(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	result, err := d.UseCase.GetZoneRules(ctx, c.Param("projectId"))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"net/http"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/request"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ZoneRulesSaveParkingHandler struct {
+	UseCase _interface.IZoneRulesSaveParkingUseCase
+}
+
+func NewZoneRulesSaveParkingHandler(c *echo.Group, useCase _interface.IZoneRulesSaveParkingUseCase) _interface.IZoneRulesSaveParkingHandler {
+	handler := &ZoneRulesSaveParkingHandler{
+		UseCase: useCase,
+	}
+	c.PUT("/:projectId/zones/rules", handler.SaveZoneRules)
+	return handler
+}
+
+// 구역 이름 규칙 저장
+// @Router /v0.1/parking/{projectId}/zones/rules [put]
+// @Summary 구역 이름 규칙 저장
+// @Description 구역 이름 규칙 전체를 교체합니다. 목록 순서대로 맞춰 보고 처음 맞는 규칙을 적용합니다.
+// @Description cctvPattern, parkingPattern : 정규식 (비어 있으면 모두 일치), 이름 그룹 (?P<이름>...)으로 값을 뽑습니다.
+// @Description lot, floor, zone : ${이름} 템플릿 (비어 있으면 같은 이름의 그룹, 값이 없으면 default)
+// @Description 예) cctvPattern "^(?P<lot>[^_]+)_(?P<floor>[^_]+)_(?P<zone>[^_]+)_" 이면 P1_B3_1_3 → P1 / B3 / 1
+// @Description 규칙만 저장하며, 구역은 zones/derive로 다시 만듭니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 잘못된 정규식, 100개 초과, 길이 초과
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 저장 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param projectId path string true "프로젝트 ID"
+// @Param request body request.ReqSaveZoneRules true "구역 이름 규칙"
+// @Success 200 {object} response.ResZoneRules
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags parking
+func (d *ZoneRulesSaveParkingHandler) SaveZoneRules(c echo.Context) error {
+	var req request.ReqSaveZoneRules
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "Invalid request body",
+		})
+	}
+
+	ctx, _, _ := common.CtxGenerate(c)
+	result, err := d.UseCase.SaveZoneRules(ctx, c.Param("projectId"), req)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"net/http"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/request"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ZoneSaveParkingHandler struct {
+	UseCase _interface.IZoneSaveParkingUseCase
+}
+
+func NewZoneSaveParkingHandler(c *echo.Group, useCase _interface.IZoneSaveParkingUseCase) _interface.IZoneSaveParkingHandler {
+	handler := &ZoneSaveParkingHandler{
+		UseCase: useCase,
+	}
+	c.PUT("/:projectId/zones", handler.SaveZones)
+	return handler
+}
+
+// 구역 저장
+// @Router /v0.1/parking/{projectId}/zones [put]
+// @Summary 구역 저장
+// @Description 프로젝트의 구역 전체를 spaces로 교체합니다. 주차면(cctvId, parkingId)마다 lot, floor, zone을 지정합니다.
+// @Description spaces에 없는 주차면은 어느 구역에도 속하지 않게 되고, 빈 목록이면 구역이 모두 삭제됩니다.
+// @Description 같은 lot/floor/zone 구역은 ID가 유지되며, 없어진 구역은 삭제됩니다.
+// @Description 없어질 구역에 웹훅 구독이 걸려 있으면 아무것도 저장하지 않고 CONFLICT를 반환합니다 (구독을 먼저 삭제).
+// @Description 저장 후 구역 계층을 반환합니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 빈 이름, 100자 초과, 같은 주차면을 두 구역에 지정
+// @Description
+// @Description ■ errCode with 409
+// @Description CONFLICT : 웹훅 구독이 걸린 구역이 없어짐 (메시지에 구역과 구독 id)
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 저장 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param projectId path string true "프로젝트 ID"
+// @Param request body request.ReqSaveZones true "주차면별 구역"
+// @Success 200 {object} response.ResZones
+// @Failure 400 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags parking
+func (d *ZoneSaveParkingHandler) SaveZones(c echo.Context) error {
+	var req request.ReqSaveZones
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "Invalid request body",
+		})
+	}
+
+	ctx, _, _ := common.CtxGenerate(c)
+	result, err := d.UseCase.SaveZones(ctx, c.Param("projectId"), req)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, result)
+}
@@ -6,6 +6,7 @@ type RoiResult struct {
 }
 type CctvResult struct {
 	CctvID           string      `json:"cctv_id"`
+	TestImage        string      `json:"test_image"`
 	LearningDataSize int         `json:"learning_data_size"`
 	RoiResults       []RoiResult `json:"roi_results"`
 }
@@ -15,3 +16,19 @@ type ExperimentResult struct {
 	Results    []CctvResult `json:"results"`
 	TotalTests int          `json:"total_tests"`
 }
+
+// LearningProgress OpenCV가 출력하는 진행 상황 (PROGRESS:{...})
+type LearningProgress struct {
+	Stage         string `json:"stage"`
+	CctvID        string `json:"cctv_id,omitempty"`
+	Image         string `json:"image,omitempty"`
+	Index         int    `json:"index,omitempty"`
+	Total         int    `json:"total,omitempty"`
+	LearnedImages int    `json:"learned_images,omitempty"`
+	RoiCount      int    `json:"roi_count,omitempty"`
+}
+
+// LearningMessage OpenCV 일반 출력(log)/경고(warning) 한 줄
+type LearningMessage struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"time"
+
+	"main/common/zones"
+)
+
+// OccupancyHistoryFilter 점유 이력 조회 조건 (Spaces가 있으면 해당 주차면만, CctvID가 있으면 해당 CCTV만)
+type OccupancyHistoryFilter struct {
+	ProjectID string
+	CctvID    string
+	Spaces    []zones.Space
+	From      time.Time
+	To        time.Time
+}
+
+// OccupancyRetention 점유 이력 보관 정책 (일수가 0이면 해당 단계는 보관)
+type OccupancyRetention struct {
+	// RawDays 원본 점을 시간 단위로 줄이기 전까지 보관하는 일수
+	RawDays int
+	// HourlyDays 시간 단위 이력을 삭제하기 전까지 보관하는 일수
+	HourlyDays int
+}
@@ -65,3 +65,95 @@ type IBatchImagesParkingHandler interface {
 type ILiveLearningParkingHandler interface {
 	LiveLearning(c echo.Context) error
 }
+
+type ILiveStateParkingHandler interface {
+	GetLiveState(c echo.Context) error
+}
+
+type IJobEventsParkingHandler interface {
+	JobEvents(c echo.Context) error
+}
+
+type ICreateSweepParkingHandler interface {
+	CreateSweep(c echo.Context) error
+}
+
+type IGetSweepParkingHandler interface {
+	GetSweep(c echo.Context) error
+}
+
+type IEvaluationParkingHandler interface {
+	GetEvaluation(c echo.Context) error
+}
+
+type ICurvesParkingHandler interface {
+	GetCurves(c echo.Context) error
+}
+
+type IThresholdGetParkingHandler interface {
+	GetThresholds(c echo.Context) error
+}
+
+type IThresholdSaveParkingHandler interface {
+	SaveThreshold(c echo.Context) error
+}
+
+type IThresholdDeleteParkingHandler interface {
+	DeleteThreshold(c echo.Context) error
+}
+
+type IAlignmentParkingHandler interface {
+	GetAlignment(c echo.Context) error
+}
+
+type IZoneGetParkingHandler interface {
+	GetZones(c echo.Context) error
+}
+
+type IZoneSaveParkingHandler interface {
+	SaveZones(c echo.Context) error
+}
+
+type IZoneRulesGetParkingHandler interface {
+	GetZoneRules(c echo.Context) error
+}
+
+type IZoneRulesSaveParkingHandler interface {
+	SaveZoneRules(c echo.Context) error
+}
+
+type ICategoryGetParkingHandler interface {
+	GetCategories(c echo.Context) error
+}
+
+type ICategorySaveParkingHandler interface {
+	SaveCategories(c echo.Context) error
+}
+
+type IZoneDeriveParkingHandler interface {
+	DeriveZones(c echo.Context) error
+}
+
+type IZoneOccupancyParkingHandler interface {
+	GetZoneOccupancy(c echo.Context) error
+}
+
+type IOccupancySamplesParkingHandler interface {
+	GetOccupancySamples(c echo.Context) error
+}
+
+type IOccupancyBucketsParkingHandler interface {
+	GetOccupancyBuckets(c echo.Context) error
+}
+
+type IOccupancyStateAtParkingHandler interface {
+	GetOccupancyStateAt(c echo.Context) error
+}
+
+type ILiveEventsParkingHandler interface {
+	LiveEvents(c echo.Context) error
+}
+
+type IAnalyticsParkingHandler interface {
+	GetAnalytics(c echo.Context) error
+}
@@ -3,7 +3,10 @@ package _interface
 import (
 	"context"
 	"main/common/db/mysql"
+	"main/common/zones"
+	"main/features/parking/model/entity"
 	"main/features/parking/model/response"
+	"time"
 )
 
 type ILearningUploadParkingRepository interface {
@@ -25,13 +28,24 @@ type IRoiStatsParkingRepository interface {
 }
 
 type ILearningParkingRepository interface {
+	CreateLearningJob(ctx context.Context, job mysql.LearningJobs) (uint, error)
+	UpdateLearningJob(ctx context.Context, jobID uint, fields map[string]interface{}) error
+	FailUnfinishedLearningJobs(ctx context.Context, message string) (int64, error)
+	WithTransaction(ctx context.Context, fn func(repo ILearningParkingRepository) error) error
 	CreateExperimentSession(ctx context.Context, experimentSession mysql.ExperimentSessions) (int, error)
 	CreateCctvResult(ctx context.Context, cctvResult mysql.CctvResults) (int, error)
 	CreateRoiResult(ctx context.Context, roiResult mysql.RoiResults) error
+	EnsureParkingSpaces(ctx context.Context, projectID string, parkingIDs map[string][]string) ([]mysql.ParkingSpaces, error)
+	FindProjectsWithoutParkingSpaces(ctx context.Context) ([]string, error)
 }
 
 type ILearningResultsParkingRepository interface {
 	GetLearningResults(ctx context.Context, projectID string, timestamp string) (response.ResLearningResults, error)
+	FindExperimentSessionByName(ctx context.Context, projectID string, name string) (mysql.ExperimentSessions, error)
+	FindCctvResultsBySessionIDs(ctx context.Context, sessionIDs []int) ([]mysql.CctvResults, error)
+	FindRoiResultsByCctvResultIDs(ctx context.Context, cctvResultIDs []int) ([]mysql.RoiResults, error)
+	FindOccupancyThresholds(ctx context.Context, projectID string) ([]mysql.OccupancyThresholds, error)
+	FindParkingSpaces(ctx context.Context, projectID string) ([]mysql.ParkingSpaces, error)
 }
 
 type ICctvImagesParkingRepository interface {
@@ -43,6 +57,10 @@ type IImageParkingRepository interface {
 type IHistoryParkingRepository interface {
 	GetHistory(ctx context.Context, projectID string) ([]mysql.ExperimentSessions, error)
 	FindCctvResultByExperimentSessionID(ctx context.Context, experimentSessionID int) ([]string, error)
+	FindCctvResultsBySessionIDs(ctx context.Context, sessionIDs []int) ([]mysql.CctvResults, error)
+	FindRoiResultsByCctvResultIDs(ctx context.Context, cctvResultIDs []int) ([]mysql.RoiResults, error)
+	FindOccupancyThresholds(ctx context.Context, projectID string) ([]mysql.OccupancyThresholds, error)
+	FindParkingSpaces(ctx context.Context, projectID string) ([]mysql.ParkingSpaces, error)
 }
 
 type ILabelGetParkingRepository interface {
@@ -58,7 +76,142 @@ type IBatchImagesParkingRepository interface {
 }
 
 type ILiveLearningParkingRepository interface {
+	FindOccupancyThresholds(ctx context.Context, projectID string) ([]mysql.OccupancyThresholds, error)
+	FindParkingSpaces(ctx context.Context, projectID string) ([]mysql.ParkingSpaces, error)
+	EnsureParkingSpaces(ctx context.Context, projectID string, parkingIDs map[string][]string) ([]mysql.ParkingSpaces, error)
+	ReplaceSpaceStates(ctx context.Context, projectID string, states []mysql.SpaceState, parkingIDs map[string][]string) error
+	FindSpaceStates(ctx context.Context, projectID string, cctvID string) ([]mysql.SpaceState, error)
+	InsertOccupancySamples(ctx context.Context, samples []mysql.OccupancySamples) error
+	IZoneGetParkingRepository
+}
+
+type ILiveStateParkingRepository interface {
+	FindSpaceStates(ctx context.Context, projectID string, cctvID string) ([]mysql.SpaceState, error)
 }
 
 type ICctvImageParkingRepository interface {
 }
+
+type IJobEventsParkingRepository interface {
+	FindLearningJobByID(ctx context.Context, jobID uint) (mysql.LearningJobs, error)
+}
+
+// ICreateSweepParkingRepository 조합별 작업 등록은 학습 작업과 같은 저장소 기능 사용
+type ICreateSweepParkingRepository interface {
+	ILearningParkingRepository
+	CreateSweep(ctx context.Context, sweep mysql.LearningSweeps) (uint, error)
+	UpdateSweepTotalRuns(ctx context.Context, sweepID uint) (int, error)
+}
+
+type IGetSweepParkingRepository interface {
+	FindSweepByID(ctx context.Context, sweepID uint) (mysql.LearningSweeps, error)
+	FindLearningJobsBySweepID(ctx context.Context, sweepID uint) ([]mysql.LearningJobs, error)
+	FindCctvResultsBySessionIDs(ctx context.Context, sessionIDs []int) ([]mysql.CctvResults, error)
+	FindRoiResultsByCctvResultIDs(ctx context.Context, cctvResultIDs []int) ([]mysql.RoiResults, error)
+	FindParkingSpaces(ctx context.Context, projectID string) ([]mysql.ParkingSpaces, error)
+}
+
+type IEvaluationParkingRepository interface {
+	FindExperimentSessionByID(ctx context.Context, experimentID uint) (mysql.ExperimentSessions, error)
+	FindCctvResultsBySessionID(ctx context.Context, experimentID int) ([]mysql.CctvResults, error)
+	FindRoiResultsByCctvResultIDs(ctx context.Context, cctvResultIDs []int) ([]mysql.RoiResults, error)
+	FindOccupancyThresholds(ctx context.Context, projectID string) ([]mysql.OccupancyThresholds, error)
+	FindParkingSpaces(ctx context.Context, projectID string) ([]mysql.ParkingSpaces, error)
+}
+
+// ICurvesParkingRepository 평가와 같은 실험/결과 조회 사용
+type ICurvesParkingRepository interface {
+	IEvaluationParkingRepository
+}
+
+type IThresholdGetParkingRepository interface {
+	FindOccupancyThresholds(ctx context.Context, projectID string) ([]mysql.OccupancyThresholds, error)
+	FindParkingSpaces(ctx context.Context, projectID string) ([]mysql.ParkingSpaces, error)
+}
+
+type IThresholdSaveParkingRepository interface {
+	FindOccupancyThresholds(ctx context.Context, projectID string) ([]mysql.OccupancyThresholds, error)
+	UpsertOccupancyThreshold(ctx context.Context, threshold mysql.OccupancyThresholds) error
+	FindParkingSpaces(ctx context.Context, projectID string) ([]mysql.ParkingSpaces, error)
+}
+
+type IThresholdDeleteParkingRepository interface {
+	FindOccupancyThresholds(ctx context.Context, projectID string) ([]mysql.OccupancyThresholds, error)
+	DeleteOccupancyThreshold(ctx context.Context, projectID string, scope string, cctvID string, roiID int) (int64, error)
+	FindParkingSpaces(ctx context.Context, projectID string) ([]mysql.ParkingSpaces, error)
+}
+
+type IAlignmentParkingRepository interface {
+}
+
+type IZoneGetParkingRepository interface {
+	FindParkingZones(ctx context.Context, projectID string) ([]mysql.ParkingZones, error)
+	FindParkingZoneSpaces(ctx context.Context, projectID string) ([]mysql.ParkingZoneSpaces, error)
+}
+
+// IZoneSaveParkingRepository 구역 전체 교체 (한 트랜잭션으로 기존 구역 삭제 후 생성)
+type IZoneSaveParkingRepository interface {
+	IZoneGetParkingRepository
+	ReplaceParkingZones(ctx context.Context, projectID string, assignments map[zones.Key][]zones.Space) error
+}
+
+type IZoneRulesGetParkingRepository interface {
+	FindParkingZoneRules(ctx context.Context, projectID string) ([]mysql.ParkingZoneRules, error)
+}
+
+type IZoneRulesSaveParkingRepository interface {
+	IZoneRulesGetParkingRepository
+	ReplaceParkingZoneRules(ctx context.Context, projectID string, rules []mysql.ParkingZoneRules) error
+}
+
+type ICategoryGetParkingRepository interface {
+	FindParkingSpaceCategories(ctx context.Context, projectID string) ([]mysql.ParkingSpaceCategories, error)
+}
+
+// ICategorySaveParkingRepository 주차면 종류 전체 교체 (한 트랜잭션으로 기존 종류 삭제 후 생성)
+type ICategorySaveParkingRepository interface {
+	ICategoryGetParkingRepository
+	ReplaceParkingSpaceCategories(ctx context.Context, projectID string, categories []mysql.ParkingSpaceCategories) error
+}
+
+type IZoneDeriveParkingRepository interface {
+	IZoneSaveParkingRepository
+	FindParkingZoneRules(ctx context.Context, projectID string) ([]mysql.ParkingZoneRules, error)
+}
+
+// IZoneOccupancyParkingRepository 평가와 같은 실험/결과 조회에 구역 조회 추가
+type IZoneOccupancyParkingRepository interface {
+	IEvaluationParkingRepository
+	IZoneGetParkingRepository
+}
+
+// IOccupancySamplesParkingRepository 원본 점 조회와 보관 정책 적용 (구역 필터는 구역 조회로)
+type IOccupancySamplesParkingRepository interface {
+	IZoneGetParkingRepository
+	FindOccupancySamples(ctx context.Context, filter entity.OccupancyHistoryFilter, limit int) ([]mysql.OccupancySamples, error)
+	DownsampleOccupancySamples(ctx context.Context, before time.Time) (int64, error)
+	DeleteOccupancyHourlySamples(ctx context.Context, before time.Time) (int64, error)
+}
+
+type IOccupancyBucketsParkingRepository interface {
+	IZoneGetParkingRepository
+	FindOccupancySampleBuckets(ctx context.Context, filter entity.OccupancyHistoryFilter, bucket time.Duration) ([]mysql.OccupancyHourlySamples, error)
+	FindOccupancyHourlySamples(ctx context.Context, filter entity.OccupancyHistoryFilter) ([]mysql.OccupancyHourlySamples, error)
+}
+
+type IOccupancyStateAtParkingRepository interface {
+	IZoneGetParkingRepository
+	FindOccupancySamplesAt(ctx context.Context, filter entity.OccupancyHistoryFilter, at time.Time) ([]mysql.OccupancySamples, error)
+	FindOccupancyHourlySamplesAt(ctx context.Context, filter entity.OccupancyHistoryFilter, at time.Time) ([]mysql.OccupancyHourlySamples, error)
+}
+
+type ILiveEventsParkingRepository interface {
+	FindSpaceStates(ctx context.Context, projectID string, cctvID string) ([]mysql.SpaceState, error)
+}
+
+// IAnalyticsParkingRepository 구간 집계에 점유 여부가 바뀐 점, 원본 점이 남아 있는 시작 시각 조회 추가 (체류 시간, 회전율)
+type IAnalyticsParkingRepository interface {
+	IOccupancyBucketsParkingRepository
+	FindOccupancyTransitions(ctx context.Context, filter entity.OccupancyHistoryFilter) ([]mysql.OccupancySamples, error)
+	FindLatestOccupancyHourlySample(ctx context.Context, projectID string) (mysql.OccupancyHourlySamples, bool, error)
+}
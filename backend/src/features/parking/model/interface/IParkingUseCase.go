@@ -2,9 +2,12 @@ package _interface
 
 import (
 	"context"
+	"main/common/jobevents"
+	"main/features/parking/model/entity"
 	"main/features/parking/model/request"
 	"main/features/parking/model/response"
 	"mime/multipart"
+	"time"
 )
 
 type ILearningUploadParkingUseCase interface {
@@ -33,6 +36,8 @@ type IRoiStatsParkingUseCase interface {
 
 type ILearningParkingUseCase interface {
 	Learning(ctx context.Context, req request.ReqLearning) (response.ResLearning, error)
+	RecoverLearningJobs(ctx context.Context) error
+	BackfillParkingSpaces(ctx context.Context) error
 }
 
 type ILearningResultsParkingUseCase interface {
@@ -74,3 +79,96 @@ type IBatchImagesParkingUseCase interface {
 type ILiveLearningParkingUseCase interface {
 	LiveLearning(ctx context.Context, req request.ReqLiveLearning) (response.ResLiveLearning, error)
 }
+
+type ILiveStateParkingUseCase interface {
+	GetLiveState(ctx context.Context, projectID string, cctvID string) (response.ResLiveState, error)
+}
+
+type IJobEventsParkingUseCase interface {
+	SubscribeJobEvents(ctx context.Context, projectID string, jobID string, lastEventID int) (jobevents.Subscription, error)
+}
+
+type ICreateSweepParkingUseCase interface {
+	CreateSweep(ctx context.Context, req request.ReqSweep) (response.ResCreateSweep, error)
+}
+
+type IGetSweepParkingUseCase interface {
+	GetSweep(ctx context.Context, projectID string, sweepID string) (response.ResSweep, error)
+}
+
+type IEvaluationParkingUseCase interface {
+	GetEvaluation(ctx context.Context, projectID string, experimentID string, threshold *float64) (response.ResEvaluation, error)
+}
+
+type ICurvesParkingUseCase interface {
+	GetCurves(ctx context.Context, projectID string, experimentID string, step float64) (response.ResCurves, error)
+}
+
+type IThresholdGetParkingUseCase interface {
+	GetThresholds(ctx context.Context, projectID string) (response.ResThresholds, error)
+}
+
+type IThresholdSaveParkingUseCase interface {
+	SaveThreshold(ctx context.Context, projectID string, req request.ReqSaveThreshold) (response.ResThresholds, error)
+}
+
+type IThresholdDeleteParkingUseCase interface {
+	DeleteThreshold(ctx context.Context, projectID string, cctvID string, roiID *int, parkingID string) (response.ResThresholds, error)
+}
+
+type IAlignmentParkingUseCase interface {
+	GetAlignment(ctx context.Context, projectID string, source string, folder string, tolerance float64) (response.ResAlignment, error)
+}
+
+type IZoneGetParkingUseCase interface {
+	GetZones(ctx context.Context, projectID string) (response.ResZones, error)
+}
+
+type IZoneSaveParkingUseCase interface {
+	SaveZones(ctx context.Context, projectID string, req request.ReqSaveZones) (response.ResZones, error)
+}
+
+type IZoneRulesGetParkingUseCase interface {
+	GetZoneRules(ctx context.Context, projectID string) (response.ResZoneRules, error)
+}
+
+type IZoneRulesSaveParkingUseCase interface {
+	SaveZoneRules(ctx context.Context, projectID string, req request.ReqSaveZoneRules) (response.ResZoneRules, error)
+}
+
+type ICategoryGetParkingUseCase interface {
+	GetCategories(ctx context.Context, projectID string) (response.ResCategories, error)
+}
+
+type ICategorySaveParkingUseCase interface {
+	SaveCategories(ctx context.Context, projectID string, req request.ReqSaveCategories) (response.ResCategories, error)
+}
+
+type IZoneDeriveParkingUseCase interface {
+	DeriveZones(ctx context.Context, projectID string, req request.ReqDeriveZones) (response.ResDeriveZones, error)
+}
+
+type IZoneOccupancyParkingUseCase interface {
+	GetZoneOccupancy(ctx context.Context, projectID string, experimentID string) (response.ResZoneOccupancy, error)
+}
+
+type IOccupancySamplesParkingUseCase interface {
+	GetOccupancySamples(ctx context.Context, projectID string, req request.ReqOccupancyHistory) (response.ResOccupancySamples, error)
+	StartOccupancyRetention(policy entity.OccupancyRetention, every time.Duration)
+}
+
+type IOccupancyBucketsParkingUseCase interface {
+	GetOccupancyBuckets(ctx context.Context, projectID string, req request.ReqOccupancyHistory) (response.ResOccupancyBuckets, error)
+}
+
+type IOccupancyStateAtParkingUseCase interface {
+	GetOccupancyStateAt(ctx context.Context, projectID string, req request.ReqOccupancyStateAt) (response.ResOccupancyStateAt, error)
+}
+
+type ILiveEventsParkingUseCase interface {
+	SubscribeLiveEvents(ctx context.Context, projectID string, lastEventID int) (jobevents.Subscription, error)
+}
+
+type IAnalyticsParkingUseCase interface {
+	GetAnalytics(ctx context.Context, projectID string, req request.ReqParkingAnalytics) (response.ResParkingAnalytics, error)
+}
@@ -0,0 +1,11 @@
+package request
+
+// ReqParkingAnalytics 분석 조건 (from/to는 서버 시간대의 YYYY-MM-DD, to 포함)
+type ReqParkingAnalytics struct {
+	CctvID string `query:"cctvId"`
+	ZoneID uint   `query:"zoneId"`
+	From   string `query:"from"`
+	To     string `query:"to"`
+	// Format json(기본) 또는 csv
+	Format string `query:"format"`
+}
@@ -0,0 +1,21 @@
+package request
+
+// ReqOccupancyHistory 점유 이력 조회 조건 (zoneId가 있으면 구역의 주차면만, from/to는 RFC3339)
+type ReqOccupancyHistory struct {
+	CctvID    string `query:"cctvId"`
+	ZoneID    uint   `query:"zoneId"`
+	ParkingID string `query:"parkingId"`
+	From      string `query:"from"`
+	To        string `query:"to"`
+	// Interval 집계 단위 (5m | hour | day)
+	Interval string `query:"interval"`
+	Limit    int    `query:"limit"`
+}
+
+// ReqOccupancyStateAt 특정 시각의 주차면 상태 조회 조건 (at은 RFC3339)
+type ReqOccupancyStateAt struct {
+	CctvID    string `query:"cctvId"`
+	ZoneID    uint   `query:"zoneId"`
+	ParkingID string `query:"parkingId"`
+	At        string `query:"at"`
+}
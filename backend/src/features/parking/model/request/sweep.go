@@ -0,0 +1,21 @@
+package request
+
+// ReqSweep 하이퍼파라미터 조합 실행 요청 (각 파라미터는 values 목록 또는 min/max/step 범위)
+type ReqSweep struct {
+	ProjectID          string     `json:"projectId"`
+	LearningRate       ParamRange `json:"learningRate"`
+	Iterations         ParamRange `json:"iterations"`
+	VarThreshold       ParamRange `json:"varThreshold"`
+	LearningPath       string     `json:"learningPath"`
+	TestPath           string     `json:"testPath"`
+	RoiPath            string     `json:"roiPath"`
+	OccupancyThreshold *float64   `json:"occupancyThreshold"`
+}
+
+// ParamRange values가 있으면 그대로 사용하고, 없으면 min부터 max까지 step 간격으로 생성
+type ParamRange struct {
+	Values []float64 `json:"values"`
+	Min    *float64  `json:"min"`
+	Max    *float64  `json:"max"`
+	Step   *float64  `json:"step"`
+}
@@ -0,0 +1,9 @@
+package request
+
+// ReqSaveThreshold cctvId가 없으면 프로젝트 기준, cctvId만 있으면 CCTV 기준, roiId 또는 parkingId까지 있으면 parking_id 기준
+type ReqSaveThreshold struct {
+	CctvID    string  `json:"cctvId"`
+	RoiID     *int    `json:"roiId"`
+	ParkingID string  `json:"parkingId"`
+	Threshold float64 `json:"threshold"`
+}
@@ -0,0 +1,49 @@
+package request
+
+// ReqSaveZones 프로젝트의 구역 전체를 spaces로 교체 (주차면마다 lot/floor/zone 지정)
+type ReqSaveZones struct {
+	Spaces []ZoneSpace `json:"spaces"`
+}
+
+type ZoneSpace struct {
+	Lot       string `json:"lot"`
+	Floor     string `json:"floor"`
+	Zone      string `json:"zone"`
+	CctvID    string `json:"cctvId"`
+	ParkingID string `json:"parkingId"`
+}
+
+// ReqSaveZoneRules 이름 규칙 전체 교체 (목록 순서가 적용 순서)
+type ReqSaveZoneRules struct {
+	Rules []ZoneRule `json:"rules"`
+}
+
+// ZoneRule cctvPattern/parkingPattern은 정규식 (비어 있으면 모두 일치)
+// lot/floor/zone은 ${그룹이름} 템플릿 (비어 있으면 같은 이름의 그룹)
+type ZoneRule struct {
+	CctvPattern    string `json:"cctvPattern"`
+	ParkingPattern string `json:"parkingPattern"`
+	Lot            string `json:"lot"`
+	Floor          string `json:"floor"`
+	Zone           string `json:"zone"`
+}
+
+// ReqSaveCategories 주차면 종류 전체를 spaces로 교체 (목록에 없는 주차면은 general)
+type ReqSaveCategories struct {
+	Spaces []SpaceCategory `json:"spaces"`
+}
+
+// SpaceCategory category는 영문 소문자, 숫자, _, - (예: general, disabled, ev)
+type SpaceCategory struct {
+	CctvID    string `json:"cctvId"`
+	ParkingID string `json:"parkingId"`
+	Category  string `json:"category"`
+}
+
+// ReqDeriveZones ROI 파일의 주차면에 이름 규칙을 적용해 구역 생성
+// rules가 있으면 저장된 규칙 대신 사용 (저장하지 않고 규칙을 시험할 때)
+type ReqDeriveZones struct {
+	RoiPath string     `json:"roiPath"`
+	Rules   []ZoneRule `json:"rules"`
+	Preview bool       `json:"preview"`
+}
@@ -0,0 +1,35 @@
+package response
+
+import "time"
+
+// ResAlignment 기준 프레임 대비 카메라 흔들림 검사 결과
+type ResAlignment struct {
+	Source     string            `json:"source"`
+	Tolerance  float64           `json:"tolerance"`
+	Total      int               `json:"total"`
+	Misaligned int               `json:"misaligned"`
+	Cameras    []CameraAlignment `json:"cameras"`
+}
+
+// CameraAlignment 카메라 하나의 검사 결과 (dx, dy는 원본 픽셀 단위로 이미지 내용이 움직인 양)
+// status: aligned, misaligned, uncertain(장면이 많이 달라 추정 불가), no_reference, size_mismatch
+type CameraAlignment struct {
+	CctvID     string  `json:"cctv_id"`
+	Image      string  `json:"image"`
+	Status     string  `json:"status"`
+	Misaligned bool    `json:"misaligned"`
+	Dx         float64 `json:"dx"`
+	Dy         float64 `json:"dy"`
+	Distance   float64 `json:"distance"`
+	Confidence float64 `json:"confidence"`
+	// SuggestedTranslate ROI 일괄 변환(POST /v0.1/roi/{projectId}/transform)의 translate에 그대로 사용
+	SuggestedTranslate *AlignmentTranslate `json:"suggested_translate,omitempty"`
+	ReferenceRoiFile   string              `json:"reference_roi_file,omitempty"`
+	ReferenceSavedAt   *time.Time          `json:"reference_saved_at,omitempty"`
+	Message            string              `json:"message,omitempty"`
+}
+
+type AlignmentTranslate struct {
+	Dx float64 `json:"dx"`
+	Dy float64 `json:"dy"`
+}
@@ -0,0 +1,68 @@
+package response
+
+// ResParkingAnalytics 기간 내 점유 이력 분석 (점유율은 관측된 점 중 점유 비율 %)
+type ResParkingAnalytics struct {
+	ProjectID string `json:"project_id"`
+	ZoneID    uint   `json:"zone_id,omitempty"`
+	CctvID    string `json:"cctv_id,omitempty"`
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Timezone  string `json:"timezone"`
+	// RawFrom 원본 점이 남아 있는 첫 날짜 (체류 시간/회전율은 이 날짜부터 계산, 기간 안에 없으면 null)
+	RawFrom *string `json:"raw_from"`
+	// Spaces 기간 내 한 번이라도 관측된 주차면 수
+	Spaces        int                         `json:"spaces"`
+	Samples       int                         `json:"samples"`
+	OccupancyRate float64                     `json:"occupancy_rate"`
+	Peak          *AnalyticsPeak              `json:"peak"`
+	HourOfDay     []AnalyticsHourOccupancy    `json:"hour_of_day"`
+	DayOfWeek     []AnalyticsWeekdayOccupancy `json:"day_of_week"`
+	Dwell         AnalyticsDwell              `json:"dwell"`
+	Turnover      []AnalyticsDailyTurnover    `json:"turnover"`
+	SpaceStats    []AnalyticsSpace            `json:"space_stats"`
+}
+
+// AnalyticsPeak 점유율이 가장 높았던 5분 구간 (같으면 이른 구간)
+type AnalyticsPeak struct {
+	BucketStart   string  `json:"bucket_start"`
+	OccupancyRate float64 `json:"occupancy_rate"`
+	Spaces        int     `json:"spaces"`
+}
+
+type AnalyticsHourOccupancy struct {
+	Hour            int     `json:"hour"`
+	Samples         int     `json:"samples"`
+	OccupiedSamples int     `json:"occupied_samples"`
+	OccupancyRate   float64 `json:"occupancy_rate"`
+}
+
+type AnalyticsWeekdayOccupancy struct {
+	Weekday         string  `json:"weekday"`
+	Samples         int     `json:"samples"`
+	OccupiedSamples int     `json:"occupied_samples"`
+	OccupancyRate   float64 `json:"occupancy_rate"`
+}
+
+// AnalyticsDwell 시작과 끝이 모두 기간 안에서 관측된 점유 구간의 체류 시간 (분)
+type AnalyticsDwell struct {
+	Intervals     int     `json:"intervals"`
+	AvgMinutes    float64 `json:"avg_minutes"`
+	MedianMinutes float64 `json:"median_minutes"`
+}
+
+// AnalyticsDailyTurnover 하루 동안 빈 자리에서 점유로 바뀐 횟수와 주차면당 회전율 (원본 점이 없는 날은 null)
+type AnalyticsDailyTurnover struct {
+	Date             string   `json:"date"`
+	Arrivals         *int     `json:"arrivals"`
+	Spaces           int      `json:"spaces"`
+	TurnoverPerSpace *float64 `json:"turnover_per_space"`
+}
+
+// AnalyticsSpace 주차면별 입차 수, 일평균 회전율(원본 점이 있는 날 기준, 없으면 null), 체류 시간
+type AnalyticsSpace struct {
+	CctvID         string   `json:"cctv_id"`
+	ParkingID      string   `json:"parking_id"`
+	Arrivals       int      `json:"arrivals"`
+	TurnoverPerDay *float64 `json:"turnover_per_day"`
+	AnalyticsDwell
+}
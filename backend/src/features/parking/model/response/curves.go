@@ -0,0 +1,41 @@
+package response
+
+type ResCurves struct {
+	ExperimentID uint           `json:"experiment_id"`
+	ProjectID    string         `json:"project_id"`
+	Name         string         `json:"name"`
+	Step         float64        `json:"step"`
+	Overall      CurveSet       `json:"overall"`
+	Cctvs        []CctvCurveSet `json:"cctvs"`
+}
+
+type CctvCurveSet struct {
+	CctvID string `json:"cctv_id"`
+	CurveSet
+}
+
+// CurveSet 임계값 0~1 구간별 ROC/PR 곡선 점과 최적 임계값
+// 한쪽 클래스만 있으면 roc_auc는 null, 차량 있음 라벨이 없으면 pr_auc는 null
+type CurveSet struct {
+	Positives   int          `json:"positives"`
+	Negatives   int          `json:"negatives"`
+	RocAuc      *float64     `json:"roc_auc"`
+	PrAuc       *float64     `json:"pr_auc"`
+	BestF1      *CurvePoint  `json:"best_f1"`
+	BestYoudenJ *CurvePoint  `json:"best_youden_j"`
+	Points      []CurvePoint `json:"points"`
+}
+
+type CurvePoint struct {
+	Threshold     float64 `json:"threshold"`
+	TruePositive  int     `json:"true_positive"`
+	FalsePositive int     `json:"false_positive"`
+	TrueNegative  int     `json:"true_negative"`
+	FalseNegative int     `json:"false_negative"`
+	Tpr           float64 `json:"tpr"`
+	Fpr           float64 `json:"fpr"`
+	Precision     float64 `json:"precision"`
+	Recall        float64 `json:"recall"`
+	F1            float64 `json:"f1"`
+	YoudenJ       float64 `json:"youden_j"`
+}
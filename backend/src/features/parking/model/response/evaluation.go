@@ -0,0 +1,54 @@
+package response
+
+// ResEvaluation threshold가 null이면 프로젝트에 저장된 기준(ROI/CCTV/프로젝트)을 ROI마다 적용
+type ResEvaluation struct {
+	ExperimentID uint                 `json:"experiment_id"`
+	ProjectID    string               `json:"project_id"`
+	Name         string               `json:"name"`
+	Threshold    *float64             `json:"threshold"`
+	Summary      EvaluationMetrics    `json:"summary"`
+	Cctvs        []CctvEvaluation     `json:"cctvs"`
+	Mismatches   []EvaluationMismatch `json:"mismatches"`
+}
+
+// EvaluationMetrics 차량 있음(positive) 기준 혼동 행렬과 지표 (분모가 0이면 0)
+type EvaluationMetrics struct {
+	TruePositive  int     `json:"true_positive"`
+	FalsePositive int     `json:"false_positive"`
+	TrueNegative  int     `json:"true_negative"`
+	FalseNegative int     `json:"false_negative"`
+	Labeled       int     `json:"labeled"`
+	Unlabeled     int     `json:"unlabeled"`
+	Accuracy      float64 `json:"accuracy"`
+	Precision     float64 `json:"precision"`
+	Recall        float64 `json:"recall"`
+	F1            float64 `json:"f1"`
+}
+
+type CctvEvaluation struct {
+	CctvID  string            `json:"cctv_id"`
+	Metrics EvaluationMetrics `json:"metrics"`
+	Rois    []RoiEvaluation   `json:"rois"`
+}
+
+// RoiEvaluation outcome: tp / fp / tn / fn / unlabeled
+type RoiEvaluation struct {
+	RoiID      int     `json:"roi_id"`
+	ParkingID  string  `json:"parking_id"`
+	Rate       float64 `json:"rate"`
+	Threshold  float64 `json:"threshold"`
+	HasVehicle *bool   `json:"has_vehicle"`
+	Predicted  bool    `json:"predicted"`
+	Outcome    string  `json:"outcome"`
+}
+
+type EvaluationMismatch struct {
+	CctvID     string  `json:"cctv_id"`
+	RoiID      int     `json:"roi_id"`
+	ParkingID  string  `json:"parking_id"`
+	Rate       float64 `json:"rate"`
+	Threshold  float64 `json:"threshold"`
+	HasVehicle bool    `json:"has_vehicle"`
+	Predicted  bool    `json:"predicted"`
+	Outcome    string  `json:"outcome"`
+}
@@ -13,4 +13,6 @@ type HistoryItem struct {
 	LearningRate float64  `json:"learning_rate"`
 	VarThreshold float64  `json:"var_threshold"`
 	CctvList     []string `json:"cctv_list"`
+	// Cctvs CCTV별 ROI 점유율과 현재 임계값 기준 점유 판정
+	Cctvs []CctvOccupancy `json:"cctvs"`
 }
@@ -1,5 +1,16 @@
 package response
 
 type ResLearning struct {
-	FolderPath string `json:"folder_path"`
+	JobID  uint   `json:"job_id"`
+	Status string `json:"status"`
+}
+
+// LearningJobFinished learning_job.finished 웹훅 이벤트 (error_message는 실패/취소일 때만)
+type LearningJobFinished struct {
+	JobID        uint   `json:"job_id"`
+	Status       string `json:"status"`
+	ResultFolder string `json:"result_folder"`
+	ErrorMessage string `json:"error_message,omitempty"`
+	DurationMs   int64  `json:"duration_ms"`
+	FinishedAt   string `json:"finished_at"`
 }
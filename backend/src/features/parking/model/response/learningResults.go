@@ -11,7 +11,9 @@ type LearningResultsData struct {
 	CctvList  []CctvResultInfo `json:"cctv_list"`
 }
 
+// CctvResultInfo rois는 DB에 저장된 ROI 결과 (저장되지 않은 결과 폴더면 빈 배열)
 type CctvResultInfo struct {
-	CctvID    string `json:"cctv_id"`
-	HasImages bool   `json:"has_images"`
+	CctvID    string         `json:"cctv_id"`
+	HasImages bool           `json:"has_images"`
+	Rois      []RoiOccupancy `json:"rois"`
 }
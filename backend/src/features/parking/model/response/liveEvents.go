@@ -0,0 +1,31 @@
+package response
+
+// LiveSpaceChange space_changed 이벤트 (previous는 이전 상태, 처음 관측된 주차면이면 null)
+type LiveSpaceChange struct {
+	CctvID     string  `json:"cctv_id"`
+	ParkingID  string  `json:"parking_id"`
+	RoiID      int     `json:"roi_id"`
+	Occupied   bool    `json:"occupied"`
+	Previous   *bool   `json:"previous"`
+	Rate       float64 `json:"rate"`
+	ObservedAt string  `json:"observed_at"`
+}
+
+// LiveImageUpdate image_updated 이벤트 (image_types의 이미지를 GET /v0.1/parking/{projectId}/{cctvId}/images/{imageType}로 다시 받음)
+type LiveImageUpdate struct {
+	CctvID      string   `json:"cctv_id"`
+	ImageTypes  []string `json:"image_types"`
+	SourceImage string   `json:"source_image"`
+	UpdatedAt   string   `json:"updated_at"`
+}
+
+// LiveRunFinished live_run_finished 이벤트 (success가 false면 결과 없이 끝난 실행)
+type LiveRunFinished struct {
+	Success    bool     `json:"success"`
+	Cctvs      []string `json:"cctvs"`
+	Occupied   int      `json:"occupied"`
+	Free       int      `json:"free"`
+	Changed    int      `json:"changed"`
+	Misaligned int      `json:"misaligned"`
+	FinishedAt string   `json:"finished_at"`
+}
@@ -1,6 +1,9 @@
 package response
 
 type ResLiveLearning struct {
-	Cctvs      []string `json:"cctvs"`
-	TotalCctvs int      `json:"total_cctvs"`
+	Cctvs      []string        `json:"cctvs"`
+	TotalCctvs int             `json:"total_cctvs"`
+	Results    []CctvOccupancy `json:"results"`
+	// Misaligned 기준 프레임에서 움직인 카메라 (해당 카메라의 점유 결과는 ROI가 어긋나 신뢰할 수 없음)
+	Misaligned []CameraAlignment `json:"misaligned,omitempty"`
 }
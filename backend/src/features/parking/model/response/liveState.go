@@ -0,0 +1,32 @@
+package response
+
+// ResLiveState 주차면별 최신 실시간 점유 상태 (updated_at은 가장 최근 관측 시각, 상태가 없으면 null)
+type ResLiveState struct {
+	ProjectID string          `json:"project_id"`
+	UpdatedAt *string         `json:"updated_at"`
+	Total     int             `json:"total"`
+	Occupied  int             `json:"occupied"`
+	Free      int             `json:"free"`
+	Cctvs     []LiveCctvState `json:"cctvs"`
+}
+
+// LiveCctvState CCTV의 가장 최근 관측 이미지와 주차면 상태
+type LiveCctvState struct {
+	CctvID      string           `json:"cctv_id"`
+	SourceImage string           `json:"source_image"`
+	ObservedAt  string           `json:"observed_at"`
+	Occupied    int              `json:"occupied"`
+	Free        int              `json:"free"`
+	Spaces      []LiveSpaceState `json:"spaces"`
+}
+
+// LiveSpaceState observed_at은 판정에 쓴 이미지의 시각 (주차면마다 마지막으로 결과가 나온 실행 기준)
+type LiveSpaceState struct {
+	ParkingID   string  `json:"parking_id"`
+	RoiID       int     `json:"roi_id"`
+	Occupied    bool    `json:"occupied"`
+	Rate        float64 `json:"rate"`
+	Threshold   float64 `json:"threshold"`
+	SourceImage string  `json:"source_image"`
+	ObservedAt  string  `json:"observed_at"`
+}
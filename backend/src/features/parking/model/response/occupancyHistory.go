@@ -0,0 +1,59 @@
+package response
+
+// ResOccupancySamples 실시간 학습마다 기록된 주차면 점유 원본 점 (관측 시각 순)
+type ResOccupancySamples struct {
+	ProjectID string `json:"project_id"`
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Total     int    `json:"total"`
+	// Truncated limit에 걸려 뒤쪽 점이 빠졌으면 true
+	Truncated bool              `json:"truncated"`
+	Samples   []OccupancySample `json:"samples"`
+}
+
+type OccupancySample struct {
+	CctvID     string  `json:"cctv_id"`
+	ParkingID  string  `json:"parking_id"`
+	Occupied   bool    `json:"occupied"`
+	Rate       float64 `json:"rate"`
+	ObservedAt string  `json:"observed_at"`
+}
+
+// ResOccupancyBuckets 구간별 점유 집계 (점이 없는 구간은 빠짐)
+type ResOccupancyBuckets struct {
+	ProjectID string            `json:"project_id"`
+	Interval  string            `json:"interval"`
+	From      string            `json:"from"`
+	To        string            `json:"to"`
+	Buckets   []OccupancyBucket `json:"buckets"`
+}
+
+// OccupancyBucket occupancy_rate는 구간 점 중 점유 비율 %, avg_rate는 평균 전경 비율
+type OccupancyBucket struct {
+	BucketStart     string  `json:"bucket_start"`
+	Spaces          int     `json:"spaces"`
+	Samples         int     `json:"samples"`
+	OccupiedSamples int     `json:"occupied_samples"`
+	OccupancyRate   float64 `json:"occupancy_rate"`
+	AvgRate         float64 `json:"avg_rate"`
+}
+
+// ResOccupancyStateAt 특정 시각에 주차면마다 마지막으로 관측된 상태
+type ResOccupancyStateAt struct {
+	ProjectID string                  `json:"project_id"`
+	At        string                  `json:"at"`
+	Total     int                     `json:"total"`
+	Occupied  int                     `json:"occupied"`
+	Free      int                     `json:"free"`
+	Spaces    []OccupancySpaceStateAt `json:"spaces"`
+}
+
+// OccupancySpaceStateAt source가 hourly면 시간 단위 이력의 다수결 (rate는 평균, observed_at은 구간 시작)
+type OccupancySpaceStateAt struct {
+	CctvID     string  `json:"cctv_id"`
+	ParkingID  string  `json:"parking_id"`
+	Occupied   bool    `json:"occupied"`
+	Rate       float64 `json:"rate"`
+	ObservedAt string  `json:"observed_at"`
+	Source     string  `json:"source"`
+}
@@ -1,7 +1,8 @@
 package response
 
 type ResRoiUpload struct {
-	TotalFiles int `json:"total_files"`
-	Success    int `json:"success"`
-	Failed     int `json:"failed"`
+	TotalFiles int      `json:"total_files"`
+	Success    int      `json:"success"`
+	Failed     int      `json:"failed"`
+	Errors     []string `json:"errors,omitempty"`
 }
@@ -0,0 +1,61 @@
+package response
+
+// ResCreateSweep 등록 도중 실패하면 그때까지 대기열에 넣은 작업만 job_ids에 담고 error에 실패 이유 설정
+type ResCreateSweep struct {
+	SweepID   uint   `json:"sweep_id"`
+	JobIDs    []uint `json:"job_ids"`
+	Total     int    `json:"total"`
+	Requested int    `json:"requested"`
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+}
+
+type ResSweep struct {
+	SweepID            uint            `json:"sweep_id"`
+	ProjectID          string          `json:"project_id"`
+	Status             string          `json:"status"`
+	Total              int             `json:"total"`
+	Finished           int             `json:"finished"`
+	OccupancyThreshold float64         `json:"occupancy_threshold"`
+	LearningPath       string          `json:"learning_path"`
+	TestPath           string          `json:"test_path"`
+	RoiPath            string          `json:"roi_path"`
+	CreatedAt          string          `json:"created_at"`
+	Best               *SweepRun       `json:"best"`
+	BestPerCctv        []SweepCctvBest `json:"best_per_cctv"`
+	Runs               []SweepRun      `json:"runs"`
+}
+
+// SweepRun 조합 하나의 실행 결과 (rank는 정확도 순위, 채점할 수 없으면 0)
+type SweepRun struct {
+	Rank                int                 `json:"rank"`
+	JobID               uint                `json:"job_id"`
+	Status              string              `json:"status"`
+	LearningRate        float64             `json:"learning_rate"`
+	Iterations          int                 `json:"iterations"`
+	VarThreshold        float64             `json:"var_threshold"`
+	ExperimentSessionID *int                `json:"experiment_session_id"`
+	ResultFolder        string              `json:"result_folder"`
+	Accuracy            *float64            `json:"accuracy"`
+	Correct             int                 `json:"correct"`
+	Labeled             int                 `json:"labeled"`
+	Cctvs               []SweepCctvAccuracy `json:"cctvs"`
+}
+
+type SweepCctvAccuracy struct {
+	CctvID   string  `json:"cctv_id"`
+	Accuracy float64 `json:"accuracy"`
+	Correct  int     `json:"correct"`
+	Labeled  int     `json:"labeled"`
+}
+
+type SweepCctvBest struct {
+	CctvID       string  `json:"cctv_id"`
+	JobID        uint    `json:"job_id"`
+	LearningRate float64 `json:"learning_rate"`
+	Iterations   int     `json:"iterations"`
+	VarThreshold float64 `json:"var_threshold"`
+	Accuracy     float64 `json:"accuracy"`
+	Correct      int     `json:"correct"`
+	Labeled      int     `json:"labeled"`
+}
@@ -0,0 +1,38 @@
+package response
+
+// ResThresholds 점유 판정 기준 (적용 순서: parking_id > CCTV > 프로젝트 > 기본값)
+type ResThresholds struct {
+	ProjectID         string          `json:"project_id"`
+	DefaultThreshold  float64         `json:"default_threshold"`
+	ProjectThreshold  float64         `json:"project_threshold"`
+	ProjectOverridden bool            `json:"project_overridden"`
+	Cctvs             []CctvThreshold `json:"cctvs"`
+}
+
+// CctvThreshold threshold가 null이면 CCTV 기준 없이 프로젝트 기준 사용
+type CctvThreshold struct {
+	CctvID    string         `json:"cctv_id"`
+	Threshold *float64       `json:"threshold"`
+	Rois      []RoiThreshold `json:"rois"`
+}
+
+// RoiThreshold parking_id는 ROI 번호에 대응하는 주차면 ID (대응이 없으면 빈 문자열)
+type RoiThreshold struct {
+	RoiID     int     `json:"roi_id"`
+	ParkingID string  `json:"parking_id"`
+	Threshold float64 `json:"threshold"`
+}
+
+// RoiOccupancy ROI 전경 비율과 적용한 기준, 점유 여부 (parking_id는 ROI 파일의 주차면 ID)
+type RoiOccupancy struct {
+	RoiID     int     `json:"roi_id"`
+	ParkingID string  `json:"parking_id"`
+	Rate      float64 `json:"rate"`
+	Threshold float64 `json:"threshold"`
+	Occupied  bool    `json:"occupied"`
+}
+
+type CctvOccupancy struct {
+	CctvID string         `json:"cctv_id"`
+	Rois   []RoiOccupancy `json:"rois"`
+}
@@ -0,0 +1,109 @@
+package response
+
+// ResZones 프로젝트 구역 계층 (주차장 → 층 → 구역 → 주차면)
+type ResZones struct {
+	ProjectID string    `json:"project_id"`
+	Capacity  int       `json:"capacity"`
+	Lots      []ZoneLot `json:"lots"`
+}
+
+type ZoneLot struct {
+	Lot      string      `json:"lot"`
+	Capacity int         `json:"capacity"`
+	Floors   []ZoneFloor `json:"floors"`
+}
+
+type ZoneFloor struct {
+	Floor    string     `json:"floor"`
+	Capacity int        `json:"capacity"`
+	Zones    []ZoneInfo `json:"zones"`
+}
+
+type ZoneInfo struct {
+	ID       uint        `json:"id"`
+	Zone     string      `json:"zone"`
+	Capacity int         `json:"capacity"`
+	Spaces   []ZoneSpace `json:"spaces"`
+}
+
+type ZoneSpace struct {
+	CctvID    string `json:"cctv_id"`
+	ParkingID string `json:"parking_id"`
+}
+
+// ResZoneRules 이름 규칙 (priority 순)
+type ResZoneRules struct {
+	ProjectID string     `json:"project_id"`
+	Rules     []ZoneRule `json:"rules"`
+}
+
+type ZoneRule struct {
+	Priority       int    `json:"priority"`
+	CctvPattern    string `json:"cctv_pattern"`
+	ParkingPattern string `json:"parking_pattern"`
+	Lot            string `json:"lot"`
+	Floor          string `json:"floor"`
+	Zone           string `json:"zone"`
+}
+
+// ResCategories 주차면 종류 (categories는 사용 중인 종류, general 포함)
+type ResCategories struct {
+	ProjectID  string          `json:"project_id"`
+	Categories []string        `json:"categories"`
+	Spaces     []SpaceCategory `json:"spaces"`
+}
+
+type SpaceCategory struct {
+	CctvID    string `json:"cctv_id"`
+	ParkingID string `json:"parking_id"`
+	Category  string `json:"category"`
+}
+
+// ResDeriveZones 이름 규칙으로 만든 구역 (preview면 저장하지 않음)
+type ResDeriveZones struct {
+	Success bool     `json:"success"`
+	Message string   `json:"message"`
+	Preview bool     `json:"preview"`
+	Zones   ResZones `json:"zones"`
+	// Unmatched 어떤 규칙에도 맞지 않아 구역에 넣지 못한 주차면
+	Unmatched []ZoneSpace `json:"unmatched"`
+}
+
+// ZoneCounts 주차면 수와 점유율 (occupancy_rate는 결과가 있는 주차면 중 점유 비율 %)
+type ZoneCounts struct {
+	Capacity      int     `json:"capacity"`
+	Occupied      int     `json:"occupied"`
+	Free          int     `json:"free"`
+	Unknown       int     `json:"unknown"`
+	OccupancyRate float64 `json:"occupancy_rate"`
+}
+
+// ResZoneOccupancy 실험 또는 실시간 결과의 구역별 점유 집계
+type ResZoneOccupancy struct {
+	ProjectID string `json:"project_id"`
+	// Source experiment 또는 live
+	Source       string `json:"source"`
+	ExperimentID uint   `json:"experiment_id,omitempty"`
+	ZoneCounts
+	Lots []LotOccupancy `json:"lots"`
+	// Unassigned 결과는 있지만 어느 구역에도 속하지 않은 주차면 수
+	Unassigned int `json:"unassigned"`
+}
+
+type LotOccupancy struct {
+	Lot string `json:"lot"`
+	ZoneCounts
+	Floors []FloorOccupancy `json:"floors"`
+}
+
+type FloorOccupancy struct {
+	Floor string `json:"floor"`
+	ZoneCounts
+	Zones []ZoneOccupancy `json:"zones"`
+}
+
+type ZoneOccupancy struct {
+	ID   uint   `json:"id"`
+	Zone string `json:"zone"`
+	ZoneCounts
+}
@@ -0,0 +1,11 @@
+package repository
+
+import (
+	_interface "main/features/parking/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewAlignmentParkingRepository(gormDB *gorm.DB) _interface.IAlignmentParkingRepository {
+	return &AlignmentParkingRepository{GormDB: gormDB}
+}
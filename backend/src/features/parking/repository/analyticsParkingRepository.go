@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	"main/features/parking/model/entity"
+	_interface "main/features/parking/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewAnalyticsParkingRepository(gormDB *gorm.DB) _interface.IAnalyticsParkingRepository {
+	return &AnalyticsParkingRepository{OccupancyBucketsParkingRepository{ZoneGetParkingRepository{GormDB: gormDB}}}
+}
+
+func (r *AnalyticsParkingRepository) FindOccupancyTransitions(ctx context.Context, filter entity.OccupancyHistoryFilter) ([]mysql.OccupancySamples, error) {
+	return findOccupancyTransitions(ctx, r.GormDB, filter)
+}
+
+func (r *AnalyticsParkingRepository) FindLatestOccupancyHourlySample(ctx context.Context, projectID string) (mysql.OccupancyHourlySamples, bool, error) {
+	return findLatestOccupancyHourlySample(ctx, r.GormDB, projectID)
+}
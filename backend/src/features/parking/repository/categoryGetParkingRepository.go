@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/parking/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewCategoryGetParkingRepository(gormDB *gorm.DB) _interface.ICategoryGetParkingRepository {
+	return &CategoryGetParkingRepository{GormDB: gormDB}
+}
+
+func (r *CategoryGetParkingRepository) FindParkingSpaceCategories(ctx context.Context, projectID string) ([]mysql.ParkingSpaceCategories, error) {
+	return findParkingSpaceCategories(ctx, r.GormDB, projectID)
+}
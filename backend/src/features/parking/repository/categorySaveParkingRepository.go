@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/parking/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewCategorySaveParkingRepository(gormDB *gorm.DB) _interface.ICategorySaveParkingRepository {
+	return &CategorySaveParkingRepository{CategoryGetParkingRepository{GormDB: gormDB}}
+}
+
+// ReplaceParkingSpaceCategories 기존 종류를 지우고 categories로 교체
+func (r *CategorySaveParkingRepository) ReplaceParkingSpaceCategories(ctx context.Context, projectID string, categories []mysql.ParkingSpaceCategories) error {
+	return r.GormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("project_id = ?", projectID).Delete(&mysql.ParkingSpaceCategories{}).Error; err != nil {
+			return err
+		}
+		if len(categories) == 0 {
+			return nil
+		}
+		return tx.Create(&categories).Error
+	})
+}
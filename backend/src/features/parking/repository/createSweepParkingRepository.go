@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/parking/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewCreateSweepParkingRepository(gormDB *gorm.DB) _interface.ICreateSweepParkingRepository {
+	return &CreateSweepParkingRepository{LearningParkingRepository{GormDB: gormDB}}
+}
+
+func (r *CreateSweepParkingRepository) CreateSweep(ctx context.Context, sweep mysql.LearningSweeps) (uint, error) {
+	result := r.GormDB.WithContext(ctx).Create(&sweep)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return sweep.ID, nil
+}
+
+// UpdateSweepTotalRuns total_runs를 실제로 등록된 작업 수로 맞추고 그 값을 반환 (일부 조합만 등록된 경우)
+func (r *CreateSweepParkingRepository) UpdateSweepTotalRuns(ctx context.Context, sweepID uint) (int, error) {
+	var count int64
+	if err := r.GormDB.WithContext(ctx).Model(&mysql.LearningJobs{}).Where("sweep_id = ?", sweepID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	result := r.GormDB.WithContext(ctx).Model(&mysql.LearningSweeps{}).Where("id = ?", sweepID).Update("total_runs", count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return int(count), nil
+}
@@ -0,0 +1,11 @@
+package repository
+
+import (
+	_interface "main/features/parking/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewCurvesParkingRepository(gormDB *gorm.DB) _interface.ICurvesParkingRepository {
+	return &CurvesParkingRepository{EvaluationParkingRepository{GormDB: gormDB}}
+}
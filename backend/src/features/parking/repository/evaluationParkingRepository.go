@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/parking/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewEvaluationParkingRepository(gormDB *gorm.DB) _interface.IEvaluationParkingRepository {
+	return &EvaluationParkingRepository{GormDB: gormDB}
+}
+
+func (r *EvaluationParkingRepository) FindExperimentSessionByID(ctx context.Context, experimentID uint) (mysql.ExperimentSessions, error) {
+	var experimentSession mysql.ExperimentSessions
+	result := r.GormDB.WithContext(ctx).Where("id = ?", experimentID).First(&experimentSession)
+	if result.Error != nil {
+		return mysql.ExperimentSessions{}, result.Error
+	}
+	return experimentSession, nil
+}
+
+func (r *EvaluationParkingRepository) FindCctvResultsBySessionID(ctx context.Context, experimentID int) ([]mysql.CctvResults, error) {
+	var cctvResults []mysql.CctvResults
+	result := r.GormDB.WithContext(ctx).Where("experiment_session_id = ?", experimentID).Order("cctv_id ASC").Find(&cctvResults)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return cctvResults, nil
+}
+
+func (r *EvaluationParkingRepository) FindRoiResultsByCctvResultIDs(ctx context.Context, cctvResultIDs []int) ([]mysql.RoiResults, error) {
+	return findRoiResultsByCctvResultIDs(ctx, r.GormDB, cctvResultIDs)
+}
+
+func (r *EvaluationParkingRepository) FindOccupancyThresholds(ctx context.Context, projectID string) ([]mysql.OccupancyThresholds, error) {
+	return findOccupancyThresholds(ctx, r.GormDB, projectID)
+}
+
+func (r *EvaluationParkingRepository) FindParkingSpaces(ctx context.Context, projectID string) ([]mysql.ParkingSpaces, error) {
+	return findParkingSpaces(ctx, r.GormDB, projectID)
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+
+	"gorm.io/gorm"
+)
+
+// findCctvResultsBySessionIDs 여러 실험의 CCTV 결과 조회 (결과/이력/스윕/평가 저장소에서 공용)
+func findCctvResultsBySessionIDs(ctx context.Context, db *gorm.DB, sessionIDs []int) ([]mysql.CctvResults, error) {
+	var cctvResults []mysql.CctvResults
+	if len(sessionIDs) == 0 {
+		return cctvResults, nil
+	}
+	result := db.WithContext(ctx).Where("experiment_session_id IN ?", sessionIDs).Order("cctv_id ASC").Find(&cctvResults)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return cctvResults, nil
+}
+
+// findRoiResultsByCctvResultIDs 여러 CCTV 결과의 ROI 결과 조회
+func findRoiResultsByCctvResultIDs(ctx context.Context, db *gorm.DB, cctvResultIDs []int) ([]mysql.RoiResults, error) {
+	var roiResults []mysql.RoiResults
+	if len(cctvResultIDs) == 0 {
+		return roiResults, nil
+	}
+	result := db.WithContext(ctx).Where("cctv_result_id IN ?", cctvResultIDs).Find(&roiResults)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return roiResults, nil
+}
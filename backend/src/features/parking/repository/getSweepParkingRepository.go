@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/parking/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewGetSweepParkingRepository(gormDB *gorm.DB) _interface.IGetSweepParkingRepository {
+	return &GetSweepParkingRepository{GormDB: gormDB}
+}
+
+func (r *GetSweepParkingRepository) FindSweepByID(ctx context.Context, sweepID uint) (mysql.LearningSweeps, error) {
+	var sweep mysql.LearningSweeps
+	result := r.GormDB.WithContext(ctx).Where("id = ?", sweepID).First(&sweep)
+	if result.Error != nil {
+		return mysql.LearningSweeps{}, result.Error
+	}
+	return sweep, nil
+}
+
+func (r *GetSweepParkingRepository) FindLearningJobsBySweepID(ctx context.Context, sweepID uint) ([]mysql.LearningJobs, error) {
+	var jobs []mysql.LearningJobs
+	result := r.GormDB.WithContext(ctx).Where("sweep_id = ?", sweepID).Order("id ASC").Find(&jobs)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return jobs, nil
+}
+
+func (r *GetSweepParkingRepository) FindCctvResultsBySessionIDs(ctx context.Context, sessionIDs []int) ([]mysql.CctvResults, error) {
+	return findCctvResultsBySessionIDs(ctx, r.GormDB, sessionIDs)
+}
+
+func (r *GetSweepParkingRepository) FindRoiResultsByCctvResultIDs(ctx context.Context, cctvResultIDs []int) ([]mysql.RoiResults, error) {
+	return findRoiResultsByCctvResultIDs(ctx, r.GormDB, cctvResultIDs)
+}
+
+func (r *GetSweepParkingRepository) FindParkingSpaces(ctx context.Context, projectID string) ([]mysql.ParkingSpaces, error) {
+	return findParkingSpaces(ctx, r.GormDB, projectID)
+}
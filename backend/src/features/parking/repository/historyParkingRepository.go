@@ -43,3 +43,19 @@ func (r *HistoryParkingRepository) FindCctvResultByExperimentSessionID(ctx conte
 
 	return cctvIDs, nil
 }
+
+func (r *HistoryParkingRepository) FindCctvResultsBySessionIDs(ctx context.Context, sessionIDs []int) ([]mysql.CctvResults, error) {
+	return findCctvResultsBySessionIDs(ctx, r.GormDB, sessionIDs)
+}
+
+func (r *HistoryParkingRepository) FindRoiResultsByCctvResultIDs(ctx context.Context, cctvResultIDs []int) ([]mysql.RoiResults, error) {
+	return findRoiResultsByCctvResultIDs(ctx, r.GormDB, cctvResultIDs)
+}
+
+func (r *HistoryParkingRepository) FindOccupancyThresholds(ctx context.Context, projectID string) ([]mysql.OccupancyThresholds, error) {
+	return findOccupancyThresholds(ctx, r.GormDB, projectID)
+}
+
+func (r *HistoryParkingRepository) FindParkingSpaces(ctx context.Context, projectID string) ([]mysql.ParkingSpaces, error) {
+	return findParkingSpaces(ctx, r.GormDB, projectID)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/parking/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewJobEventsParkingRepository(gormDB *gorm.DB) _interface.IJobEventsParkingRepository {
+	return &JobEventsParkingRepository{GormDB: gormDB}
+}
+
+func (r *JobEventsParkingRepository) FindLearningJobByID(ctx context.Context, jobID uint) (mysql.LearningJobs, error) {
+	var job mysql.LearningJobs
+	result := r.GormDB.WithContext(ctx).Where("id = ?", jobID).First(&job)
+	if result.Error != nil {
+		return mysql.LearningJobs{}, result.Error
+	}
+	return job, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"main/common/db/mysql"
 	_interface "main/features/parking/model/interface"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -12,6 +13,44 @@ func NewLearningParkingRepository(gormDB *gorm.DB) _interface.ILearningParkingRe
 	return &LearningParkingRepository{GormDB: gormDB}
 }
 
+func (r *LearningParkingRepository) CreateLearningJob(ctx context.Context, job mysql.LearningJobs) (uint, error) {
+	result := r.GormDB.WithContext(ctx).Create(&job)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return job.ID, nil
+}
+
+func (r *LearningParkingRepository) UpdateLearningJob(ctx context.Context, jobID uint, fields map[string]interface{}) error {
+	result := r.GormDB.WithContext(ctx).Model(&mysql.LearningJobs{}).Where("id = ?", jobID).Updates(fields)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+// FailUnfinishedLearningJobs 대기/실행 중으로 남아 있는 작업을 실패 처리
+func (r *LearningParkingRepository) FailUnfinishedLearningJobs(ctx context.Context, message string) (int64, error) {
+	result := r.GormDB.WithContext(ctx).Model(&mysql.LearningJobs{}).
+		Where("status IN ?", []string{mysql.LearningJobStatusQueued, mysql.LearningJobStatusRunning}).
+		Updates(map[string]interface{}{
+			"status":        mysql.LearningJobStatusFailed,
+			"error_message": message,
+			"finished_at":   time.Now(),
+		})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// WithTransaction fn 안의 저장 작업을 하나의 트랜잭션으로 처리 (에러 시 롤백)
+func (r *LearningParkingRepository) WithTransaction(ctx context.Context, fn func(repo _interface.ILearningParkingRepository) error) error {
+	return r.GormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&LearningParkingRepository{GormDB: tx})
+	})
+}
+
 func (r *LearningParkingRepository) CreateExperimentSession(ctx context.Context, experimentSession mysql.ExperimentSessions) (int, error) {
 	result := r.GormDB.WithContext(ctx).Create(&experimentSession)
 	if result.Error != nil {
@@ -35,3 +74,19 @@ func (r *LearningParkingRepository) CreateRoiResult(ctx context.Context, roiResu
 	}
 	return nil
 }
+
+func (r *LearningParkingRepository) EnsureParkingSpaces(ctx context.Context, projectID string, parkingIDs map[string][]string) ([]mysql.ParkingSpaces, error) {
+	return ensureParkingSpaces(ctx, r.GormDB, projectID, parkingIDs)
+}
+
+// FindProjectsWithoutParkingSpaces 주차면 번호 대응이 하나도 없는 프로젝트 ID (예전 규칙 번호를 옮겨 담을 대상)
+func (r *LearningParkingRepository) FindProjectsWithoutParkingSpaces(ctx context.Context) ([]string, error) {
+	var projectIDs []string
+	result := r.GormDB.WithContext(ctx).Model(&mysql.Projects{}).
+		Where("NOT EXISTS (SELECT 1 FROM parking_spaces WHERE parking_spaces.project_id = projects.id)").
+		Order("id ASC").Pluck("id", &projectIDs)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return projectIDs, nil
+}
@@ -2,16 +2,19 @@ package repository
 
 import (
 	"context"
+	"main/common/db/mysql"
 	_interface "main/features/parking/model/interface"
 	"main/features/parking/model/response"
+
+	"gorm.io/gorm"
 )
 
 type LearningResultsParkingRepository struct {
-	// 필요한 경우 DB 연결 등을 추가
+	GormDB *gorm.DB
 }
 
-func NewLearningResultsParkingRepository() _interface.ILearningResultsParkingRepository {
-	return &LearningResultsParkingRepository{}
+func NewLearningResultsParkingRepository(gormDB *gorm.DB) _interface.ILearningResultsParkingRepository {
+	return &LearningResultsParkingRepository{GormDB: gormDB}
 }
 
 func (r *LearningResultsParkingRepository) GetLearningResults(ctx context.Context, projectID string, timestamp string) (response.ResLearningResults, error) {
@@ -19,3 +22,29 @@ func (r *LearningResultsParkingRepository) GetLearningResults(ctx context.Contex
 	// 실제로는 DB 조회 로직이 들어갈 수 있음
 	return response.ResLearningResults{}, nil
 }
+
+// FindExperimentSessionByName 결과 폴더명(= 실험 이름)으로 실험 조회
+func (r *LearningResultsParkingRepository) FindExperimentSessionByName(ctx context.Context, projectID string, name string) (mysql.ExperimentSessions, error) {
+	var experimentSession mysql.ExperimentSessions
+	result := r.GormDB.WithContext(ctx).Where("project_id = ? AND name = ?", projectID, name).Order("id DESC").First(&experimentSession)
+	if result.Error != nil {
+		return mysql.ExperimentSessions{}, result.Error
+	}
+	return experimentSession, nil
+}
+
+func (r *LearningResultsParkingRepository) FindCctvResultsBySessionIDs(ctx context.Context, sessionIDs []int) ([]mysql.CctvResults, error) {
+	return findCctvResultsBySessionIDs(ctx, r.GormDB, sessionIDs)
+}
+
+func (r *LearningResultsParkingRepository) FindRoiResultsByCctvResultIDs(ctx context.Context, cctvResultIDs []int) ([]mysql.RoiResults, error) {
+	return findRoiResultsByCctvResultIDs(ctx, r.GormDB, cctvResultIDs)
+}
+
+func (r *LearningResultsParkingRepository) FindOccupancyThresholds(ctx context.Context, projectID string) ([]mysql.OccupancyThresholds, error) {
+	return findOccupancyThresholds(ctx, r.GormDB, projectID)
+}
+
+func (r *LearningResultsParkingRepository) FindParkingSpaces(ctx context.Context, projectID string) ([]mysql.ParkingSpaces, error) {
+	return findParkingSpaces(ctx, r.GormDB, projectID)
+}
@@ -0,0 +1,11 @@
+package repository
+
+import (
+	_interface "main/features/parking/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewLiveEventsParkingRepository(gormDB *gorm.DB) _interface.ILiveEventsParkingRepository {
+	return &LiveEventsParkingRepository{LiveStateParkingRepository{GormDB: gormDB}}
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+	"main/common/db/mysql"
 	_interface "main/features/parking/model/interface"
 
 	"gorm.io/gorm"
@@ -9,3 +11,35 @@ import (
 func NewLiveLearningParkingRepository(gormDB *gorm.DB) _interface.ILiveLearningParkingRepository {
 	return &LiveLearningParkingRepository{GormDB: gormDB}
 }
+
+func (r *LiveLearningParkingRepository) FindOccupancyThresholds(ctx context.Context, projectID string) ([]mysql.OccupancyThresholds, error) {
+	return findOccupancyThresholds(ctx, r.GormDB, projectID)
+}
+
+func (r *LiveLearningParkingRepository) FindParkingSpaces(ctx context.Context, projectID string) ([]mysql.ParkingSpaces, error) {
+	return findParkingSpaces(ctx, r.GormDB, projectID)
+}
+
+func (r *LiveLearningParkingRepository) EnsureParkingSpaces(ctx context.Context, projectID string, parkingIDs map[string][]string) ([]mysql.ParkingSpaces, error) {
+	return ensureParkingSpaces(ctx, r.GormDB, projectID, parkingIDs)
+}
+
+func (r *LiveLearningParkingRepository) ReplaceSpaceStates(ctx context.Context, projectID string, states []mysql.SpaceState, parkingIDs map[string][]string) error {
+	return replaceSpaceStates(ctx, r.GormDB, projectID, states, parkingIDs)
+}
+
+func (r *LiveLearningParkingRepository) InsertOccupancySamples(ctx context.Context, samples []mysql.OccupancySamples) error {
+	return insertOccupancySamples(ctx, r.GormDB, samples)
+}
+
+func (r *LiveLearningParkingRepository) FindSpaceStates(ctx context.Context, projectID string, cctvID string) ([]mysql.SpaceState, error) {
+	return findSpaceStates(ctx, r.GormDB, projectID, cctvID)
+}
+
+func (r *LiveLearningParkingRepository) FindParkingZones(ctx context.Context, projectID string) ([]mysql.ParkingZones, error) {
+	return findParkingZones(ctx, r.GormDB, projectID)
+}
+
+func (r *LiveLearningParkingRepository) FindParkingZoneSpaces(ctx context.Context, projectID string) ([]mysql.ParkingZoneSpaces, error) {
+	return findParkingZoneSpaces(ctx, r.GormDB, projectID)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/parking/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewLiveStateParkingRepository(gormDB *gorm.DB) _interface.ILiveStateParkingRepository {
+	return &LiveStateParkingRepository{GormDB: gormDB}
+}
+
+func (r *LiveStateParkingRepository) FindSpaceStates(ctx context.Context, projectID string, cctvID string) ([]mysql.SpaceState, error) {
+	return findSpaceStates(ctx, r.GormDB, projectID, cctvID)
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	"main/features/parking/model/entity"
+	_interface "main/features/parking/model/interface"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func NewOccupancyBucketsParkingRepository(gormDB *gorm.DB) _interface.IOccupancyBucketsParkingRepository {
+	return &OccupancyBucketsParkingRepository{ZoneGetParkingRepository{GormDB: gormDB}}
+}
+
+func (r *OccupancyBucketsParkingRepository) FindOccupancySampleBuckets(ctx context.Context, filter entity.OccupancyHistoryFilter, bucket time.Duration) ([]mysql.OccupancyHourlySamples, error) {
+	return findOccupancySampleBuckets(ctx, r.GormDB, filter, bucket)
+}
+
+func (r *OccupancyBucketsParkingRepository) FindOccupancyHourlySamples(ctx context.Context, filter entity.OccupancyHistoryFilter) ([]mysql.OccupancyHourlySamples, error) {
+	return findOccupancyHourlySamples(ctx, r.GormDB, filter)
+}
@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	"main/features/parking/model/entity"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// occupancyHistoryScope 프로젝트와 CCTV/주차면 조건 (원본 점, 시간 단위 이력 공용)
+func occupancyHistoryScope(filter entity.OccupancyHistoryFilter) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Where("project_id = ?", filter.ProjectID)
+		if filter.CctvID != "" {
+			db = db.Where("cctv_id = ?", filter.CctvID)
+		}
+		if len(filter.Spaces) > 0 {
+			pairs := make([][]interface{}, 0, len(filter.Spaces))
+			for _, space := range filter.Spaces {
+				pairs = append(pairs, []interface{}{space.CctvID, space.ParkingID})
+			}
+			db = db.Where("(cctv_id, parking_id) IN ?", pairs)
+		}
+		return db
+	}
+}
+
+// insertOccupancySamples 원본 점 추가 (같은 주차면의 같은 관측 시각은 한 번만 기록)
+func insertOccupancySamples(ctx context.Context, db *gorm.DB, samples []mysql.OccupancySamples) error {
+	if len(samples) == 0 {
+		return nil
+	}
+	result := db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&samples)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+// findOccupancySamples from 이상 to 미만 원본 점 (관측 시각 순, 최대 limit개)
+func findOccupancySamples(ctx context.Context, db *gorm.DB, filter entity.OccupancyHistoryFilter, limit int) ([]mysql.OccupancySamples, error) {
+	var samples []mysql.OccupancySamples
+	result := db.WithContext(ctx).Scopes(occupancyHistoryScope(filter)).
+		Where("observed_at >= ? AND observed_at < ?", filter.From, filter.To).
+		Order("observed_at ASC, cctv_id ASC, parking_id ASC").
+		Limit(limit).
+		Find(&samples)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return samples, nil
+}
+
+// findOccupancySampleBuckets from 이상 to 미만 원본 점을 주차면별 bucket 구간으로 묶은 합계
+func findOccupancySampleBuckets(ctx context.Context, db *gorm.DB, filter entity.OccupancyHistoryFilter, bucket time.Duration) ([]mysql.OccupancyHourlySamples, error) {
+	seconds := int64(bucket / time.Second)
+	var buckets []mysql.OccupancyHourlySamples
+	result := db.WithContext(ctx).Model(&mysql.OccupancySamples{}).Scopes(occupancyHistoryScope(filter)).
+		Select("project_id, cctv_id, parking_id, FROM_UNIXTIME(FLOOR(UNIX_TIMESTAMP(observed_at) / ?) * ?) AS bucket_start, COUNT(*) AS samples, SUM(occupied) AS occupied_samples, SUM(rate) AS rate_sum", seconds, seconds).
+		Where("observed_at >= ? AND observed_at < ?", filter.From, filter.To).
+		Group("project_id, cctv_id, parking_id, bucket_start").
+		Order("bucket_start ASC").
+		Scan(&buckets)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return buckets, nil
+}
+
+// findOccupancyHourlySamples from 이상 to 미만 시간 단위 이력 (구간 시작 기준)
+func findOccupancyHourlySamples(ctx context.Context, db *gorm.DB, filter entity.OccupancyHistoryFilter) ([]mysql.OccupancyHourlySamples, error) {
+	var buckets []mysql.OccupancyHourlySamples
+	result := db.WithContext(ctx).Scopes(occupancyHistoryScope(filter)).
+		Where("bucket_start >= ? AND bucket_start < ?", filter.From, filter.To).
+		Order("bucket_start ASC").
+		Find(&buckets)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return buckets, nil
+}
+
+// findOccupancySamplesAt 주차면마다 at 이전(포함) 마지막 원본 점
+func findOccupancySamplesAt(ctx context.Context, db *gorm.DB, filter entity.OccupancyHistoryFilter, at time.Time) ([]mysql.OccupancySamples, error) {
+	latest := db.Model(&mysql.OccupancySamples{}).Scopes(occupancyHistoryScope(filter)).
+		Select("cctv_id, parking_id, MAX(observed_at) AS observed_at").
+		Where("observed_at <= ?", at).
+		Group("cctv_id, parking_id")
+
+	var samples []mysql.OccupancySamples
+	result := db.WithContext(ctx).Table("occupancy_samples AS s").
+		Select("s.*").
+		Joins("JOIN (?) AS l ON s.cctv_id = l.cctv_id AND s.parking_id = l.parking_id AND s.observed_at = l.observed_at", latest).
+		Where("s.project_id = ?", filter.ProjectID).
+		Order("s.cctv_id ASC, s.parking_id ASC").
+		Find(&samples)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return samples, nil
+}
+
+// findOccupancyHourlySamplesAt 주차면마다 at 이전(포함)에 시작한 마지막 시간 단위 이력
+func findOccupancyHourlySamplesAt(ctx context.Context, db *gorm.DB, filter entity.OccupancyHistoryFilter, at time.Time) ([]mysql.OccupancyHourlySamples, error) {
+	latest := db.Model(&mysql.OccupancyHourlySamples{}).Scopes(occupancyHistoryScope(filter)).
+		Select("cctv_id, parking_id, MAX(bucket_start) AS bucket_start").
+		Where("bucket_start <= ?", at).
+		Group("cctv_id, parking_id")
+
+	var buckets []mysql.OccupancyHourlySamples
+	result := db.WithContext(ctx).Table("occupancy_hourly_samples AS h").
+		Select("h.*").
+		Joins("JOIN (?) AS l ON h.cctv_id = l.cctv_id AND h.parking_id = l.parking_id AND h.bucket_start = l.bucket_start", latest).
+		Where("h.project_id = ?", filter.ProjectID).
+		Order("h.cctv_id ASC, h.parking_id ASC").
+		Find(&buckets)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return buckets, nil
+}
+
+// downsampleOccupancySamples before 이전 원본 점을 시간 단위 이력에 더하고 삭제 (모든 프로젝트, 한 트랜잭션)
+func downsampleOccupancySamples(ctx context.Context, db *gorm.DB, before time.Time) (int64, error) {
+	var downsampled int64
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(`INSERT INTO occupancy_hourly_samples (project_id, cctv_id, parking_id, bucket_start, samples, occupied_samples, rate_sum)
+			SELECT project_id, cctv_id, parking_id, DATE_FORMAT(observed_at, '%Y-%m-%d %H:00:00') AS bucket_start, COUNT(*), SUM(occupied), SUM(rate)
+			FROM occupancy_samples
+			WHERE observed_at < ?
+			GROUP BY project_id, cctv_id, parking_id, bucket_start
+			ON DUPLICATE KEY UPDATE
+				samples = samples + VALUES(samples),
+				occupied_samples = occupied_samples + VALUES(occupied_samples),
+				rate_sum = rate_sum + VALUES(rate_sum)`, before).Error
+		if err != nil {
+			return err
+		}
+		result := tx.Where("observed_at < ?", before).Delete(&mysql.OccupancySamples{})
+		if result.Error != nil {
+			return result.Error
+		}
+		downsampled = result.RowsAffected
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return downsampled, nil
+}
+
+// deleteOccupancyHourlySamples before 이전에 시작한 시간 단위 이력 삭제 (모든 프로젝트)
+func deleteOccupancyHourlySamples(ctx context.Context, db *gorm.DB, before time.Time) (int64, error) {
+	result := db.WithContext(ctx).Where("bucket_start < ?", before).Delete(&mysql.OccupancyHourlySamples{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// findLatestOccupancyHourlySample 프로젝트에서 가장 늦게 시작한 시간 단위 이력 (원본 점은 이 구간 다음부터 남아 있음, 없으면 false)
+func findLatestOccupancyHourlySample(ctx context.Context, db *gorm.DB, projectID string) (mysql.OccupancyHourlySamples, bool, error) {
+	var buckets []mysql.OccupancyHourlySamples
+	result := db.WithContext(ctx).Where("project_id = ?", projectID).Order("bucket_start DESC").Limit(1).Find(&buckets)
+	if result.Error != nil {
+		return mysql.OccupancyHourlySamples{}, false, result.Error
+	}
+	if len(buckets) == 0 {
+		return mysql.OccupancyHourlySamples{}, false, nil
+	}
+	return buckets[0], true, nil
+}
+
+// findOccupancyTransitions from 이상 to 미만 원본 점 중 주차면마다 첫 점과 점유 여부가 바뀐 점 (주차면, 관측 시각 순)
+func findOccupancyTransitions(ctx context.Context, db *gorm.DB, filter entity.OccupancyHistoryFilter) ([]mysql.OccupancySamples, error) {
+	ordered := db.Model(&mysql.OccupancySamples{}).Scopes(occupancyHistoryScope(filter)).
+		Select("cctv_id, parking_id, occupied, observed_at, LAG(occupied) OVER (PARTITION BY cctv_id, parking_id ORDER BY observed_at) AS previous").
+		Where("observed_at >= ? AND observed_at < ?", filter.From, filter.To)
+
+	var samples []mysql.OccupancySamples
+	result := db.WithContext(ctx).Table("(?) AS t", ordered).
+		Select("cctv_id, parking_id, occupied, observed_at").
+		Where("previous IS NULL OR previous <> occupied").
+		Order("cctv_id ASC, parking_id ASC, observed_at ASC").
+		Find(&samples)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return samples, nil
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	"main/features/parking/model/entity"
+	_interface "main/features/parking/model/interface"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func NewOccupancySamplesParkingRepository(gormDB *gorm.DB) _interface.IOccupancySamplesParkingRepository {
+	return &OccupancySamplesParkingRepository{ZoneGetParkingRepository{GormDB: gormDB}}
+}
+
+func (r *OccupancySamplesParkingRepository) FindOccupancySamples(ctx context.Context, filter entity.OccupancyHistoryFilter, limit int) ([]mysql.OccupancySamples, error) {
+	return findOccupancySamples(ctx, r.GormDB, filter, limit)
+}
+
+func (r *OccupancySamplesParkingRepository) DownsampleOccupancySamples(ctx context.Context, before time.Time) (int64, error) {
+	return downsampleOccupancySamples(ctx, r.GormDB, before)
+}
+
+func (r *OccupancySamplesParkingRepository) DeleteOccupancyHourlySamples(ctx context.Context, before time.Time) (int64, error) {
+	return deleteOccupancyHourlySamples(ctx, r.GormDB, before)
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	"main/features/parking/model/entity"
+	_interface "main/features/parking/model/interface"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func NewOccupancyStateAtParkingRepository(gormDB *gorm.DB) _interface.IOccupancyStateAtParkingRepository {
+	return &OccupancyStateAtParkingRepository{ZoneGetParkingRepository{GormDB: gormDB}}
+}
+
+func (r *OccupancyStateAtParkingRepository) FindOccupancySamplesAt(ctx context.Context, filter entity.OccupancyHistoryFilter, at time.Time) ([]mysql.OccupancySamples, error) {
+	return findOccupancySamplesAt(ctx, r.GormDB, filter, at)
+}
+
+func (r *OccupancyStateAtParkingRepository) FindOccupancyHourlySamplesAt(ctx context.Context, filter entity.OccupancyHistoryFilter, at time.Time) ([]mysql.OccupancyHourlySamples, error) {
+	return findOccupancyHourlySamplesAt(ctx, r.GormDB, filter, at)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+
+	"gorm.io/gorm"
+)
+
+// findOccupancyThresholds 프로젝트의 점유 판정 기준 전체 조회 (결과/이력/실시간/평가 저장소에서 공용)
+func findOccupancyThresholds(ctx context.Context, db *gorm.DB, projectID string) ([]mysql.OccupancyThresholds, error) {
+	var thresholds []mysql.OccupancyThresholds
+	result := db.WithContext(ctx).Where("project_id = ?", projectID).Order("scope ASC, cctv_id ASC, roi_id ASC").Find(&thresholds)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return thresholds, nil
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+
+	"gorm.io/gorm"
+)
+
+// findParkingSpaceCategories 프로젝트의 주차면 종류 전체 조회
+func findParkingSpaceCategories(ctx context.Context, db *gorm.DB, projectID string) ([]mysql.ParkingSpaceCategories, error) {
+	var categories []mysql.ParkingSpaceCategories
+	result := db.WithContext(ctx).Where("project_id = ?", projectID).Order("cctv_id ASC, parking_id ASC").Find(&categories)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return categories, nil
+}
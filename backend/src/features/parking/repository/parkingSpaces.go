@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	"main/common/spaces"
+	"sort"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// findParkingSpaces 프로젝트의 parking_id ↔ ROI 번호 대응 전체 조회 (결과/정답/구역 저장소에서 공용)
+func findParkingSpaces(ctx context.Context, db *gorm.DB, projectID string) ([]mysql.ParkingSpaces, error) {
+	var rows []mysql.ParkingSpaces
+	result := db.WithContext(ctx).Where("project_id = ?", projectID).Order("cctv_id ASC, roi_id ASC").Find(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return rows, nil
+}
+
+// ensureParkingSpaces 대응이 없는 parking_id(CCTV ID -> parking_id 목록)에 번호를 정해 저장하고 전체 대응 반환
+// 동시에 학습을 시작해도 같은 번호를 두 번 쓰지 않도록 프로젝트 행을 잠그고 번호를 정함
+func ensureParkingSpaces(ctx context.Context, db *gorm.DB, projectID string, parkingIDs map[string][]string) ([]mysql.ParkingSpaces, error) {
+	cctvIDs := make([]string, 0, len(parkingIDs))
+	for cctvID := range parkingIDs {
+		cctvIDs = append(cctvIDs, cctvID)
+	}
+	sort.Strings(cctvIDs)
+
+	var rows []mysql.ParkingSpaces
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var project mysql.Projects
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", projectID).First(&project).Error; err != nil {
+			return err
+		}
+		existing, err := findParkingSpaces(ctx, tx, projectID)
+		if err != nil {
+			return err
+		}
+
+		index := spaces.NewIndex(existing)
+		var created []mysql.ParkingSpaces
+		for _, cctvID := range cctvIDs {
+			for _, parkingID := range parkingIDs[cctvID] {
+				if row, ok := index.Allocate(projectID, cctvID, parkingID); ok {
+					created = append(created, row)
+				}
+			}
+		}
+		if len(created) > 0 {
+			if err := tx.Create(&created).Error; err != nil {
+				return err
+			}
+		}
+		rows = append(existing, created...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
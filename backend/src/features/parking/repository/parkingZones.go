@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	"main/common/zones"
+	"sort"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// findParkingZones 프로젝트의 구역 전체 조회 (구역/집계/규칙 적용 저장소에서 공용)
+func findParkingZones(ctx context.Context, db *gorm.DB, projectID string) ([]mysql.ParkingZones, error) {
+	var parkingZones []mysql.ParkingZones
+	result := db.WithContext(ctx).Where("project_id = ?", projectID).Order("lot ASC, floor ASC, zone ASC").Find(&parkingZones)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return parkingZones, nil
+}
+
+// findParkingZoneSpaces 프로젝트의 구역별 주차면 전체 조회
+func findParkingZoneSpaces(ctx context.Context, db *gorm.DB, projectID string) ([]mysql.ParkingZoneSpaces, error) {
+	var spaces []mysql.ParkingZoneSpaces
+	result := db.WithContext(ctx).Where("project_id = ?", projectID).Order("cctv_id ASC, parking_id ASC").Find(&spaces)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return spaces, nil
+}
+
+// findParkingZoneRules 프로젝트의 이름 규칙 (적용 순서대로)
+func findParkingZoneRules(ctx context.Context, db *gorm.DB, projectID string) ([]mysql.ParkingZoneRules, error) {
+	var rules []mysql.ParkingZoneRules
+	result := db.WithContext(ctx).Where("project_id = ?", projectID).Order("priority ASC, id ASC").Find(&rules)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return rules, nil
+}
+
+// replaceParkingZones 구역을 assignments로 교체 (같은 lot/floor/zone 구역은 ID를 유지하고, 없어진 구역만 삭제)
+// 구역 ID는 웹훅 구독, 점유 이력/분석 조회에서 쓰이므로 다시 만들지 않음. 구역별 주차면은 모두 지우고 다시 저장
+// 없어질 구역에 웹훅 구독이 걸려 있으면 아무것도 바꾸지 않고 *zones.InUseError 반환 (FK cascade로 구독이 조용히 지워지지 않도록)
+func replaceParkingZones(ctx context.Context, db *gorm.DB, projectID string, assignments map[zones.Key][]zones.Space) error {
+	keys := make([]zones.Key, 0, len(assignments))
+	for key := range assignments {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := findParkingZones(ctx, tx, projectID)
+		if err != nil {
+			return err
+		}
+		zoneIDs := make(map[zones.Key]uint, len(existing))
+		removedKeys := make(map[uint]zones.Key)
+		var removed []uint
+		for _, zone := range existing {
+			key := zones.Key{Lot: zone.Lot, Floor: zone.Floor, Zone: zone.Zone}
+			if _, ok := assignments[key]; ok {
+				zoneIDs[key] = zone.ID
+			} else {
+				removed = append(removed, zone.ID)
+				removedKeys[zone.ID] = key
+			}
+		}
+
+		if len(removed) > 0 {
+			// 잠금 읽기로 방금 만들어진 구독까지 확인
+			var subscriptions []mysql.WebhookSubscriptions
+			if err := tx.Clauses(clause.Locking{Strength: "SHARE"}).Where("zone_id IN ?", removed).Order("id ASC").Find(&subscriptions).Error; err != nil {
+				return err
+			}
+			if len(subscriptions) > 0 {
+				return zoneInUseError(subscriptions, removedKeys)
+			}
+		}
+
+		if err := tx.Where("project_id = ?", projectID).Delete(&mysql.ParkingZoneSpaces{}).Error; err != nil {
+			return err
+		}
+		// 위에서 구독이 없는 것을 확인했으므로 webhook_subscriptions.zone_id cascade로 지워지는 구독은 없음
+		if len(removed) > 0 {
+			if err := tx.Where("id IN ?", removed).Delete(&mysql.ParkingZones{}).Error; err != nil {
+				return err
+			}
+		}
+		for _, key := range keys {
+			zoneID, ok := zoneIDs[key]
+			if !ok {
+				zone := mysql.ParkingZones{ProjectId: projectID, Lot: key.Lot, Floor: key.Floor, Zone: key.Zone}
+				if err := tx.Create(&zone).Error; err != nil {
+					return err
+				}
+				zoneID = zone.ID
+			}
+			spaces := make([]mysql.ParkingZoneSpaces, 0, len(assignments[key]))
+			for _, space := range assignments[key] {
+				spaces = append(spaces, mysql.ParkingZoneSpaces{ProjectId: projectID, ZoneId: zoneID, CctvId: space.CctvID, ParkingId: space.ParkingID})
+			}
+			if len(spaces) == 0 {
+				continue
+			}
+			if err := tx.Create(&spaces).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// zoneInUseError 구독이 걸린 구역과 구독 ID로 에러 생성 (구역은 이름 순)
+func zoneInUseError(subscriptions []mysql.WebhookSubscriptions, removedKeys map[uint]zones.Key) *zones.InUseError {
+	inUse := &zones.InUseError{}
+	seen := make(map[uint]bool)
+	for _, subscription := range subscriptions {
+		inUse.SubscriptionIDs = append(inUse.SubscriptionIDs, subscription.ID)
+		if subscription.ZoneId == nil || seen[*subscription.ZoneId] {
+			continue
+		}
+		seen[*subscription.ZoneId] = true
+		inUse.Keys = append(inUse.Keys, removedKeys[*subscription.ZoneId])
+	}
+	sort.Slice(inUse.Keys, func(i, j int) bool { return inUse.Keys[i].String() < inUse.Keys[j].String() })
+	return inUse
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"reflect"
+	"testing"
+
+	"main/common/db/mysql"
+	"main/common/zones"
+)
+
+func TestZoneInUseError(t *testing.T) {
+	zoneA, zoneB := uint(1), uint(2)
+	removed := map[uint]zones.Key{
+		zoneA: {Lot: "P2", Floor: "B1", Zone: "A"},
+		zoneB: {Lot: "P1", Floor: "B3", Zone: "1"},
+	}
+	subscriptions := []mysql.WebhookSubscriptions{
+		{ID: 3, ZoneId: &zoneA},
+		{ID: 5, ZoneId: &zoneB},
+		{ID: 8, ZoneId: &zoneA},
+	}
+
+	err := zoneInUseError(subscriptions, removed)
+	if want := []uint{3, 5, 8}; !reflect.DeepEqual(err.SubscriptionIDs, want) {
+		t.Errorf("SubscriptionIDs = %v, want %v", err.SubscriptionIDs, want)
+	}
+	// 구역은 한 번씩, 이름 순
+	if want := []zones.Key{removed[zoneB], removed[zoneA]}; !reflect.DeepEqual(err.Keys, want) {
+		t.Errorf("Keys = %v, want %v", err.Keys, want)
+	}
+	if want := "웹훅 구독(id 3, 5, 8)이 걸린 구역은 삭제할 수 없습니다: P1 / B3 / 1, P2 / B1 / A"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
@@ -53,3 +53,96 @@ type LiveLearningParkingRepository struct {
 type CctvImageParkingRepository struct {
 	GormDB *gorm.DB
 }
+
+type JobEventsParkingRepository struct {
+	GormDB *gorm.DB
+}
+
+type CreateSweepParkingRepository struct {
+	LearningParkingRepository
+}
+
+type GetSweepParkingRepository struct {
+	GormDB *gorm.DB
+}
+
+type EvaluationParkingRepository struct {
+	GormDB *gorm.DB
+}
+
+type CurvesParkingRepository struct {
+	EvaluationParkingRepository
+}
+
+type ThresholdGetParkingRepository struct {
+	GormDB *gorm.DB
+}
+
+type ThresholdSaveParkingRepository struct {
+	GormDB *gorm.DB
+}
+
+type ThresholdDeleteParkingRepository struct {
+	GormDB *gorm.DB
+}
+
+type AlignmentParkingRepository struct {
+	GormDB *gorm.DB
+}
+
+type ZoneGetParkingRepository struct {
+	GormDB *gorm.DB
+}
+
+type ZoneSaveParkingRepository struct {
+	ZoneGetParkingRepository
+}
+
+type ZoneRulesGetParkingRepository struct {
+	GormDB *gorm.DB
+}
+
+type ZoneRulesSaveParkingRepository struct {
+	ZoneRulesGetParkingRepository
+}
+
+type CategoryGetParkingRepository struct {
+	GormDB *gorm.DB
+}
+
+type CategorySaveParkingRepository struct {
+	CategoryGetParkingRepository
+}
+
+type ZoneDeriveParkingRepository struct {
+	ZoneSaveParkingRepository
+}
+
+type ZoneOccupancyParkingRepository struct {
+	EvaluationParkingRepository
+	ZoneGetParkingRepository
+}
+
+type LiveStateParkingRepository struct {
+	GormDB *gorm.DB
+}
+
+type OccupancySamplesParkingRepository struct {
+	ZoneGetParkingRepository
+}
+
+type OccupancyBucketsParkingRepository struct {
+	ZoneGetParkingRepository
+}
+
+type OccupancyStateAtParkingRepository struct {
+	ZoneGetParkingRepository
+}
+
+type LiveEventsParkingRepository struct {
+	LiveStateParkingRepository
+}
+
+type AnalyticsParkingRepository struct {
+	OccupancyBucketsParkingRepository
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// findSpaceStates 프로젝트의 주차면별 최신 실시간 상태 (cctvID가 있으면 해당 CCTV만)
+func findSpaceStates(ctx context.Context, db *gorm.DB, projectID string, cctvID string) ([]mysql.SpaceState, error) {
+	var states []mysql.SpaceState
+	query := db.WithContext(ctx).Where("project_id = ?", projectID)
+	if cctvID != "" {
+		query = query.Where("cctv_id = ?", cctvID)
+	}
+	result := query.Order("cctv_id ASC, roi_id ASC").Find(&states)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return states, nil
+}
+
+// replaceSpaceStates 같은 주차면(project/cctv/parking_id)의 상태가 있으면 최신 값으로 갱신하고
+// 이번 실행의 ROI(CCTV ID -> parking_id 목록)에 없는 주차면의 상태는 같은 트랜잭션에서 삭제
+func replaceSpaceStates(ctx context.Context, db *gorm.DB, projectID string, states []mysql.SpaceState, parkingIDs map[string][]string) error {
+	current := make(map[string]map[string]bool, len(parkingIDs))
+	for cctvID, ids := range parkingIDs {
+		current[cctvID] = make(map[string]bool, len(ids))
+		for _, parkingID := range ids {
+			current[cctvID][parkingID] = true
+		}
+	}
+
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := findSpaceStates(ctx, tx, projectID, "")
+		if err != nil {
+			return err
+		}
+		var staleIDs []uint
+		for _, state := range existing {
+			if !current[state.CctvId][state.ParkingId] {
+				staleIDs = append(staleIDs, state.ID)
+			}
+		}
+		if len(staleIDs) > 0 {
+			if err := tx.Where("id IN ?", staleIDs).Delete(&mysql.SpaceState{}).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(states) == 0 {
+			return nil
+		}
+		return tx.Clauses(clause.OnConflict{
+			DoUpdates: clause.AssignmentColumns([]string{"roi_id", "occupied", "rate", "threshold", "source_image", "observed_at", "updated_at"}),
+		}).Create(&states).Error
+	})
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/parking/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewThresholdDeleteParkingRepository(gormDB *gorm.DB) _interface.IThresholdDeleteParkingRepository {
+	return &ThresholdDeleteParkingRepository{GormDB: gormDB}
+}
+
+func (r *ThresholdDeleteParkingRepository) FindOccupancyThresholds(ctx context.Context, projectID string) ([]mysql.OccupancyThresholds, error) {
+	return findOccupancyThresholds(ctx, r.GormDB, projectID)
+}
+
+func (r *ThresholdDeleteParkingRepository) DeleteOccupancyThreshold(ctx context.Context, projectID string, scope string, cctvID string, roiID int) (int64, error) {
+	result := r.GormDB.WithContext(ctx).
+		Where("project_id = ? AND scope = ? AND cctv_id = ? AND roi_id = ?", projectID, scope, cctvID, roiID).
+		Delete(&mysql.OccupancyThresholds{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+func (r *ThresholdDeleteParkingRepository) FindParkingSpaces(ctx context.Context, projectID string) ([]mysql.ParkingSpaces, error) {
+	return findParkingSpaces(ctx, r.GormDB, projectID)
+}
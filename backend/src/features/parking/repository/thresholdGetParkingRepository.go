@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/parking/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewThresholdGetParkingRepository(gormDB *gorm.DB) _interface.IThresholdGetParkingRepository {
+	return &ThresholdGetParkingRepository{GormDB: gormDB}
+}
+
+func (r *ThresholdGetParkingRepository) FindOccupancyThresholds(ctx context.Context, projectID string) ([]mysql.OccupancyThresholds, error) {
+	return findOccupancyThresholds(ctx, r.GormDB, projectID)
+}
+
+func (r *ThresholdGetParkingRepository) FindParkingSpaces(ctx context.Context, projectID string) ([]mysql.ParkingSpaces, error) {
+	return findParkingSpaces(ctx, r.GormDB, projectID)
+}
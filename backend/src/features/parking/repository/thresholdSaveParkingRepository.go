@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/parking/model/interface"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func NewThresholdSaveParkingRepository(gormDB *gorm.DB) _interface.IThresholdSaveParkingRepository {
+	return &ThresholdSaveParkingRepository{GormDB: gormDB}
+}
+
+func (r *ThresholdSaveParkingRepository) FindOccupancyThresholds(ctx context.Context, projectID string) ([]mysql.OccupancyThresholds, error) {
+	return findOccupancyThresholds(ctx, r.GormDB, projectID)
+}
+
+// UpsertOccupancyThreshold 같은 범위(project/scope/cctv/roi)의 기준이 있으면 값만 갱신
+func (r *ThresholdSaveParkingRepository) UpsertOccupancyThreshold(ctx context.Context, threshold mysql.OccupancyThresholds) error {
+	result := r.GormDB.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{"threshold", "updated_at"}),
+	}).Create(&threshold)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+func (r *ThresholdSaveParkingRepository) FindParkingSpaces(ctx context.Context, projectID string) ([]mysql.ParkingSpaces, error) {
+	return findParkingSpaces(ctx, r.GormDB, projectID)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/parking/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewZoneDeriveParkingRepository(gormDB *gorm.DB) _interface.IZoneDeriveParkingRepository {
+	return &ZoneDeriveParkingRepository{ZoneSaveParkingRepository{ZoneGetParkingRepository{GormDB: gormDB}}}
+}
+
+func (r *ZoneDeriveParkingRepository) FindParkingZoneRules(ctx context.Context, projectID string) ([]mysql.ParkingZoneRules, error) {
+	return findParkingZoneRules(ctx, r.GormDB, projectID)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/parking/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewZoneGetParkingRepository(gormDB *gorm.DB) _interface.IZoneGetParkingRepository {
+	return &ZoneGetParkingRepository{GormDB: gormDB}
+}
+
+func (r *ZoneGetParkingRepository) FindParkingZones(ctx context.Context, projectID string) ([]mysql.ParkingZones, error) {
+	return findParkingZones(ctx, r.GormDB, projectID)
+}
+
+func (r *ZoneGetParkingRepository) FindParkingZoneSpaces(ctx context.Context, projectID string) ([]mysql.ParkingZoneSpaces, error) {
+	return findParkingZoneSpaces(ctx, r.GormDB, projectID)
+}
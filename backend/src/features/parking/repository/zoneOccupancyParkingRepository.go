@@ -0,0 +1,14 @@
+package repository
+
+import (
+	_interface "main/features/parking/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewZoneOccupancyParkingRepository(gormDB *gorm.DB) _interface.IZoneOccupancyParkingRepository {
+	return &ZoneOccupancyParkingRepository{
+		EvaluationParkingRepository: EvaluationParkingRepository{GormDB: gormDB},
+		ZoneGetParkingRepository:    ZoneGetParkingRepository{GormDB: gormDB},
+	}
+}
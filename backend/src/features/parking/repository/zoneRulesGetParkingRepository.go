@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/parking/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewZoneRulesGetParkingRepository(gormDB *gorm.DB) _interface.IZoneRulesGetParkingRepository {
+	return &ZoneRulesGetParkingRepository{GormDB: gormDB}
+}
+
+func (r *ZoneRulesGetParkingRepository) FindParkingZoneRules(ctx context.Context, projectID string) ([]mysql.ParkingZoneRules, error) {
+	return findParkingZoneRules(ctx, r.GormDB, projectID)
+}
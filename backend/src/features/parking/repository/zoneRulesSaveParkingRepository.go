@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/parking/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewZoneRulesSaveParkingRepository(gormDB *gorm.DB) _interface.IZoneRulesSaveParkingRepository {
+	return &ZoneRulesSaveParkingRepository{ZoneRulesGetParkingRepository{GormDB: gormDB}}
+}
+
+// ReplaceParkingZoneRules 기존 규칙을 지우고 rules로 교체
+func (r *ZoneRulesSaveParkingRepository) ReplaceParkingZoneRules(ctx context.Context, projectID string, rules []mysql.ParkingZoneRules) error {
+	return r.GormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("project_id = ?", projectID).Delete(&mysql.ParkingZoneRules{}).Error; err != nil {
+			return err
+		}
+		if len(rules) == 0 {
+			return nil
+		}
+		return tx.Create(&rules).Error
+	})
+}
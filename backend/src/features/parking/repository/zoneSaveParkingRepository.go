@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"main/common/zones"
+	_interface "main/features/parking/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewZoneSaveParkingRepository(gormDB *gorm.DB) _interface.IZoneSaveParkingRepository {
+	return &ZoneSaveParkingRepository{ZoneGetParkingRepository{GormDB: gormDB}}
+}
+
+func (r *ZoneSaveParkingRepository) ReplaceParkingZones(ctx context.Context, projectID string, assignments map[zones.Key][]zones.Space) error {
+	return replaceParkingZones(ctx, r.GormDB, projectID, assignments)
+}
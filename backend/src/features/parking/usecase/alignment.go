@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"fmt"
+	"io/fs"
+	"main/common"
+	"main/common/camshift"
+	"main/features/parking/model/response"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// latestCctvImages 폴더 아래 CCTV별 가장 최근 이미지 ({cctvId}.jpg 또는 {cctvId}_Current.jpg)
+func latestCctvImages(root string) (map[string]string, error) {
+	type candidate struct {
+		path    string
+		modTime time.Time
+	}
+	latest := map[string]candidate{}
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if ext != ".jpg" && ext != ".jpeg" && ext != ".png" {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil
+		}
+		cctvID := strings.TrimSuffix(strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name())), "_Current")
+		if prev, ok := latest[cctvID]; !ok || info.ModTime().After(prev.modTime) {
+			latest[cctvID] = candidate{path: path, modTime: info.ModTime()}
+		}
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	images := make(map[string]string, len(latest))
+	for cctvID, c := range latest {
+		images[cctvID] = c.path
+	}
+	return images, nil
+}
+
+// checkCameraAlignment 폴더의 CCTV별 최신 이미지를 기준 프레임과 비교 (CCTV ID 순)
+func checkCameraAlignment(ws common.Workspace, root string, tolerance float64) ([]response.CameraAlignment, error) {
+	images, err := latestCctvImages(root)
+	if err != nil {
+		return nil, fmt.Errorf("이미지 폴더 읽기 실패: %v", err)
+	}
+	cctvIDs := make([]string, 0, len(images))
+	for cctvID := range images {
+		cctvIDs = append(cctvIDs, cctvID)
+	}
+	sort.Strings(cctvIDs)
+
+	cameras := make([]response.CameraAlignment, 0, len(cctvIDs))
+	for _, cctvID := range cctvIDs {
+		camera := response.CameraAlignment{CctvID: cctvID, Image: filepath.Base(images[cctvID])}
+		result, err := camshift.Check(ws, cctvID, images[cctvID], tolerance)
+		if err != nil {
+			// 한 카메라 이미지가 깨져 있어도 나머지는 검사
+			camera.Status = string(camshift.StatusUncertain)
+			camera.Message = err.Error()
+			cameras = append(cameras, camera)
+			continue
+		}
+
+		camera.Status = string(result.Status)
+		camera.Message = result.Message
+		camera.Dx = result.Shift.Dx
+		camera.Dy = result.Shift.Dy
+		camera.Distance = result.Shift.Distance()
+		camera.Confidence = result.Shift.Confidence
+		if result.Reference != nil {
+			camera.ReferenceRoiFile = result.Reference.RoiFile
+			camera.ReferenceSavedAt = &result.Reference.SavedAt
+		}
+		if result.Status == camshift.StatusMisaligned {
+			camera.Misaligned = true
+			// ROI 좌표가 정수 픽셀이므로 제안 이동량도 정수로
+			camera.SuggestedTranslate = &response.AlignmentTranslate{Dx: math.Round(result.Shift.Dx), Dy: math.Round(result.Shift.Dy)}
+		}
+		cameras = append(cameras, camera)
+	}
+	return cameras, nil
+}
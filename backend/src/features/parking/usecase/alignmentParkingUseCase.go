@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/response"
+)
+
+type AlignmentParkingUseCase struct {
+	Repository     _interface.IAlignmentParkingRepository
+	ContextTimeout time.Duration
+}
+
+func NewAlignmentParkingUseCase(repo _interface.IAlignmentParkingRepository, timeout time.Duration) _interface.IAlignmentParkingUseCase {
+	return &AlignmentParkingUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// GetAlignment source(current: currentImages, test: uploads/testImages/{folder}) 이미지를 기준 프레임과 비교
+func (d *AlignmentParkingUseCase) GetAlignment(c context.Context, projectID string, source string, folder string, tolerance float64) (response.ResAlignment, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	ws, err := common.ResolveWorkspace(ctx, projectID)
+	if err != nil {
+		return response.ResAlignment{}, err
+	}
+
+	var root string
+	switch source {
+	case "current":
+		root, err = ws.Resolve("currentImages")
+	case "test":
+		if folder != "" {
+			if err := common.ValidatePathSegment(folder); err != nil {
+				return response.ResAlignment{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+			}
+			root, err = ws.Resolve("uploads", "testImages", folder)
+		} else {
+			root, err = ws.Resolve("uploads", "testImages")
+		}
+	default:
+		return response.ResAlignment{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), fmt.Sprintf("source는 current 또는 test여야 합니다: %s", source), common.ErrFromClient)
+	}
+	if err != nil {
+		return response.ResAlignment{}, err
+	}
+
+	cameras, err := checkCameraAlignment(ws, root, tolerance)
+	if err != nil {
+		return response.ResAlignment{}, common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), err.Error(), common.ErrFromInternal)
+	}
+
+	misaligned := 0
+	for _, camera := range cameras {
+		if camera.Misaligned {
+			misaligned++
+		}
+	}
+	return response.ResAlignment{
+		Source:     source,
+		Tolerance:  tolerance,
+		Total:      len(cameras),
+		Misaligned: misaligned,
+		Cameras:    cameras,
+	}, nil
+}
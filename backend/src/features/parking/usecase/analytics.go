@@ -0,0 +1,315 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"main/common/db/mysql"
+	"main/common/zones"
+	"main/features/parking/model/response"
+)
+
+const (
+	analyticsDateLayout = "2006-01-02"
+	// 기간을 생략하면 오늘까지 7일
+	defaultAnalyticsDays = 7
+	maxAnalyticsDays     = 92
+)
+
+// parseAnalyticsRange from/to 날짜 검증 (서버 시간대 자정 기준, end는 to 다음 날 자정)
+func parseAnalyticsRange(from string, to string, now time.Time) (time.Time, time.Time, error) {
+	local := now.In(time.Local)
+	last := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.Local)
+	if to != "" {
+		t, err := time.ParseInLocation(analyticsDateLayout, to, time.Local)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("to는 YYYY-MM-DD 형식이어야 합니다: %s", to)
+		}
+		last = t
+	}
+	first := last.AddDate(0, 0, -(defaultAnalyticsDays - 1))
+	if from != "" {
+		t, err := time.ParseInLocation(analyticsDateLayout, from, time.Local)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("from은 YYYY-MM-DD 형식이어야 합니다: %s", from)
+		}
+		first = t
+	}
+	if last.Before(first) {
+		return time.Time{}, time.Time{}, fmt.Errorf("from은 to보다 늦을 수 없습니다")
+	}
+	if len(analyticsDates(first, last.AddDate(0, 0, 1))) > maxAnalyticsDays {
+		return time.Time{}, time.Time{}, fmt.Errorf("기간은 %d일 이하여야 합니다", maxAnalyticsDays)
+	}
+	return first, last.AddDate(0, 0, 1), nil
+}
+
+// analyticsDates start(자정)부터 end 전날까지 날짜 목록
+func analyticsDates(start time.Time, end time.Time) []string {
+	var dates []string
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		dates = append(dates, day.Format(analyticsDateLayout))
+	}
+	return dates
+}
+
+// analyticsRawStart 원본 점이 하루 전체 남아 있는 첫 날 자정 (start보다 이르면 start)
+// 시간 단위로 줄인 마지막 구간 다음 시각부터 원본 점이 있으므로, 그 시각이 자정이 아니면 다음 날부터
+func analyticsRawStart(start time.Time, latestHourly time.Time, downsampled bool) time.Time {
+	if !downsampled {
+		return start
+	}
+	rawFrom := latestHourly.Add(time.Hour).In(time.Local)
+	day := time.Date(rawFrom.Year(), rawFrom.Month(), rawFrom.Day(), 0, 0, 0, 0, time.Local)
+	if day.Before(rawFrom) {
+		day = day.AddDate(0, 0, 1)
+	}
+	if day.Before(start) {
+		return start
+	}
+	return day
+}
+
+// occupiedInterval 주차면 하나의 점유 구간 (시작/끝이 기간 밖이면 해당 시각을 모름)
+type occupiedInterval struct {
+	space      zones.Space
+	start      time.Time
+	end        time.Time
+	knownStart bool
+	knownEnd   bool
+}
+
+// occupiedIntervals 주차면별 첫 점과 점유 여부가 바뀐 점(주차면, 시각 순)으로 점유 구간 생성
+// 첫 점이 점유면 그 전부터 점유였을 수 있어 시작을 모르고, 마지막까지 점유면 끝을 모름
+func occupiedIntervals(transitions []mysql.OccupancySamples) []occupiedInterval {
+	var intervals []occupiedInterval
+	var open *occupiedInterval
+	var current zones.Space
+	for i, row := range transitions {
+		space := zones.Space{CctvID: row.CctvId, ParkingID: row.ParkingId}
+		first := i == 0 || space != current
+		if first {
+			if open != nil {
+				intervals = append(intervals, *open)
+				open = nil
+			}
+			current = space
+		}
+		if row.Occupied {
+			if open == nil {
+				open = &occupiedInterval{space: space, start: row.ObservedAt, knownStart: !first}
+			}
+			continue
+		}
+		if open != nil {
+			open.end = row.ObservedAt
+			open.knownEnd = true
+			intervals = append(intervals, *open)
+			open = nil
+		}
+	}
+	if open != nil {
+		intervals = append(intervals, *open)
+	}
+	return intervals
+}
+
+// analyticsDwell 시작과 끝을 모두 아는 구간의 평균/중앙 체류 시간
+func analyticsDwell(intervals []occupiedInterval) response.AnalyticsDwell {
+	var minutes []float64
+	for _, interval := range intervals {
+		if interval.knownStart && interval.knownEnd {
+			minutes = append(minutes, interval.end.Sub(interval.start).Minutes())
+		}
+	}
+	dwell := response.AnalyticsDwell{Intervals: len(minutes)}
+	if len(minutes) == 0 {
+		return dwell
+	}
+	sort.Float64s(minutes)
+	sum := 0.0
+	for _, value := range minutes {
+		sum += value
+	}
+	dwell.AvgMinutes = sum / float64(len(minutes))
+	if n := len(minutes); n%2 == 1 {
+		dwell.MedianMinutes = minutes[n/2]
+	} else {
+		dwell.MedianMinutes = (minutes[n/2-1] + minutes[n/2]) / 2
+	}
+	return dwell
+}
+
+func analyticsRate(occupied int, samples int) float64 {
+	if samples == 0 {
+		return 0
+	}
+	return float64(occupied) * 100 / float64(samples)
+}
+
+// buildParkingAnalytics 구간 합계(원본 점 5분 단위 + 시간 단위 이력)와 점유 구간으로 분석 결과 생성
+// 점유 구간은 rawStart 이후 원본 점으로 만든 것이므로 회전율은 rawStart 이후 날짜만 계산
+func buildParkingAnalytics(result *response.ResParkingAnalytics, buckets []mysql.OccupancyHourlySamples, intervals []occupiedInterval, start time.Time, end time.Time, rawStart time.Time) {
+	hours := make([]response.AnalyticsHourOccupancy, 24)
+	for hour := range hours {
+		hours[hour].Hour = hour
+	}
+	weekdays := make([]response.AnalyticsWeekdayOccupancy, 7)
+	for day := range weekdays {
+		weekdays[day].Weekday = time.Weekday(day).String()
+	}
+
+	spaces := make(map[zones.Space]bool)
+	occupied := 0
+	for _, row := range buckets {
+		local := row.BucketStart.In(time.Local)
+		hours[local.Hour()].Samples += row.Samples
+		hours[local.Hour()].OccupiedSamples += row.OccupiedSamples
+		weekdays[local.Weekday()].Samples += row.Samples
+		weekdays[local.Weekday()].OccupiedSamples += row.OccupiedSamples
+		spaces[zones.Space{CctvID: row.CctvId, ParkingID: row.ParkingId}] = true
+		result.Samples += row.Samples
+		occupied += row.OccupiedSamples
+	}
+	for i := range hours {
+		hours[i].OccupancyRate = analyticsRate(hours[i].OccupiedSamples, hours[i].Samples)
+	}
+	for i := range weekdays {
+		weekdays[i].OccupancyRate = analyticsRate(weekdays[i].OccupiedSamples, weekdays[i].Samples)
+	}
+	result.HourOfDay = hours
+	result.DayOfWeek = weekdays
+	result.Spaces = len(spaces)
+	result.OccupancyRate = analyticsRate(occupied, result.Samples)
+
+	for _, bucket := range buildOccupancyBuckets(buckets, "5m") {
+		if result.Peak == nil || bucket.OccupancyRate > result.Peak.OccupancyRate {
+			result.Peak = &response.AnalyticsPeak{BucketStart: bucket.BucketStart, OccupancyRate: bucket.OccupancyRate, Spaces: bucket.Spaces}
+		}
+	}
+
+	// 입차는 빈 자리에서 점유로 바뀐 시점 (기간 시작부터 점유였던 구간은 제외)
+	dates := analyticsDates(start, end)
+	arrivals := make(map[string]int, len(dates))
+	bySpace := make(map[zones.Space][]occupiedInterval)
+	for _, interval := range intervals {
+		bySpace[interval.space] = append(bySpace[interval.space], interval)
+		spaces[interval.space] = true
+		if interval.knownStart {
+			arrivals[interval.start.In(time.Local).Format(analyticsDateLayout)]++
+		}
+	}
+	result.Spaces = len(spaces)
+	result.Dwell = analyticsDwell(intervals)
+
+	rawDates := analyticsDates(rawStart, end)
+	if len(rawDates) > 0 {
+		result.RawFrom = &rawDates[0]
+	}
+	result.Turnover = make([]response.AnalyticsDailyTurnover, 0, len(dates))
+	for i, date := range dates {
+		turnover := response.AnalyticsDailyTurnover{Date: date, Spaces: result.Spaces}
+		if i >= len(dates)-len(rawDates) {
+			count := arrivals[date]
+			turnover.Arrivals = &count
+			if turnover.Spaces > 0 {
+				perSpace := float64(count) / float64(turnover.Spaces)
+				turnover.TurnoverPerSpace = &perSpace
+			}
+		}
+		result.Turnover = append(result.Turnover, turnover)
+	}
+
+	result.SpaceStats = make([]response.AnalyticsSpace, 0, len(spaces))
+	for space := range spaces {
+		stat := response.AnalyticsSpace{CctvID: space.CctvID, ParkingID: space.ParkingID, AnalyticsDwell: analyticsDwell(bySpace[space])}
+		for _, interval := range bySpace[space] {
+			if interval.knownStart {
+				stat.Arrivals++
+			}
+		}
+		if len(rawDates) > 0 {
+			perDay := float64(stat.Arrivals) / float64(len(rawDates))
+			stat.TurnoverPerDay = &perDay
+		}
+		result.SpaceStats = append(result.SpaceStats, stat)
+	}
+	sort.Slice(result.SpaceStats, func(i, j int) bool {
+		if result.SpaceStats[i].CctvID != result.SpaceStats[j].CctvID {
+			return result.SpaceStats[i].CctvID < result.SpaceStats[j].CctvID
+		}
+		return result.SpaceStats[i].ParkingID < result.SpaceStats[j].ParkingID
+	})
+}
+
+// ParkingAnalyticsCSV 분석 결과를 CSV로 (표마다 머리글 행이 있고 빈 행으로 구분)
+func ParkingAnalyticsCSV(result response.ResParkingAnalytics) ([]byte, error) {
+	float := func(value float64) string { return strconv.FormatFloat(value, 'f', 2, 64) }
+	// 원본 점이 없어 계산하지 않은 값은 빈 칸
+	optionalInt := func(value *int) string {
+		if value == nil {
+			return ""
+		}
+		return strconv.Itoa(*value)
+	}
+	optionalFloat := func(value *float64) string {
+		if value == nil {
+			return ""
+		}
+		return float(*value)
+	}
+	rawFrom := ""
+	if result.RawFrom != nil {
+		rawFrom = *result.RawFrom
+	}
+	peakStart, peakRate, peakSpaces := "", "", ""
+	if result.Peak != nil {
+		peakStart, peakRate, peakSpaces = result.Peak.BucketStart, float(result.Peak.OccupancyRate), strconv.Itoa(result.Peak.Spaces)
+	}
+
+	tables := [][][]string{
+		{
+			{"project_id", "zone_id", "cctv_id", "from", "to", "timezone", "raw_from", "spaces", "samples", "occupancy_rate", "peak_bucket_start", "peak_occupancy_rate", "peak_spaces", "dwell_intervals", "avg_dwell_minutes", "median_dwell_minutes"},
+			{result.ProjectID, strconv.FormatUint(uint64(result.ZoneID), 10), result.CctvID, result.From, result.To, result.Timezone, rawFrom, strconv.Itoa(result.Spaces), strconv.Itoa(result.Samples), float(result.OccupancyRate), peakStart, peakRate, peakSpaces, strconv.Itoa(result.Dwell.Intervals), float(result.Dwell.AvgMinutes), float(result.Dwell.MedianMinutes)},
+		},
+		{{"hour", "samples", "occupied_samples", "occupancy_rate"}},
+		{{"weekday", "samples", "occupied_samples", "occupancy_rate"}},
+		{{"date", "arrivals", "spaces", "turnover_per_space"}},
+		{{"cctv_id", "parking_id", "arrivals", "turnover_per_day", "dwell_intervals", "avg_dwell_minutes", "median_dwell_minutes"}},
+	}
+	for _, row := range result.HourOfDay {
+		tables[1] = append(tables[1], []string{strconv.Itoa(row.Hour), strconv.Itoa(row.Samples), strconv.Itoa(row.OccupiedSamples), float(row.OccupancyRate)})
+	}
+	for _, row := range result.DayOfWeek {
+		tables[2] = append(tables[2], []string{row.Weekday, strconv.Itoa(row.Samples), strconv.Itoa(row.OccupiedSamples), float(row.OccupancyRate)})
+	}
+	for _, row := range result.Turnover {
+		tables[3] = append(tables[3], []string{row.Date, optionalInt(row.Arrivals), strconv.Itoa(row.Spaces), optionalFloat(row.TurnoverPerSpace)})
+	}
+	for _, row := range result.SpaceStats {
+		tables[4] = append(tables[4], []string{row.CctvID, row.ParkingID, strconv.Itoa(row.Arrivals), optionalFloat(row.TurnoverPerDay), strconv.Itoa(row.Intervals), float(row.AvgMinutes), float(row.MedianMinutes)})
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	for i, table := range tables {
+		if i > 0 {
+			// csv.Writer는 빈 레코드를 빈 줄로 씀
+			if err := writer.Write(nil); err != nil {
+				return nil, err
+			}
+		}
+		if err := writer.WriteAll(table); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -30,8 +30,15 @@ func (d *BatchImagesParkingUseCase) BatchImages(ctx context.Context, projectID s
 	// 서버 목록
 	servers := []string{"172.23.30.84", "172.23.229.77"}
 
-	// 로컬 저장 경로 설정
-	localBasePath := filepath.Join(common.Env.UploadPath, projectID, "currentImages")
+	// 로컬 저장 경로 설정 (작업 폴더 기준)
+	ws, err := common.ResolveWorkspace(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	localBasePath, err := ws.Resolve("currentImages")
+	if err != nil {
+		return err
+	}
 	if err := os.MkdirAll(localBasePath, 0755); err != nil {
 		return fmt.Errorf("로컬 디렉토리 생성 실패: %v", err)
 	}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"main/common/db/mysql"
+	"main/common/zones"
+	"main/features/parking/model/request"
+	"main/features/parking/model/response"
+)
+
+// buildCategoriesResponse 저장된 주차면 종류를 응답으로 (categories는 general과 사용 중인 종류, 이름 순)
+func buildCategoriesResponse(projectID string, rows []mysql.ParkingSpaceCategories) response.ResCategories {
+	result := response.ResCategories{ProjectID: projectID, Categories: []string{zones.DefaultCategory}, Spaces: []response.SpaceCategory{}}
+	seen := map[string]bool{zones.DefaultCategory: true}
+	for _, row := range rows {
+		result.Spaces = append(result.Spaces, response.SpaceCategory{CctvID: row.CctvId, ParkingID: row.ParkingId, Category: row.Category})
+		if !seen[row.Category] {
+			seen[row.Category] = true
+			result.Categories = append(result.Categories, row.Category)
+		}
+	}
+	sort.Strings(result.Categories)
+	return result
+}
+
+// categoryRows 요청의 주차면별 종류를 저장 형식으로 (같은 주차면이 두 번 나오면 오류)
+func categoryRows(projectID string, items []request.SpaceCategory) ([]mysql.ParkingSpaceCategories, error) {
+	rows := make([]mysql.ParkingSpaceCategories, 0, len(items))
+	seen := make(map[zones.Space]bool)
+	for _, item := range items {
+		space := zones.Space{CctvID: item.CctvID, ParkingID: item.ParkingID}
+		category := strings.TrimSpace(item.Category)
+		if err := zones.ValidateSpace(space); err != nil {
+			return nil, err
+		}
+		if err := zones.ValidateCategory(category); err != nil {
+			return nil, err
+		}
+		if seen[space] {
+			return nil, fmt.Errorf("주차면 %s/%s의 종류가 두 번 지정되었습니다", space.CctvID, space.ParkingID)
+		}
+		seen[space] = true
+		rows = append(rows, mysql.ParkingSpaceCategories{ProjectId: projectID, CctvId: space.CctvID, ParkingId: space.ParkingID, Category: category})
+	}
+	return rows, nil
+}
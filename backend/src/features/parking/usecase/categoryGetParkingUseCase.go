@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/response"
+)
+
+type CategoryGetParkingUseCase struct {
+	Repository     _interface.ICategoryGetParkingRepository
+	ContextTimeout time.Duration
+}
+
+func NewCategoryGetParkingUseCase(repo _interface.ICategoryGetParkingRepository, timeout time.Duration) _interface.ICategoryGetParkingUseCase {
+	return &CategoryGetParkingUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// GetCategories 주차면 종류 조회
+func (d *CategoryGetParkingUseCase) GetCategories(c context.Context, projectID string) (response.ResCategories, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	rows, err := d.Repository.FindParkingSpaceCategories(ctx, projectID)
+	if err != nil {
+		return response.ResCategories{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("주차면 종류 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	return buildCategoriesResponse(projectID, rows), nil
+}
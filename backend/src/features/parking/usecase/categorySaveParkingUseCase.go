@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/request"
+	"main/features/parking/model/response"
+)
+
+type CategorySaveParkingUseCase struct {
+	Repository     _interface.ICategorySaveParkingRepository
+	ContextTimeout time.Duration
+}
+
+func NewCategorySaveParkingUseCase(repo _interface.ICategorySaveParkingRepository, timeout time.Duration) _interface.ICategorySaveParkingUseCase {
+	return &CategorySaveParkingUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// SaveCategories 주차면 종류 전체 교체 (구역과 따로 저장되어 구역을 다시 만들어도 유지됨)
+func (d *CategorySaveParkingUseCase) SaveCategories(c context.Context, projectID string, req request.ReqSaveCategories) (response.ResCategories, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	rows, err := categoryRows(projectID, req.Spaces)
+	if err != nil {
+		return response.ResCategories{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+	if err := d.Repository.ReplaceParkingSpaceCategories(ctx, projectID, rows); err != nil {
+		return response.ResCategories{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("주차면 종류 저장 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	saved, err := d.Repository.FindParkingSpaceCategories(ctx, projectID)
+	if err != nil {
+		return response.ResCategories{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("주차면 종류 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	return buildCategoriesResponse(projectID, saved), nil
+}
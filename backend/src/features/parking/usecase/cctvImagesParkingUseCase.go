@@ -2,11 +2,11 @@ package usecase
 
 import (
 	"context"
-	"fmt"
 	"os"
 	"path/filepath"
 	"time"
 
+	"main/common"
 	_interface "main/features/parking/model/interface"
 	"main/features/parking/model/response"
 )
@@ -24,21 +24,15 @@ func (d *CctvImageParkingUseCase) GetCctvImage(ctx context.Context, projectID st
 	_, cancel := context.WithTimeout(ctx, d.ContextTimeout)
 	defer cancel()
 
-	// 현재 작업 디렉토리 확인
-	currentDir, err := os.Getwd()
+	// CCTV 폴더 경로 구성 (작업 폴더 기준)
+	ws, err := common.ResolveWorkspace(ctx, projectID)
 	if err != nil {
-		return response.ResCctvImage{
-			Success: false,
-			Message: "Failed to get current directory: " + err.Error(),
-		}, nil
+		return response.ResCctvImage{}, err
+	}
+	cctvPath, err := ws.Resolve("liveResults", cctvID)
+	if err != nil {
+		return response.ResCctvImage{}, err
 	}
-
-	// CCTV 폴더 경로 구성 (절대 경로 사용)
-	cctvPath := filepath.Join(currentDir, "..", "..", "shared", projectID, "liveResults", cctvID)
-
-	// 디버깅을 위한 로그 출력
-	fmt.Printf("Current directory: %s\n", currentDir)
-	fmt.Printf("CCTV path: %s\n", cctvPath)
 
 	// 폴더가 존재하는지 확인
 	if _, err := os.Stat(cctvPath); os.IsNotExist(err) {
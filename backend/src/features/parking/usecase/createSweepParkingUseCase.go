@@ -0,0 +1,223 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"main/common"
+	"main/common/db/mysql"
+	"main/common/jobqueue"
+	"main/common/occupancy"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/request"
+	"main/features/parking/model/response"
+
+	"github.com/labstack/echo/v4"
+)
+
+// 스윕 하나에서 실행할 수 있는 최대 조합 수
+const maxSweepRuns = 50
+
+type CreateSweepParkingUseCase struct {
+	Repository     _interface.ICreateSweepParkingRepository
+	ContextTimeout time.Duration
+}
+
+func NewCreateSweepParkingUseCase(repo _interface.ICreateSweepParkingRepository, timeout time.Duration) _interface.ICreateSweepParkingUseCase {
+	return &CreateSweepParkingUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// CreateSweep 모든 파라미터 조합을 학습 작업으로 등록하고 스윕으로 묶음
+func (d *CreateSweepParkingUseCase) CreateSweep(c context.Context, req request.ReqSweep) (response.ResCreateSweep, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	combinations, err := buildSweepCombinations(req)
+	if err != nil {
+		return response.ResCreateSweep{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+
+	// 경로는 모든 조합이 같으므로 한 번만 변환/검증
+	ws, fullPaths, backendDir, err := prepareLearningPaths(ctx, combinations[0])
+	if err != nil {
+		return response.ResCreateSweep{}, err
+	}
+
+	// 일부 조합만 등록되지 않도록 대기열 여유를 먼저 확인
+	if free := jobqueue.LearningQueue.Free(); free < len(combinations) {
+		return response.ResCreateSweep{}, common.ErrorMsg(ctx, common.ErrQueueFull, common.Trace(), fmt.Sprintf("작업 대기열 여유가 부족합니다 (필요 %d, 여유 %d)", len(combinations), free), common.ErrFromInternal)
+	}
+
+	sweepID, err := d.Repository.CreateSweep(ctx, mysql.LearningSweeps{
+		ProjectId:          fullPaths.ProjectID,
+		LearningPath:       fullPaths.LearningPath,
+		TestImagePath:      fullPaths.TestPath,
+		RoiPath:            fullPaths.RoiPath,
+		OccupancyThreshold: sweepOccupancyThreshold(req),
+		TotalRuns:          len(combinations),
+	})
+	if err != nil {
+		return response.ResCreateSweep{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("스윕 등록 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	// 조합마다 별도 학습 작업(= 별도 ExperimentSession)으로 실행
+	learning := &LearningParkingUseCase{Repository: d.Repository, ContextTimeout: d.ContextTimeout}
+	jobIDs := make([]uint, 0, len(combinations))
+	for _, combination := range combinations {
+		run := fullPaths
+		run.LearningRate = combination.LearningRate
+		run.Iterations = combination.Iterations
+		run.VarThreshold = combination.VarThreshold
+
+		jobID, err := learning.enqueueLearningJob(ctx, ws, run, backendDir, &sweepID)
+		if err != nil {
+			return d.partialSweep(ctx, sweepID, jobIDs, len(combinations), err)
+		}
+		jobIDs = append(jobIDs, jobID)
+	}
+
+	return response.ResCreateSweep{
+		SweepID:   sweepID,
+		JobIDs:    jobIDs,
+		Total:     len(jobIDs),
+		Requested: len(combinations),
+		Status:    mysql.LearningJobStatusQueued,
+	}, nil
+}
+
+// partialSweep 조합 등록 도중 실패한 경우 total_runs를 실제 등록된 작업 수로 맞춤
+// 이미 대기열에 넣은 작업이 있으면 스윕 ID와 작업 ID를 돌려줘 조회하거나 취소할 수 있게 함
+func (d *CreateSweepParkingUseCase) partialSweep(ctx context.Context, sweepID uint, jobIDs []uint, requested int, cause error) (response.ResCreateSweep, error) {
+	// 요청 시간이 끝나 실패했을 수도 있으므로 정리는 취소되지 않는 context로
+	cleanupCtx := context.WithoutCancel(ctx)
+	if _, err := d.Repository.UpdateSweepTotalRuns(cleanupCtx, sweepID); err != nil {
+		fmt.Printf("스윕 작업 수 갱신 실패 (%d): %v\n", sweepID, err)
+	}
+	if len(jobIDs) == 0 {
+		return response.ResCreateSweep{}, cause
+	}
+
+	// ErrorMsg 형식("type|trace|msg|from")이면 메시지만 응답에 넣음
+	message := cause.Error()
+	if strings.Count(message, "|") >= 3 {
+		message = common.ErrorParsing(message).Msg
+	}
+	return response.ResCreateSweep{
+		SweepID:   sweepID,
+		JobIDs:    jobIDs,
+		Total:     len(jobIDs),
+		Requested: requested,
+		Status:    mysql.LearningJobStatusQueued,
+		Error:     message,
+	}, nil
+}
+
+// ValidateSweepRequest 스윕 요청 검증 (조합 생성 및 조합별 파라미터 검증)
+func ValidateSweepRequest(req request.ReqSweep) error {
+	if _, err := buildSweepCombinations(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if req.OccupancyThreshold != nil && !isValidThreshold(*req.OccupancyThreshold) {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("OccupancyThreshold는 0보다 크고 1 이하여야 합니다. %f", *req.OccupancyThreshold))
+	}
+	return nil
+}
+
+func sweepOccupancyThreshold(req request.ReqSweep) float64 {
+	if req.OccupancyThreshold != nil {
+		return *req.OccupancyThreshold
+	}
+	return occupancy.DefaultThreshold
+}
+
+// buildSweepCombinations learningRate × iterations × varThreshold 모든 조합 생성
+func buildSweepCombinations(req request.ReqSweep) ([]request.ReqLearning, error) {
+	learningRates, err := expandParamRange("learningRate", req.LearningRate)
+	if err != nil {
+		return nil, err
+	}
+	iterations, err := expandParamRange("iterations", req.Iterations)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range iterations {
+		if value != math.Trunc(value) {
+			return nil, fmt.Errorf("iterations는 정수여야 합니다: %v", value)
+		}
+	}
+	varThresholds, err := expandParamRange("varThreshold", req.VarThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	total := len(learningRates) * len(iterations) * len(varThresholds)
+	if total > maxSweepRuns {
+		return nil, fmt.Errorf("조합 수가 너무 많습니다: %d (최대 %d)", total, maxSweepRuns)
+	}
+
+	combinations := make([]request.ReqLearning, 0, total)
+	for _, learningRate := range learningRates {
+		for _, iteration := range iterations {
+			for _, varThreshold := range varThresholds {
+				combination := request.ReqLearning{
+					ProjectID:    req.ProjectID,
+					LearningRate: learningRate,
+					Iterations:   int(iteration),
+					VarThreshold: varThreshold,
+					LearningPath: req.LearningPath,
+					TestPath:     req.TestPath,
+					RoiPath:      req.RoiPath,
+				}
+				if err := ValidateLearningRequest(combination); err != nil {
+					if httpErr, ok := err.(*echo.HTTPError); ok {
+						return nil, fmt.Errorf("%v", httpErr.Message)
+					}
+					return nil, err
+				}
+				combinations = append(combinations, combination)
+			}
+		}
+	}
+	return combinations, nil
+}
+
+// expandParamRange values 목록(중복 제거) 또는 min~max를 step 간격으로 나눈 값 목록
+func expandParamRange(name string, r request.ParamRange) ([]float64, error) {
+	var values []float64
+	if len(r.Values) > 0 {
+		seen := make(map[float64]bool)
+		for _, value := range r.Values {
+			if !seen[value] {
+				seen[value] = true
+				values = append(values, value)
+			}
+		}
+	} else {
+		if r.Min == nil || r.Max == nil || r.Step == nil {
+			return nil, fmt.Errorf("%s는 values 또는 min/max/step이 필요합니다", name)
+		}
+		from, to, step := *r.Min, *r.Max, *r.Step
+		if step <= 0 {
+			return nil, fmt.Errorf("%s의 step은 0보다 커야 합니다: %v", name, step)
+		}
+		if from > to {
+			return nil, fmt.Errorf("%s의 min이 max보다 큽니다: %v > %v", name, from, to)
+		}
+		// 부동소수점 오차로 max가 빠지지 않도록 여유를 두고 계산
+		count := int(math.Floor((to-from)/step+1e-9)) + 1
+		if count > maxSweepRuns {
+			return nil, fmt.Errorf("%s 값이 너무 많습니다: %d (최대 %d)", name, count, maxSweepRuns)
+		}
+		for i := 0; i < count; i++ {
+			values = append(values, math.Round((from+float64(i)*step)*1e9)/1e9)
+		}
+	}
+	if len(values) > maxSweepRuns {
+		return nil, fmt.Errorf("%s 값이 너무 많습니다: %d (최대 %d)", name, len(values), maxSweepRuns)
+	}
+	return values, nil
+}
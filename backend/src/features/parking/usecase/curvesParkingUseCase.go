@@ -0,0 +1,230 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/response"
+)
+
+// 곡선 임계값 간격 기본값 (0, 0.01, ..., 1)
+const defaultCurveStep = 0.01
+
+type CurvesParkingUseCase struct {
+	Repository     _interface.ICurvesParkingRepository
+	ContextTimeout time.Duration
+}
+
+func NewCurvesParkingUseCase(repo _interface.ICurvesParkingRepository, timeout time.Duration) _interface.ICurvesParkingUseCase {
+	return &CurvesParkingUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// curveSample 라벨이 있는 ROI 하나 (score: 전경 비율, positive: 차량 있음)
+type curveSample struct {
+	Score    float64
+	Positive bool
+}
+
+// GetCurves 임계값을 0부터 1까지 바꿔가며 전체/CCTV별 ROC/PR 곡선과 최적 임계값 계산
+func (d *CurvesParkingUseCase) GetCurves(c context.Context, projectID string, experimentID string, step float64) (response.ResCurves, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	scores, err := loadExperimentScores(ctx, d.Repository, projectID, experimentID)
+	if err != nil {
+		return response.ResCurves{}, err
+	}
+
+	thresholds := curveThresholds(step)
+	var all []curveSample
+	cctvs := make([]response.CctvCurveSet, 0, len(scores.CctvIDs))
+	for _, cctvID := range scores.CctvIDs {
+		truth, err := loadCctvLabels(scores.TestPath, cctvID, scores.Spaces)
+		if err != nil {
+			return response.ResCurves{}, common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), fmt.Sprintf("라벨 파일 읽기 실패 (%s): %v", cctvID, err), common.ErrFromInternal)
+		}
+
+		var samples []curveSample
+		for roiID, rate := range scores.Rates[cctvID] {
+			if hasVehicle, ok := truth[roiID]; ok {
+				samples = append(samples, curveSample{Score: rate, Positive: hasVehicle})
+			}
+		}
+		if len(samples) == 0 {
+			continue
+		}
+		all = append(all, samples...)
+		cctvs = append(cctvs, response.CctvCurveSet{CctvID: cctvID, CurveSet: buildCurveSet(samples, thresholds)})
+	}
+
+	return response.ResCurves{
+		ExperimentID: scores.Session.ID,
+		ProjectID:    scores.Session.ProjectId,
+		Name:         scores.Session.Name,
+		Step:         step,
+		Overall:      buildCurveSet(all, thresholds),
+		Cctvs:        cctvs,
+	}, nil
+}
+
+// ParseCurveStep 쿼리로 받은 임계값 간격 검증 (비어 있으면 기본값)
+func ParseCurveStep(value string) (float64, error) {
+	if value == "" {
+		return defaultCurveStep, nil
+	}
+	step, err := strconv.ParseFloat(value, 64)
+	if err != nil || step < 0.001 || step > 0.5 {
+		return 0, fmt.Errorf("step은 0.001 이상 0.5 이하여야 합니다: %s", value)
+	}
+	return step, nil
+}
+
+// curveThresholds 0부터 step 간격으로 1까지 (1은 항상 포함)
+func curveThresholds(step float64) []float64 {
+	count := int(math.Floor(1/step + 1e-9))
+	thresholds := make([]float64, 0, count+2)
+	for i := 0; i <= count; i++ {
+		thresholds = append(thresholds, math.Round(float64(i)*step*1e9)/1e9)
+	}
+	if thresholds[len(thresholds)-1] < 1 {
+		thresholds = append(thresholds, 1)
+	}
+	return thresholds
+}
+
+// buildCurveSet 임계값별 곡선 점, AUC, F1/Youden J 최대 임계값 계산
+func buildCurveSet(samples []curveSample, thresholds []float64) response.CurveSet {
+	set := response.CurveSet{Points: make([]response.CurvePoint, 0, len(thresholds))}
+	for _, sample := range samples {
+		if sample.Positive {
+			set.Positives++
+		} else {
+			set.Negatives++
+		}
+	}
+	if len(samples) == 0 {
+		return set
+	}
+
+	for _, threshold := range thresholds {
+		var matrix confusion
+		for _, sample := range samples {
+			matrix.add(sample.Score >= threshold, sample.Positive)
+		}
+		set.Points = append(set.Points, curvePoint(threshold, matrix))
+	}
+
+	// 같은 값이면 낮은 임계값 우선
+	for i := range set.Points {
+		point := set.Points[i]
+		if set.BestF1 == nil || point.F1 > set.BestF1.F1 {
+			set.BestF1 = &set.Points[i]
+		}
+		if set.Positives > 0 && set.Negatives > 0 && (set.BestYoudenJ == nil || point.YoudenJ > set.BestYoudenJ.YoudenJ) {
+			set.BestYoudenJ = &set.Points[i]
+		}
+	}
+	if set.Positives == 0 {
+		set.BestF1 = nil
+	}
+
+	if set.Positives > 0 && set.Negatives > 0 {
+		auc := rocAuc(samples)
+		set.RocAuc = &auc
+	}
+	if set.Positives > 0 {
+		ap := averagePrecision(samples)
+		set.PrAuc = &ap
+	}
+	return set
+}
+
+// curvePoint 예측한 차량 있음이 없으면 precision은 1로 둠 (PR 곡선 시작점)
+func curvePoint(threshold float64, m confusion) response.CurvePoint {
+	precision := 1.0
+	if m.TruePositive+m.FalsePositive > 0 {
+		precision = ratio(m.TruePositive, m.TruePositive+m.FalsePositive)
+	}
+	recall := ratio(m.TruePositive, m.TruePositive+m.FalseNegative)
+	fpr := ratio(m.FalsePositive, m.FalsePositive+m.TrueNegative)
+	f1 := 0.0
+	if m.TruePositive > 0 {
+		f1 = 2 * precision * recall / (precision + recall)
+	}
+	return response.CurvePoint{
+		Threshold:     threshold,
+		TruePositive:  m.TruePositive,
+		FalsePositive: m.FalsePositive,
+		TrueNegative:  m.TrueNegative,
+		FalseNegative: m.FalseNegative,
+		Tpr:           recall,
+		Fpr:           fpr,
+		Precision:     precision,
+		Recall:        recall,
+		F1:            f1,
+		YoudenJ:       recall - fpr,
+	}
+}
+
+// rocAuc 순위 합(Mann-Whitney U)으로 계산한 정확한 ROC AUC (같은 점수는 평균 순위)
+func rocAuc(samples []curveSample) float64 {
+	sorted := append([]curveSample(nil), samples...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Score < sorted[j].Score })
+
+	positives, negatives := 0, 0
+	rankSum := 0.0
+	for i := 0; i < len(sorted); {
+		j := i
+		for j < len(sorted) && sorted[j].Score == sorted[i].Score {
+			j++
+		}
+		// 순위는 1부터, i..j-1 구간의 평균 순위
+		averageRank := float64(i+j+1) / 2
+		for k := i; k < j; k++ {
+			if sorted[k].Positive {
+				positives++
+				rankSum += averageRank
+			} else {
+				negatives++
+			}
+		}
+		i = j
+	}
+	return (rankSum - float64(positives*(positives+1))/2) / float64(positives*negatives)
+}
+
+// averagePrecision 점수 높은 순으로 임계값을 내리며 구한 PR 곡선 아래 면적 (계단식)
+func averagePrecision(samples []curveSample) float64 {
+	sorted := append([]curveSample(nil), samples...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Score > sorted[j].Score })
+
+	positives := 0
+	for _, sample := range sorted {
+		if sample.Positive {
+			positives++
+		}
+	}
+
+	ap, truePositive, previousRecall := 0.0, 0, 0.0
+	for i := 0; i < len(sorted); {
+		j := i
+		for j < len(sorted) && sorted[j].Score == sorted[i].Score {
+			if sorted[j].Positive {
+				truePositive++
+			}
+			j++
+		}
+		recall := float64(truePositive) / float64(positives)
+		precision := float64(truePositive) / float64(j)
+		ap += (recall - previousRecall) * precision
+		previousRecall = recall
+		i = j
+	}
+	return ap
+}
@@ -8,7 +8,6 @@ import (
 	"main/features/parking/model/request"
 	"main/features/parking/model/response"
 	"os"
-	"path/filepath"
 )
 
 type DeleteFileParkingUseCase struct {
@@ -23,7 +22,7 @@ func NewDeleteFileParkingUseCase(repository _interface.IDeleteFileParkingReposit
 
 func (d *DeleteFileParkingUseCase) DeleteFile(ctx context.Context, projectID string, folderPath string, req request.ReqDeleteFile) (response.ResDeleteFile, error) {
 	// 파일 시스템에서 파일/폴더 삭제 실행
-	err := deleteFileFromFileSystem(projectID, folderPath, req.DeleteName)
+	err := deleteFileFromFileSystem(ctx, projectID, folderPath, req.DeleteName)
 	if err != nil {
 		return response.ResDeleteFile{
 			Success: false,
@@ -38,16 +37,21 @@ func (d *DeleteFileParkingUseCase) DeleteFile(ctx context.Context, projectID str
 }
 
 // 파일 시스템에서 파일/폴더 삭제
-func deleteFileFromFileSystem(projectID string, folderPath string, deleteName string) error {
-	// 저장 경로 설정 (기존 업로드 코드와 동일한 방식)
-	uploadPath := common.Env.UploadPath
-	projectPath := filepath.Join(uploadPath, projectID)
-	targetPath := filepath.Join(projectPath, "uploads", folderPath)
-
-	// 삭제할 폴더/파일 경로 구성
-	fullPath := filepath.Join(targetPath, deleteName)
+func deleteFileFromFileSystem(ctx context.Context, projectID string, folderPath string, deleteName string) error {
+	// 삭제 대상은 폴더/파일 이름 한 칸만 허용
+	if err := common.ValidatePathSegment(deleteName); err != nil {
+		return err
+	}
 
-	fmt.Println("fullPath", fullPath)
+	// 삭제할 폴더/파일 경로 구성 (작업 폴더 기준)
+	ws, err := common.ResolveWorkspace(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	fullPath, err := ws.Resolve("uploads", folderPath, deleteName)
+	if err != nil {
+		return err
+	}
 
 	// 경로가 존재하는지 확인
 	if _, err := os.Stat(fullPath); err != nil {
@@ -0,0 +1,239 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"main/common"
+	"main/common/db/mysql"
+	"main/common/spaces"
+	"main/features/parking/model/entity"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/response"
+
+	"gorm.io/gorm"
+)
+
+// 라벨 비교 결과 종류
+const (
+	outcomeTruePositive  = "tp"
+	outcomeFalsePositive = "fp"
+	outcomeTrueNegative  = "tn"
+	outcomeFalseNegative = "fn"
+	outcomeUnlabeled     = "unlabeled"
+)
+
+// confusion 차량 있음(positive) 기준 혼동 행렬
+type confusion struct {
+	TruePositive  int
+	FalsePositive int
+	TrueNegative  int
+	FalseNegative int
+	Unlabeled     int
+}
+
+// add 예측/정답 한 건을 더하고 결과 종류 반환
+func (m *confusion) add(predicted bool, hasVehicle bool) string {
+	switch {
+	case predicted && hasVehicle:
+		m.TruePositive++
+		return outcomeTruePositive
+	case predicted && !hasVehicle:
+		m.FalsePositive++
+		return outcomeFalsePositive
+	case !predicted && !hasVehicle:
+		m.TrueNegative++
+		return outcomeTrueNegative
+	default:
+		m.FalseNegative++
+		return outcomeFalseNegative
+	}
+}
+
+func (m *confusion) merge(other confusion) {
+	m.TruePositive += other.TruePositive
+	m.FalsePositive += other.FalsePositive
+	m.TrueNegative += other.TrueNegative
+	m.FalseNegative += other.FalseNegative
+	m.Unlabeled += other.Unlabeled
+}
+
+func (m confusion) labeled() int {
+	return m.TruePositive + m.FalsePositive + m.TrueNegative + m.FalseNegative
+}
+
+func (m confusion) correct() int {
+	return m.TruePositive + m.TrueNegative
+}
+
+func (m confusion) metrics() response.EvaluationMetrics {
+	precision := ratio(m.TruePositive, m.TruePositive+m.FalsePositive)
+	recall := ratio(m.TruePositive, m.TruePositive+m.FalseNegative)
+	f1 := 0.0
+	if precision+recall > 0 {
+		f1 = 2 * precision * recall / (precision + recall)
+	}
+	return response.EvaluationMetrics{
+		TruePositive:  m.TruePositive,
+		FalsePositive: m.FalsePositive,
+		TrueNegative:  m.TrueNegative,
+		FalseNegative: m.FalseNegative,
+		Labeled:       m.labeled(),
+		Unlabeled:     m.Unlabeled,
+		Accuracy:      ratio(m.correct(), m.labeled()),
+		Precision:     precision,
+		Recall:        recall,
+		F1:            f1,
+	}
+}
+
+func ratio(numerator int, denominator int) float64 {
+	if denominator == 0 {
+		return 0
+	}
+	return float64(numerator) / float64(denominator)
+}
+
+// groupRoiRates 세션 ID -> CCTV ID -> ROI 번호 -> 전경 비율
+func groupRoiRates(cctvResults []mysql.CctvResults, roiResults []mysql.RoiResults) map[int]map[string]map[int]float64 {
+	rates := make(map[int]map[string]map[int]float64)
+	cctvByResultID := make(map[int]mysql.CctvResults, len(cctvResults))
+	for _, cctvResult := range cctvResults {
+		cctvByResultID[int(cctvResult.ID)] = cctvResult
+		if rates[cctvResult.ExperimentSessionId] == nil {
+			rates[cctvResult.ExperimentSessionId] = make(map[string]map[int]float64)
+		}
+		rates[cctvResult.ExperimentSessionId][cctvResult.CctvId] = make(map[int]float64)
+	}
+	for _, roiResult := range roiResults {
+		cctvResult, ok := cctvByResultID[roiResult.CctvResultId]
+		if !ok {
+			continue
+		}
+		rates[cctvResult.ExperimentSessionId][cctvResult.CctvId][roiResult.RoiId] = roiResult.Rate
+	}
+	return rates
+}
+
+// cctvResultIDs CCTV 결과 ID 목록 (ROI 결과 조회용)
+func cctvResultIDs(cctvResults []mysql.CctvResults) []int {
+	ids := make([]int, 0, len(cctvResults))
+	for _, cctvResult := range cctvResults {
+		ids = append(ids, int(cctvResult.ID))
+	}
+	return ids
+}
+
+// loadCctvLabels 테스트 폴더의 {cctvID}_labels.json을 읽어 ROI 번호별 정답(차량 유무)으로 변환
+// 라벨의 roi_id(parking_id 문자열)는 주차면 번호 대응으로 ROI 번호를 찾음, 라벨 파일이 없으면 빈 맵 반환
+func loadCctvLabels(testPath string, cctvID string, index spaces.Index) (map[int]bool, error) {
+	data, err := os.ReadFile(filepath.Join(testPath, "testImages", cctvID+"_labels.json"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return map[int]bool{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var labels []entity.LabelData
+	if err := json.Unmarshal(data, &labels); err != nil {
+		return nil, err
+	}
+
+	truth := make(map[int]bool, len(labels))
+	for _, label := range labels {
+		if roiID, ok := index.RoiID(cctvID, label.RoiId); ok {
+			truth[roiID] = label.HasVehicle
+		}
+	}
+	return truth, nil
+}
+
+// resolveSessionTestPath 세션에 저장된 테스트 폴더를 현재 작업 폴더 기준으로 다시 해석
+// (저장된 전체 경로는 폴더명만 사용하므로 작업 폴더 밖을 가리키지 않음)
+func resolveSessionTestPath(ws common.Workspace, testImagePath string) (string, error) {
+	folder := filepath.Base(testImagePath)
+	if err := common.ValidatePathSegment(folder); err != nil {
+		return "", err
+	}
+	return ws.Resolve("uploads", "testImages", folder)
+}
+
+// ParseOccupancyThreshold 쿼리로 받은 점유 판정 기준 검증 (비어 있으면 nil: 저장된 기준 사용)
+func ParseOccupancyThreshold(value string) (*float64, error) {
+	if value == "" {
+		return nil, nil
+	}
+	threshold, err := strconv.ParseFloat(value, 64)
+	if err != nil || !isValidThreshold(threshold) {
+		return nil, fmt.Errorf("threshold는 0보다 크고 1 이하여야 합니다: %s", value)
+	}
+	return &threshold, nil
+}
+
+func isValidThreshold(threshold float64) bool {
+	return threshold > 0 && threshold <= 1
+}
+
+// experimentScores 실험 하나의 CCTV별 ROI 전경 비율과 라벨 파일 위치, 주차면 번호 대응
+type experimentScores struct {
+	Session  mysql.ExperimentSessions
+	TestPath string
+	CctvIDs  []string
+	Rates    map[string]map[int]float64
+	Spaces   spaces.Index
+}
+
+// loadExperimentScores 프로젝트에 속한 실험과 ROI 결과 조회 (다른 프로젝트의 실험은 NotFound)
+func loadExperimentScores(ctx context.Context, repo _interface.IEvaluationParkingRepository, projectID string, experimentID string) (experimentScores, error) {
+	id, err := strconv.ParseUint(experimentID, 10, 32)
+	if err != nil || id == 0 {
+		return experimentScores{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), fmt.Sprintf("잘못된 실험 ID입니다: %s", experimentID), common.ErrFromClient)
+	}
+
+	session, err := repo.FindExperimentSessionByID(ctx, uint(id))
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && session.ProjectId != projectID) {
+		return experimentScores{}, common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), fmt.Sprintf("실험을 찾을 수 없습니다: %s", experimentID), common.ErrFromClient)
+	}
+	if err != nil {
+		return experimentScores{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("실험 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	ws, err := common.ResolveWorkspace(ctx, projectID)
+	if err != nil {
+		return experimentScores{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+	testPath, err := resolveSessionTestPath(ws, session.TestImagePath)
+	if err != nil {
+		return experimentScores{}, common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), fmt.Sprintf("테스트 폴더 경로 변환 실패: %v", err), common.ErrFromInternal)
+	}
+
+	cctvResults, err := repo.FindCctvResultsBySessionID(ctx, int(session.ID))
+	if err != nil {
+		return experimentScores{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("CCTV 결과 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	roiResults, err := repo.FindRoiResultsByCctvResultIDs(ctx, cctvResultIDs(cctvResults))
+	if err != nil {
+		return experimentScores{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("ROI 결과 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	rates := groupRoiRates(cctvResults, roiResults)[int(session.ID)]
+	index, err := loadParkingSpaces(ctx, repo.FindParkingSpaces, projectID)
+	if err != nil {
+		return experimentScores{}, err
+	}
+
+	cctvIDs := make([]string, 0, len(rates))
+	for cctvID := range rates {
+		cctvIDs = append(cctvIDs, cctvID)
+	}
+	sort.Strings(cctvIDs)
+
+	return experimentScores{Session: session, TestPath: testPath, CctvIDs: cctvIDs, Rates: rates, Spaces: index}, nil
+}
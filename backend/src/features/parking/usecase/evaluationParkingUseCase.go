@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"main/common"
+	"main/common/occupancy"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/response"
+)
+
+type EvaluationParkingUseCase struct {
+	Repository     _interface.IEvaluationParkingRepository
+	ContextTimeout time.Duration
+}
+
+func NewEvaluationParkingUseCase(repo _interface.IEvaluationParkingRepository, timeout time.Duration) _interface.IEvaluationParkingUseCase {
+	return &EvaluationParkingUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// GetEvaluation 실험 결과(ROI 전경 비율)를 저장된 정답 라벨과 비교해 혼동 행렬/지표 계산
+func (d *EvaluationParkingUseCase) GetEvaluation(c context.Context, projectID string, experimentID string, threshold *float64) (response.ResEvaluation, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	scores, err := loadExperimentScores(ctx, d.Repository, projectID, experimentID)
+	if err != nil {
+		return response.ResEvaluation{}, err
+	}
+	session, cctvRates := scores.Session, scores.Rates
+
+	// threshold를 지정하지 않으면 저장된 기준을 ROI마다 적용
+	thresholds, err := d.occupancyThresholds(ctx, projectID, threshold)
+	if err != nil {
+		return response.ResEvaluation{}, err
+	}
+
+	var summary confusion
+	cctvs := make([]response.CctvEvaluation, 0, len(scores.CctvIDs))
+	mismatches := []response.EvaluationMismatch{}
+	for _, cctvID := range scores.CctvIDs {
+		truth, err := loadCctvLabels(scores.TestPath, cctvID, scores.Spaces)
+		if err != nil {
+			return response.ResEvaluation{}, common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), fmt.Sprintf("라벨 파일 읽기 실패 (%s): %v", cctvID, err), common.ErrFromInternal)
+		}
+
+		roiIDs := make([]int, 0, len(cctvRates[cctvID]))
+		for roiID := range cctvRates[cctvID] {
+			roiIDs = append(roiIDs, roiID)
+		}
+		sort.Ints(roiIDs)
+
+		var matrix confusion
+		rois := make([]response.RoiEvaluation, 0, len(roiIDs))
+		for _, roiID := range roiIDs {
+			rate := cctvRates[cctvID][roiID]
+			occupied, applied := thresholds.Occupied(cctvID, roiID, rate)
+			parkingID := scores.Spaces.ParkingID(cctvID, roiID)
+			roi := response.RoiEvaluation{RoiID: roiID, ParkingID: parkingID, Rate: rate, Threshold: applied, Predicted: occupied}
+
+			hasVehicle, ok := truth[roiID]
+			if !ok {
+				matrix.Unlabeled++
+				roi.Outcome = outcomeUnlabeled
+				rois = append(rois, roi)
+				continue
+			}
+			roi.HasVehicle = &hasVehicle
+			roi.Outcome = matrix.add(roi.Predicted, hasVehicle)
+			rois = append(rois, roi)
+
+			if roi.Predicted != hasVehicle {
+				mismatches = append(mismatches, response.EvaluationMismatch{
+					CctvID:     cctvID,
+					RoiID:      roiID,
+					ParkingID:  parkingID,
+					Rate:       rate,
+					Threshold:  applied,
+					HasVehicle: hasVehicle,
+					Predicted:  roi.Predicted,
+					Outcome:    roi.Outcome,
+				})
+			}
+		}
+		summary.merge(matrix)
+
+		cctvs = append(cctvs, response.CctvEvaluation{
+			CctvID:  cctvID,
+			Metrics: matrix.metrics(),
+			Rois:    rois,
+		})
+	}
+
+	return response.ResEvaluation{
+		ExperimentID: session.ID,
+		ProjectID:    session.ProjectId,
+		Name:         session.Name,
+		Threshold:    threshold,
+		Summary:      summary.metrics(),
+		Cctvs:        cctvs,
+		Mismatches:   mismatches,
+	}, nil
+}
+
+// occupancyThresholds 지정한 threshold가 있으면 모든 ROI에 같은 기준, 없으면 프로젝트에 저장된 기준
+func (d *EvaluationParkingUseCase) occupancyThresholds(ctx context.Context, projectID string, threshold *float64) (occupancy.Thresholds, error) {
+	if threshold != nil {
+		return occupancy.Thresholds{Project: *threshold}, nil
+	}
+	return loadOccupancyThresholds(ctx, d.Repository.FindOccupancyThresholds, projectID)
+}
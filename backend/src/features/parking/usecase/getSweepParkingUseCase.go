@@ -0,0 +1,255 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"main/common"
+	"main/common/db/mysql"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/response"
+
+	"gorm.io/gorm"
+)
+
+type GetSweepParkingUseCase struct {
+	Repository     _interface.IGetSweepParkingRepository
+	ContextTimeout time.Duration
+}
+
+func NewGetSweepParkingUseCase(repo _interface.IGetSweepParkingRepository, timeout time.Duration) _interface.IGetSweepParkingUseCase {
+	return &GetSweepParkingUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// GetSweep 스윕 진행 상황과 조합별 정확도 순위 조회 (라벨은 조회 시점의 _labels.json 기준)
+func (d *GetSweepParkingUseCase) GetSweep(c context.Context, projectID string, sweepID string) (response.ResSweep, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	id, err := strconv.ParseUint(sweepID, 10, 32)
+	if err != nil || id == 0 {
+		return response.ResSweep{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), fmt.Sprintf("잘못된 스윕 ID입니다: %s", sweepID), common.ErrFromClient)
+	}
+
+	// 다른 프로젝트의 스윕은 없는 것으로 처리
+	sweep, err := d.Repository.FindSweepByID(ctx, uint(id))
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && sweep.ProjectId != projectID) {
+		return response.ResSweep{}, common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), fmt.Sprintf("스윕을 찾을 수 없습니다: %s", sweepID), common.ErrFromClient)
+	}
+	if err != nil {
+		return response.ResSweep{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("스윕 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	jobs, err := d.Repository.FindLearningJobsBySweepID(ctx, sweep.ID)
+	if err != nil {
+		return response.ResSweep{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("스윕 작업 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	// 성공한 작업의 CCTV별 ROI 결과
+	rates, err := d.findSessionRates(ctx, jobs)
+	if err != nil {
+		return response.ResSweep{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("학습 결과 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	index, err := loadParkingSpaces(ctx, d.Repository.FindParkingSpaces, projectID)
+	if err != nil {
+		return response.ResSweep{}, err
+	}
+
+	// CCTV별 정답 라벨 (조합 간 공유)
+	labels := make(map[string]map[int]bool)
+	cctvLabels := func(cctvID string) (map[int]bool, error) {
+		if truth, ok := labels[cctvID]; ok {
+			return truth, nil
+		}
+		truth, err := loadCctvLabels(sweep.TestImagePath, cctvID, index)
+		if err != nil {
+			return nil, err
+		}
+		labels[cctvID] = truth
+		return truth, nil
+	}
+
+	runs := make([]response.SweepRun, 0, len(jobs))
+	finished := 0
+	for _, job := range jobs {
+		if isFinishedLearningJob(job.Status) {
+			finished++
+		}
+		run := response.SweepRun{
+			JobID:               job.ID,
+			Status:              job.Status,
+			LearningRate:        job.LearningRate,
+			Iterations:          job.Iterations,
+			VarThreshold:        job.VarThreshold,
+			ExperimentSessionID: job.ExperimentSessionId,
+			ResultFolder:        job.ResultFolder,
+			Cctvs:               []response.SweepCctvAccuracy{},
+		}
+		if job.Status == mysql.LearningJobStatusSucceeded && job.ExperimentSessionId != nil {
+			if err := scoreSweepRun(&run, rates[*job.ExperimentSessionId], sweep.OccupancyThreshold, cctvLabels); err != nil {
+				return response.ResSweep{}, common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), fmt.Sprintf("라벨 파일 읽기 실패: %v", err), common.ErrFromInternal)
+			}
+		}
+		runs = append(runs, run)
+	}
+	rankSweepRuns(runs)
+
+	result := response.ResSweep{
+		SweepID:            sweep.ID,
+		ProjectID:          sweep.ProjectId,
+		Status:             sweepStatus(jobs),
+		Total:              len(jobs),
+		Finished:           finished,
+		OccupancyThreshold: sweep.OccupancyThreshold,
+		LearningPath:       filepath.Base(sweep.LearningPath),
+		TestPath:           filepath.Base(sweep.TestImagePath),
+		RoiPath:            filepath.Base(sweep.RoiPath),
+		CreatedAt:          sweep.CreatedAt.Format(time.RFC3339),
+		BestPerCctv:        bestSweepRunPerCctv(runs),
+		Runs:               runs,
+	}
+	if len(runs) > 0 && runs[0].Rank == 1 {
+		best := runs[0]
+		result.Best = &best
+	}
+	return result, nil
+}
+
+// findSessionRates 세션 ID -> CCTV ID -> ROI 번호 -> 전경 비율
+func (d *GetSweepParkingUseCase) findSessionRates(ctx context.Context, jobs []mysql.LearningJobs) (map[int]map[string]map[int]float64, error) {
+	var sessionIDs []int
+	for _, job := range jobs {
+		if job.Status == mysql.LearningJobStatusSucceeded && job.ExperimentSessionId != nil {
+			sessionIDs = append(sessionIDs, *job.ExperimentSessionId)
+		}
+	}
+	cctvResults, err := d.Repository.FindCctvResultsBySessionIDs(ctx, sessionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	roiResults, err := d.Repository.FindRoiResultsByCctvResultIDs(ctx, cctvResultIDs(cctvResults))
+	if err != nil {
+		return nil, err
+	}
+	return groupRoiRates(cctvResults, roiResults), nil
+}
+
+// scoreSweepRun 라벨이 있는 ROI만 채점 (전경 비율이 threshold 이상이면 차량 있음으로 판정)
+func scoreSweepRun(run *response.SweepRun, cctvRates map[string]map[int]float64, threshold float64, cctvLabels func(cctvID string) (map[int]bool, error)) error {
+	cctvIDs := make([]string, 0, len(cctvRates))
+	for cctvID := range cctvRates {
+		cctvIDs = append(cctvIDs, cctvID)
+	}
+	sort.Strings(cctvIDs)
+
+	for _, cctvID := range cctvIDs {
+		truth, err := cctvLabels(cctvID)
+		if err != nil {
+			return err
+		}
+		var matrix confusion
+		for roiID, rate := range cctvRates[cctvID] {
+			if hasVehicle, ok := truth[roiID]; ok {
+				matrix.add(rate >= threshold, hasVehicle)
+			}
+		}
+		if matrix.labeled() == 0 {
+			continue
+		}
+		cctv := response.SweepCctvAccuracy{
+			CctvID:   cctvID,
+			Accuracy: ratio(matrix.correct(), matrix.labeled()),
+			Correct:  matrix.correct(),
+			Labeled:  matrix.labeled(),
+		}
+		run.Cctvs = append(run.Cctvs, cctv)
+		run.Correct += cctv.Correct
+		run.Labeled += cctv.Labeled
+	}
+	if run.Labeled > 0 {
+		accuracy := ratio(run.Correct, run.Labeled)
+		run.Accuracy = &accuracy
+	}
+	return nil
+}
+
+// rankSweepRuns 정확도 높은 순 정렬 (같으면 먼저 등록된 조합 우선), 채점 못 한 조합은 뒤로
+func rankSweepRuns(runs []response.SweepRun) {
+	sort.SliceStable(runs, func(i, j int) bool {
+		a, b := runs[i].Accuracy, runs[j].Accuracy
+		if a == nil || b == nil {
+			return a != nil && b == nil
+		}
+		return *a > *b
+	})
+	for i := range runs {
+		if runs[i].Accuracy != nil {
+			runs[i].Rank = i + 1
+		}
+	}
+}
+
+// bestSweepRunPerCctv CCTV별로 정확도가 가장 높은 조합 (runs는 순위 정렬된 상태)
+func bestSweepRunPerCctv(runs []response.SweepRun) []response.SweepCctvBest {
+	best := make(map[string]response.SweepCctvBest)
+	for _, run := range runs {
+		for _, cctv := range run.Cctvs {
+			if current, ok := best[cctv.CctvID]; ok && current.Accuracy >= cctv.Accuracy {
+				continue
+			}
+			best[cctv.CctvID] = response.SweepCctvBest{
+				CctvID:       cctv.CctvID,
+				JobID:        run.JobID,
+				LearningRate: run.LearningRate,
+				Iterations:   run.Iterations,
+				VarThreshold: run.VarThreshold,
+				Accuracy:     cctv.Accuracy,
+				Correct:      cctv.Correct,
+				Labeled:      cctv.Labeled,
+			}
+		}
+	}
+
+	result := make([]response.SweepCctvBest, 0, len(best))
+	for _, item := range best {
+		result = append(result, item)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CctvID < result[j].CctvID })
+	return result
+}
+
+// sweepStatus 조합 작업 상태로 스윕 상태 결정
+// 하나라도 대기/실행 중이면 running(전부 대기면 queued), 끝났으면 성공한 조합이 있을 때 succeeded
+func sweepStatus(jobs []mysql.LearningJobs) string {
+	counts := make(map[string]int)
+	for _, job := range jobs {
+		counts[job.Status]++
+	}
+	switch {
+	case len(jobs) == 0:
+		return mysql.LearningJobStatusFailed
+	case counts[mysql.LearningJobStatusQueued] == len(jobs):
+		return mysql.LearningJobStatusQueued
+	case counts[mysql.LearningJobStatusQueued] > 0 || counts[mysql.LearningJobStatusRunning] > 0:
+		return mysql.LearningJobStatusRunning
+	case counts[mysql.LearningJobStatusSucceeded] > 0:
+		return mysql.LearningJobStatusSucceeded
+	case counts[mysql.LearningJobStatusCancelled] == len(jobs):
+		return mysql.LearningJobStatusCancelled
+	default:
+		return mysql.LearningJobStatusFailed
+	}
+}
+
+func isFinishedLearningJob(status string) bool {
+	return status == mysql.LearningJobStatusSucceeded ||
+		status == mysql.LearningJobStatusFailed ||
+		status == mysql.LearningJobStatusCancelled
+}
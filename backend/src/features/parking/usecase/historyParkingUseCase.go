@@ -26,6 +26,29 @@ func (d *HistoryParkingUseCase) GetHistory(ctx context.Context, projectID string
 		return response.ResHistory{}, err
 	}
 
+	// 전체 실험의 ROI 결과를 한 번에 조회해 저장된 임계값으로 점유 판정
+	sessionIDs := make([]int, 0, len(history))
+	for _, item := range history {
+		sessionIDs = append(sessionIDs, int(item.ID))
+	}
+	cctvRows, err := d.Repository.FindCctvResultsBySessionIDs(ctx, sessionIDs)
+	if err != nil {
+		return response.ResHistory{}, err
+	}
+	roiRows, err := d.Repository.FindRoiResultsByCctvResultIDs(ctx, cctvResultIDs(cctvRows))
+	if err != nil {
+		return response.ResHistory{}, err
+	}
+	thresholds, err := loadOccupancyThresholds(ctx, d.Repository.FindOccupancyThresholds, projectID)
+	if err != nil {
+		return response.ResHistory{}, err
+	}
+	index, err := loadParkingSpaces(ctx, d.Repository.FindParkingSpaces, projectID)
+	if err != nil {
+		return response.ResHistory{}, err
+	}
+	rates := groupRoiRates(cctvRows, roiRows)
+
 	var historyItems []response.HistoryItem
 	for _, item := range history {
 		cctvResults, err := d.Repository.FindCctvResultByExperimentSessionID(ctx, int(item.ID))
@@ -41,6 +64,10 @@ func (d *HistoryParkingUseCase) GetHistory(ctx context.Context, projectID string
 			LearningRate: item.LearningRate,
 			VarThreshold: item.VarThreshold,
 			CctvList:     cctvResults,
+			Cctvs:        []response.CctvOccupancy{},
+		}
+		for _, cctvID := range cctvResults {
+			historyItem.Cctvs = append(historyItem.Cctvs, buildCctvOccupancy(cctvID, rates[int(item.ID)][cctvID], thresholds, index))
 		}
 
 		historyItems = append(historyItems, historyItem)
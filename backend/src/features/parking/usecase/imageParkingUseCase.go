@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"time"
 
+	"main/common"
 	_interface "main/features/parking/model/interface"
 	"main/features/parking/model/response"
 )
@@ -24,22 +25,13 @@ func (d *ImageParkingUseCase) GetImage(ctx context.Context, projectID string, fo
 	_, cancel := context.WithTimeout(ctx, d.ContextTimeout)
 	defer cancel()
 
-	// 현재 작업 디렉토리 확인
-	currentDir, err := os.Getwd()
-	if err != nil {
-		return response.ResImage{
-			Success: false,
-			Message: "Failed to get current directory: " + err.Error(),
-		}, nil
-	}
-
-	// 이미지 파일 경로 구성
-	var imagePath string
+	// 이미지 파일명 결정
+	var imageName string
 	switch imageType {
 	case "roi_result":
-		imagePath = filepath.Join(currentDir, "..", "..", "shared", projectID, "results", folderPath, cctvID, "roi_result.jpg")
+		imageName = "roi_result.jpg"
 	case "fgmask":
-		imagePath = filepath.Join(currentDir, "..", "..", "shared", projectID, "results", folderPath, cctvID, "fgmask.jpg")
+		imageName = "fgmask.jpg"
 	default:
 		return response.ResImage{
 			Success: false,
@@ -47,25 +39,21 @@ func (d *ImageParkingUseCase) GetImage(ctx context.Context, projectID string, fo
 		}, nil
 	}
 
-	// 절대 경로로 변환
-	absPath, err := filepath.Abs(imagePath)
+	// 이미지 파일 경로 구성 (작업 폴더 기준)
+	ws, err := common.ResolveWorkspace(ctx, projectID)
 	if err != nil {
-		return response.ResImage{
-			Success: false,
-			Message: "Failed to get absolute path: " + err.Error(),
-		}, nil
+		return response.ResImage{}, err
+	}
+	imagePath, err := ws.Resolve("results", folderPath, cctvID, imageName)
+	if err != nil {
+		return response.ResImage{}, err
 	}
-	imagePath = absPath
-
-	// 디버깅을 위한 로그 출력
-	fmt.Printf("Current directory: %s\n", currentDir)
-	fmt.Printf("Image path: %s\n", imagePath)
 
 	// 파일이 존재하는지 확인
 	if _, err := os.Stat(imagePath); os.IsNotExist(err) {
 		return response.ResImage{
 			Success: false,
-			Message: fmt.Sprintf("Image file not found: %s", imagePath),
+			Message: fmt.Sprintf("Image file not found: %s/%s/%s", folderPath, cctvID, imageName),
 		}, nil
 	}
 
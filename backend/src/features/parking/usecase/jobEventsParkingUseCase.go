@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"main/common"
+	"main/common/jobevents"
+	_interface "main/features/parking/model/interface"
+
+	"gorm.io/gorm"
+)
+
+type JobEventsParkingUseCase struct {
+	Repository     _interface.IJobEventsParkingRepository
+	ContextTimeout time.Duration
+}
+
+func NewJobEventsParkingUseCase(repo _interface.IJobEventsParkingRepository, timeout time.Duration) _interface.IJobEventsParkingUseCase {
+	return &JobEventsParkingUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// SubscribeJobEvents 작업 진행 이벤트 구독 (lastEventID 이후 이벤트부터 replay)
+func (d *JobEventsParkingUseCase) SubscribeJobEvents(c context.Context, projectID string, jobID string, lastEventID int) (jobevents.Subscription, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	id, err := strconv.ParseUint(jobID, 10, 32)
+	if err != nil || id == 0 {
+		return jobevents.Subscription{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), fmt.Sprintf("잘못된 작업 ID입니다: %s", jobID), common.ErrFromClient)
+	}
+
+	// 다른 프로젝트의 작업은 없는 것으로 처리
+	job, err := d.Repository.FindLearningJobByID(ctx, uint(id))
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && job.ProjectId != projectID) {
+		return jobevents.Subscription{}, common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), fmt.Sprintf("작업을 찾을 수 없습니다: %s", jobID), common.ErrFromClient)
+	}
+	if err != nil {
+		return jobevents.Subscription{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("작업 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	if sub, ok := jobevents.LearningEvents.Subscribe(learningJobKey(job.ID), lastEventID); ok {
+		return sub, nil
+	}
+
+	// 보관 기간이 지나 스트림이 없으면 DB의 현재 상태만 전달하고 종료
+	events := make(chan jobevents.Event)
+	close(events)
+	return jobevents.Subscription{
+		Replay: []jobevents.Event{{
+			Type: jobevents.StatusEvent,
+			Data: jobevents.JobState{Status: job.Status, ResultFolder: job.ResultFolder, ErrorMessage: job.ErrorMessage},
+			Time: job.UpdatedAt,
+		}},
+		Events:      events,
+		Unsubscribe: func() {},
+	}, nil
+}
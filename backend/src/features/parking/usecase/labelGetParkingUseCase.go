@@ -4,8 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"os"
-	"path/filepath"
 
+	"main/common"
 	_interface "main/features/parking/model/interface"
 	"main/features/parking/model/response"
 )
@@ -19,15 +19,16 @@ func NewLabelGetParkingUseCase(repository _interface.ILabelGetParkingRepository)
 }
 
 func (d *LabelGetParkingUseCase) GetLabels(ctx context.Context, projectID string, folderPath string, cctvID string) (response.ResGetLabel, error) {
-	// 현재 작업 디렉토리 가져오기
-	currentDir, err := os.Getwd()
+	// 라벨 파일 경로 구성 (작업 폴더 기준)
+	ws, err := common.ResolveWorkspace(ctx, projectID)
 	if err != nil {
-		return response.ResGetLabel{}, nil
+		return response.ResGetLabel{}, err
 	}
-
 	labelName := cctvID + "_labels.json"
-	// 라벨 파일 경로 구성
-	labelFilePath := filepath.Join(currentDir, "..", "..", "shared", projectID, "uploads", "testImages", folderPath, "testImages", labelName)
+	labelFilePath, err := ws.Resolve("uploads", "testImages", folderPath, "testImages", labelName)
+	if err != nil {
+		return response.ResGetLabel{}, err
+	}
 
 	// 파일이 존재하는지 확인
 	if _, err := os.Stat(labelFilePath); os.IsNotExist(err) {
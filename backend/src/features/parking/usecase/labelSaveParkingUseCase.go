@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"main/common"
 	_interface "main/features/parking/model/interface"
 	"main/features/parking/model/request"
 	"main/features/parking/model/response"
@@ -20,24 +21,21 @@ func NewLabelSaveParkingUseCase(repository _interface.ILabelSaveParkingRepositor
 }
 
 func (d *LabelSaveParkingUseCase) SaveLabels(ctx context.Context, projectID string, folderPath string, cctvID string, labels []request.LabelData) (response.ResSaveLabel, error) {
-	// 현재 작업 디렉토리 가져오기
-	currentDir, err := os.Getwd()
+	// 라벨 파일 경로 구성 (작업 폴더 기준)
+	ws, err := common.ResolveWorkspace(ctx, projectID)
 	if err != nil {
-		return response.ResSaveLabel{}, nil
+		return response.ResSaveLabel{}, err
+	}
+	labelName := cctvID + "_labels.json"
+	labelFilePath, err := ws.Resolve("uploads", "testImages", folderPath, "testImages", labelName)
+	if err != nil {
+		return response.ResSaveLabel{}, err
 	}
-
-	// 라벨 디렉토리 경로 구성
-
-	labelDir := filepath.Join(currentDir, "..", "..", "shared", projectID, "uploads", "testImages", folderPath, "testImages")
 
 	// 디렉토리가 없으면 생성
-	if err := os.MkdirAll(labelDir, 0755); err != nil {
+	if err := os.MkdirAll(filepath.Dir(labelFilePath), 0755); err != nil {
 		return response.ResSaveLabel{}, nil
 	}
-	labelName := cctvID + "_labels.json"
-
-	// 라벨 파일 경로
-	labelFilePath := filepath.Join(labelDir, labelName)
 
 	var responseImageLabels []response.SaveLabelData
 	for _, label := range labels {
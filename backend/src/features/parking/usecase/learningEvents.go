@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"main/common/jobevents"
+	"main/features/parking/model/entity"
+)
+
+// 학습 작업 진행 이벤트 종류 (상태 변경은 jobevents.StatusEvent)
+const (
+	learningEventProgress = "progress"
+	learningEventWarning  = "warning"
+	learningEventLog      = "log"
+)
+
+// learningJobKey 대기열/이벤트 스트림에서 쓰는 작업 키
+func learningJobKey(jobID uint) string {
+	return strconv.FormatUint(uint64(jobID), 10)
+}
+
+func publishLearningEvent(jobID uint, eventType string, data interface{}) {
+	jobevents.LearningEvents.Publish(learningJobKey(jobID), eventType, data)
+}
+
+// publishLearningState 작업 상태 변경 이벤트 전송
+func publishLearningState(jobID uint, status string) {
+	publishLearningEvent(jobID, jobevents.StatusEvent, jobevents.JobState{Status: status})
+}
+
+// publishLearningOutput OpenCV stdout 한 줄을 progress/log 이벤트로 변환
+func publishLearningOutput(jobID uint, line string) {
+	if payload, ok := strings.CutPrefix(line, "PROGRESS:"); ok {
+		var progress entity.LearningProgress
+		if err := json.Unmarshal([]byte(payload), &progress); err == nil {
+			publishLearningEvent(jobID, learningEventProgress, progress)
+			return
+		}
+	}
+	if strings.TrimSpace(line) == "" {
+		return
+	}
+	publishLearningEvent(jobID, learningEventLog, entity.LearningMessage{Message: line})
+}
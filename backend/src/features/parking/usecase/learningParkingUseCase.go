@@ -1,23 +1,32 @@
 package usecase
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
+	"main/common"
 	"main/common/db/mysql"
+	"main/common/jobevents"
+	"main/common/jobqueue"
+	"main/common/webhook"
 	"main/features/parking/model/entity"
 	_interface "main/features/parking/model/interface"
 	"main/features/parking/model/request"
 	"main/features/parking/model/response"
 )
 
+// 작업 테이블에 남길 stdout/stderr 최대 길이 (뒤쪽 기준)
+const learningOutputTailSize = 4096
+
 type LearningParkingUseCase struct {
 	Repository     _interface.ILearningParkingRepository
 	ContextTimeout time.Duration
@@ -27,61 +36,245 @@ func NewLearningParkingUseCase(repo _interface.ILearningParkingRepository, timeo
 	return &LearningParkingUseCase{Repository: repo, ContextTimeout: timeout}
 }
 
+// learningRun OpenCV 실행 결과
+type learningRun struct {
+	ResultFolder string
+	SessionID    int
+	ExitCode     *int
+	Stdout       string
+	Stderr       string
+}
+
 func (d *LearningParkingUseCase) Learning(c context.Context, req request.ReqLearning) (response.ResLearning, error) {
-	_, cancel := context.WithTimeout(c, d.ContextTimeout)
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
 	defer cancel()
 
+	// 작업 폴더 기준 전체 경로 변환 및 검증
+	ws, fullPaths, backendDir, err := prepareLearningPaths(ctx, req)
+	if err != nil {
+		return response.ResLearning{}, err
+	}
+
+	// 작업 등록 후 대기열에 추가
+	jobID, err := d.enqueueLearningJob(ctx, ws, fullPaths, backendDir, nil)
+	if err != nil {
+		return response.ResLearning{}, err
+	}
+
+	return response.ResLearning{
+		JobID:  jobID,
+		Status: mysql.LearningJobStatusQueued,
+	}, nil
+}
+
+// enqueueLearningJob 작업을 등록(queued)하고 대기열에 추가 (sweepID가 있으면 스윕에 묶음)
+// req는 buildFullPaths로 변환된 전체 경로 기준
+func (d *LearningParkingUseCase) enqueueLearningJob(ctx context.Context, ws common.Workspace, req request.ReqLearning, backendDir string, sweepID *uint) (uint, error) {
+	job := mysql.LearningJobs{
+		ProjectId:     req.ProjectID,
+		SweepId:       sweepID,
+		Status:        mysql.LearningJobStatusQueued,
+		VarThreshold:  req.VarThreshold,
+		LearningRate:  req.LearningRate,
+		Iterations:    req.Iterations,
+		LearningPath:  req.LearningPath,
+		TestImagePath: req.TestPath,
+		RoiPath:       req.RoiPath,
+	}
+	jobID, err := d.Repository.CreateLearningJob(ctx, job)
+	if err != nil {
+		return 0, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("학습 작업 등록 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	// 진행 이벤트 스트림 생성 후 대기열에 추가 (워커에서 OpenCV 실행)
+	jobevents.LearningEvents.Open(learningJobKey(jobID))
+	publishLearningState(jobID, mysql.LearningJobStatusQueued)
+	err = jobqueue.LearningQueue.Submit(learningJobKey(jobID), func(jobCtx context.Context) {
+		d.runLearningJob(jobCtx, jobID, ws, req, backendDir)
+	})
+	if err != nil {
+		d.updateLearningJob(ctx, jobID, map[string]interface{}{
+			"status":        mysql.LearningJobStatusFailed,
+			"error_message": err.Error(),
+			"finished_at":   time.Now(),
+		})
+		jobevents.LearningEvents.Finish(learningJobKey(jobID), jobevents.JobState{Status: mysql.LearningJobStatusFailed, ErrorMessage: err.Error()})
+		if errors.Is(err, jobqueue.ErrQueueFull) {
+			return 0, common.ErrorMsg(ctx, common.ErrQueueFull, common.Trace(), err.Error(), common.ErrFromInternal)
+		}
+		return 0, common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), err.Error(), common.ErrFromInternal)
+	}
+	return jobID, nil
+}
+
+// prepareLearningPaths 폴더명/파일명을 작업 폴더 기준 전체 경로로 바꾸고 OpenCV 실행 파일과 함께 존재 여부 검증
+func prepareLearningPaths(ctx context.Context, req request.ReqLearning) (common.Workspace, request.ReqLearning, string, error) {
 	// 현재 작업 디렉토리 가져오기
 	currentDir, err := os.Getwd()
 	if err != nil {
-		return response.ResLearning{
-			FolderPath: "",
-		}, nil
+		return common.Workspace{}, request.ReqLearning{}, "", common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), fmt.Sprintf("작업 디렉토리 조회 실패: %v", err), common.ErrFromInternal)
 	}
 
 	// Go 백엔드가 backend/src에서 실행되므로 상위 디렉토리로 이동
 	backendDir := filepath.Join(currentDir, "..")
 	opencvPath := filepath.Join(backendDir, "opencv", "build", "main")
-	fmt.Println("원본 요청:", req)
 
-	// 폴더명/파일명을 전체 경로로 변환
-	fullPaths := buildFullPaths(req)
-	fmt.Println("변환된 전체 경로:", fullPaths)
+	// 폴더명/파일명을 작업 폴더 기준 전체 경로로 변환
+	ws, err := common.ResolveWorkspace(ctx, req.ProjectID)
+	if err != nil {
+		return common.Workspace{}, request.ReqLearning{}, "", common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+	fullPaths, err := buildFullPaths(ws, req)
+	if err != nil {
+		return common.Workspace{}, request.ReqLearning{}, "", common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
 
 	if err := validatePaths(opencvPath); err != nil {
-		return response.ResLearning{
-			FolderPath: "",
-		}, err
+		return common.Workspace{}, request.ReqLearning{}, "", common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), err.Error(), common.ErrFromInternal)
+	}
+	for _, path := range []string{fullPaths.LearningPath, fullPaths.TestPath, fullPaths.RoiPath} {
+		if err := validatePaths(path); err != nil {
+			return common.Workspace{}, request.ReqLearning{}, "", common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+		}
+	}
+	return ws, fullPaths, backendDir, nil
+}
+
+// RecoverLearningJobs 서버 재시작 등으로 끝나지 못한 작업을 실패 처리
+func (d *LearningParkingUseCase) RecoverLearningJobs(c context.Context) error {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	count, err := d.Repository.FailUnfinishedLearningJobs(ctx, "서버 재시작으로 작업이 중단되었습니다")
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		fmt.Printf("중단된 학습 작업 %d건을 실패 처리했습니다\n", count)
+	}
+	return nil
+}
+
+// BackfillParkingSpaces 주차면 번호 대응이 없는 프로젝트에 ROI 파일의 parking_id를 예전 규칙 번호로 한 번 저장
+// 대응이 생기기 전에 저장된 결과/기준의 ROI 번호는 예전 규칙("_" 뒤 숫자)을 따르므로 그대로 맞게 됨
+func (d *LearningParkingUseCase) BackfillParkingSpaces(c context.Context) error {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	projectIDs, err := d.Repository.FindProjectsWithoutParkingSpaces(ctx)
+	if err != nil {
+		return err
+	}
+	for _, projectID := range projectIDs {
+		parkingIDs, err := legacyParkingIDs(projectID)
+		if err != nil {
+			fmt.Printf("%s 주차면 번호 대응 생성 실패: %v\n", projectID, err)
+			continue
+		}
+		if len(parkingIDs) == 0 {
+			continue
+		}
+		rows, err := d.Repository.EnsureParkingSpaces(ctx, projectID, parkingIDs)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s 주차면 번호 대응 %d건을 저장했습니다\n", projectID, len(rows))
 	}
+	return nil
+}
+
+// runLearningJob 워커에서 작업 하나를 실행하고 상태를 기록
+func (d *LearningParkingUseCase) runLearningJob(ctx context.Context, jobID uint, ws common.Workspace, req request.ReqLearning, backendDir string) {
+	// 작업이 취소되어도 상태 기록은 해야 하므로 취소되지 않는 ctx 사용
+	statusCtx := context.WithoutCancel(ctx)
+
+	startedAt := time.Now()
+	d.updateLearningJob(statusCtx, jobID, map[string]interface{}{
+		"status":     mysql.LearningJobStatusRunning,
+		"started_at": startedAt,
+	})
+	publishLearningState(jobID, mysql.LearningJobStatusRunning)
 
-	if err := validatePaths(fullPaths.LearningPath); err != nil {
-		return response.ResLearning{
-			FolderPath: "",
-		}, err
+	run, err := d.executeOpenCV(ctx, jobID, ws, req, backendDir)
+
+	finishedAt := time.Now()
+	fields := map[string]interface{}{
+		"result_folder": run.ResultFolder,
+		"exit_code":     run.ExitCode,
+		"stdout_tail":   tailOutput(run.Stdout, learningOutputTailSize),
+		"stderr_tail":   tailOutput(run.Stderr, learningOutputTailSize),
+		"duration_ms":   finishedAt.Sub(startedAt).Milliseconds(),
+		"finished_at":   finishedAt,
 	}
-	if err := validatePaths(fullPaths.TestPath); err != nil {
-		return response.ResLearning{
-			FolderPath: "",
-		}, err
+	switch {
+	case err != nil && ctx.Err() != nil:
+		// 취소된 작업은 중간 결과 폴더를 지움 (DB 저장은 트랜잭션 롤백됨)
+		fields["status"] = mysql.LearningJobStatusCancelled
+		fields["error_message"] = "작업이 취소되었습니다"
+		d.removeResultFolder(ws, run.ResultFolder)
+	case err != nil:
+		fields["status"] = mysql.LearningJobStatusFailed
+		fields["error_message"] = err.Error()
+	default:
+		fields["status"] = mysql.LearningJobStatusSucceeded
+		fields["experiment_session_id"] = run.SessionID
 	}
-	if err := validatePaths(fullPaths.RoiPath); err != nil {
-		return response.ResLearning{
-			FolderPath: "",
-		}, err
+	d.updateLearningJob(statusCtx, jobID, fields)
+
+	state := jobevents.JobState{Status: fields["status"].(string), ResultFolder: run.ResultFolder}
+	if message, ok := fields["error_message"].(string); ok {
+		state.ErrorMessage = message
 	}
+	jobevents.LearningEvents.Finish(learningJobKey(jobID), state)
+	webhook.Emit(ws.ProjectID, webhook.EventLearningJobFinished, response.LearningJobFinished{
+		JobID:        jobID,
+		Status:       state.Status,
+		ResultFolder: state.ResultFolder,
+		ErrorMessage: state.ErrorMessage,
+		DurationMs:   finishedAt.Sub(startedAt).Milliseconds(),
+		FinishedAt:   finishedAt.Format(time.RFC3339),
+	})
+}
 
-	// OpenCV 실행 (전체 경로로 변환된 요청 사용)
-	success, message, resultPath := d.executeOpenCV(c, fullPaths, backendDir)
-	fmt.Println(success, message)
-	return response.ResLearning{
-		FolderPath: resultPath,
-	}, nil
+// removeResultFolder 취소된 작업의 결과 폴더 삭제
+func (d *LearningParkingUseCase) removeResultFolder(ws common.Workspace, folder string) {
+	if folder == "" {
+		return
+	}
+	resultsDir, err := ws.Resolve("results", folder)
+	if err != nil {
+		return
+	}
+	if err := os.RemoveAll(resultsDir); err != nil {
+		fmt.Printf("취소된 작업의 결과 폴더 삭제 실패 (%s): %v\n", resultsDir, err)
+	}
+}
+
+// updateLearningJob 작업 상태 갱신 (실패해도 작업 흐름은 계속 진행)
+func (d *LearningParkingUseCase) updateLearningJob(ctx context.Context, jobID uint, fields map[string]interface{}) {
+	if err := d.Repository.UpdateLearningJob(ctx, jobID, fields); err != nil {
+		fmt.Printf("학습 작업 상태 갱신 실패 (job %d): %v\n", jobID, err)
+	}
 }
 
 // OpenCV 실행
-func (d *LearningParkingUseCase) executeOpenCV(ctx context.Context, req request.ReqLearning, backendDir string) (bool, string, string) {
+func (d *LearningParkingUseCase) executeOpenCV(ctx context.Context, jobID uint, ws common.Workspace, req request.ReqLearning, backendDir string) (learningRun, error) {
 	opencvPath := filepath.Join(backendDir, "opencv", "build", "main")
 
+	// 결과 폴더: {workspace}/results/{timestamp}_{jobId}
+	run := learningRun{ResultFolder: fmt.Sprintf("%s_%d", getCurrentTimestamp(), jobID)}
+	resultsDir, err := ws.Resolve("results", run.ResultFolder)
+	if err != nil {
+		return run, err
+	}
+
+	// parking_id별 고정 번호(roi_id)를 적은 ROI 파일을 OpenCV에 넘김
+	detectorRoiPath, cleanup, err := prepareDetectorRoi(ctx, d.Repository.EnsureParkingSpaces, req.ProjectID, req.RoiPath)
+	if err != nil {
+		return run, err
+	}
+	defer cleanup()
+
 	// OpenCV 실행 명령어 구성 (새로운 파라미터 순서)
 	args := []string{
 		fmt.Sprintf("%f", req.LearningRate), // learning_rate
@@ -90,102 +283,125 @@ func (d *LearningParkingUseCase) executeOpenCV(ctx context.Context, req request.
 		req.ProjectID,                       // project_id
 		req.LearningPath,                    // learning_base_path
 		req.TestPath,                        // test_images_path (폴더)
-		req.RoiPath,                         // roi_path
-		"../../shared/" + req.ProjectID + "/results/" + getCurrentTimestamp(), // results_dir
+		detectorRoiPath,                     // roi_path
+		resultsDir,                          // results_dir
 	}
 
-	// 명령어 실행
-	cmd := exec.Command(opencvPath, args...)
+	// 명령어 실행 (ctx 취소 시 프로세스 그룹 전체 종료)
+	cmd := exec.CommandContext(ctx, opencvPath, args...)
 	cmd.Dir = filepath.Join(backendDir, "opencv") // OpenCV 디렉토리를 작업 디렉토리로 설정
+	common.BindProcessGroup(cmd)
 
-	// 실행 결과 캡처
-	output, err := cmd.CombinedOutput()
+	// 실행 결과를 한 줄씩 읽어 진행 이벤트로 전달 (stdout/stderr 분리)
+	stdoutPipe, err := cmd.StdoutPipe()
 	if err != nil {
-		return false, fmt.Sprintf("OpenCV 실행 실패: %v\n출력: %s", err, string(output)), ""
+		return run, fmt.Errorf("OpenCV 출력 연결 실패: %v", err)
+	}
+	stderrPipe, err := cmd.StderrPipe()
+	if err != nil {
+		return run, fmt.Errorf("OpenCV 출력 연결 실패: %v", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return run, fmt.Errorf("OpenCV 실행 실패: %v", err)
 	}
 
-	outputStr := string(output)
-	fmt.Println("OpenCV 출력:", outputStr)
-
-	// JSON 파일명 추출
-	lines := strings.Split(outputStr, "\n")
+	var stdout, stderr bytes.Buffer
 	var jsonFilename string
-	for _, line := range lines {
-		if strings.HasPrefix(line, "JSON_FILE:") {
-			jsonFilename = strings.TrimSpace(strings.TrimPrefix(line, "JSON_FILE:"))
-			break
-		}
-	}
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		scanLines(stdoutPipe, &stdout, func(line string) {
+			// JSON 파일명 추출
+			if path, ok := strings.CutPrefix(line, "JSON_FILE:"); ok {
+				jsonFilename = strings.TrimSpace(path)
+				return
+			}
+			publishLearningOutput(jobID, line)
+		})
+	}()
+	go func() {
+		defer wg.Done()
+		scanLines(stderrPipe, &stderr, func(line string) {
+			if strings.TrimSpace(line) != "" {
+				publishLearningEvent(jobID, learningEventWarning, entity.LearningMessage{Message: line})
+			}
+		})
+	}()
+	wg.Wait()
+	err = cmd.Wait()
 
-	if jsonFilename == "" {
-		return false, "JSON 파일명을 찾을 수 없습니다.", ""
-	}
-	// JSON 파일 경로에서 results/ 뒤 폴더명 추출
-	parts := strings.Split(jsonFilename, string(os.PathSeparator))
-	folderName := ""
-	for i, p := range parts {
-		if p == "results" && i+1 < len(parts) {
-			folderName = parts[i+1]
-			break
-		}
+	run.Stdout = stdout.String()
+	run.Stderr = stderr.String()
+	if cmd.ProcessState != nil {
+		exitCode := cmd.ProcessState.ExitCode()
+		run.ExitCode = &exitCode
+	}
+	if err != nil {
+		return run, fmt.Errorf("OpenCV 실행 실패: %v", err)
 	}
 
-	if folderName == "" {
-		return false, "폴더명을 추출할 수 없습니다.", ""
+	if jsonFilename == "" {
+		return run, fmt.Errorf("JSON 파일명을 찾을 수 없습니다")
 	}
 
 	data, err := os.ReadFile(jsonFilename)
 	if err != nil {
-		return false, fmt.Sprintf("JSON 파일 읽기 실패: %v", err), ""
+		return run, fmt.Errorf("JSON 파일 읽기 실패: %v", err)
 	}
 
 	var result entity.ExperimentResult
 	if err := json.Unmarshal(data, &result); err != nil {
-		log.Fatal(err)
+		return run, fmt.Errorf("JSON 파싱 실패: %v", err)
 	}
 
-	// DB 저장 로직
-	// gorm 객체 생성 후 저장
-	//ExperimentSession 객체 생성 후 저장
-	experimentSessionDB := mysql.ExperimentSessions{
-		VarThreshold:  req.VarThreshold,
-		LearningRate:  req.LearningRate,
-		Iterations:    req.Iterations,
-		LearningPath:  req.LearningPath,
-		TestImagePath: req.TestPath,
-		RoiPath:       req.RoiPath,
-		ProjectId:     req.ProjectID,
-		Name:          folderName,
-	}
-	esID, err := d.Repository.CreateExperimentSession(ctx, experimentSessionDB)
-	if err != nil {
-		return false, fmt.Sprintf("실험 세션 생성 실패: %v", err), ""
-	}
-	// CctvResult 객체 생성 후 저장
-	for _, cctvResult := range result.Results {
-		cctvResultDB := mysql.CctvResults{
-			ExperimentSessionId: esID,
-			CctvId:              cctvResult.CctvID,
-			LearningDataSize:    cctvResult.LearningDataSize,
+	// DB 저장 로직 (세션/CCTV/ROI 결과를 한 트랜잭션으로 저장, 취소되면 롤백)
+	var esID int
+	err = d.Repository.WithTransaction(ctx, func(repo _interface.ILearningParkingRepository) error {
+		experimentSessionDB := mysql.ExperimentSessions{
+			VarThreshold:  req.VarThreshold,
+			LearningRate:  req.LearningRate,
+			Iterations:    req.Iterations,
+			LearningPath:  req.LearningPath,
+			TestImagePath: req.TestPath,
+			RoiPath:       req.RoiPath,
+			ProjectId:     req.ProjectID,
+			Name:          run.ResultFolder,
 		}
-		crID, err := d.Repository.CreateCctvResult(ctx, cctvResultDB)
+		id, err := repo.CreateExperimentSession(ctx, experimentSessionDB)
 		if err != nil {
-			return false, fmt.Sprintf("CctvResult 생성 실패: %v", err), ""
+			return fmt.Errorf("실험 세션 생성 실패: %v", err)
 		}
-		// RoiResult 객체 생성 후 저장
-		for _, roiResult := range cctvResult.RoiResults {
-			roiResultDB := mysql.RoiResults{
-				CctvResultId: crID,
-				RoiId:        roiResult.RoiID,
-				Rate:         roiResult.ForegroundRatio,
+		// CctvResult 객체 생성 후 저장
+		for _, cctvResult := range result.Results {
+			cctvResultDB := mysql.CctvResults{
+				ExperimentSessionId: id,
+				CctvId:              cctvResult.CctvID,
+				LearningDataSize:    cctvResult.LearningDataSize,
 			}
-			err = d.Repository.CreateRoiResult(ctx, roiResultDB)
+			crID, err := repo.CreateCctvResult(ctx, cctvResultDB)
 			if err != nil {
-				return false, fmt.Sprintf("RoiResult 생성 실패: %v", err), ""
+				return fmt.Errorf("CctvResult 생성 실패: %v", err)
+			}
+			// RoiResult 객체 생성 후 저장
+			for _, roiResult := range cctvResult.RoiResults {
+				roiResultDB := mysql.RoiResults{
+					CctvResultId: crID,
+					RoiId:        roiResult.RoiID,
+					Rate:         roiResult.ForegroundRatio,
+				}
+				if err := repo.CreateRoiResult(ctx, roiResultDB); err != nil {
+					return fmt.Errorf("RoiResult 생성 실패: %v", err)
+				}
 			}
 		}
+		esID = id
+		return nil
+	})
+	if err != nil {
+		return run, err
 	}
 
-	// 폴더명만 반환 (전체 경로가 아닌)
-	return true, fmt.Sprintf("학습이 성공적으로 완료되었습니다.\n생성된 JSON 파일: %v", result), folderName
+	run.SessionID = esID
+	return run, nil
 }
@@ -2,13 +2,17 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"time"
 
+	"main/common"
 	_interface "main/features/parking/model/interface"
 	"main/features/parking/model/response"
+
+	"gorm.io/gorm"
 )
 
 type LearningResultsParkingUseCase struct {
@@ -24,21 +28,15 @@ func (d *LearningResultsParkingUseCase) GetLearningResults(ctx context.Context,
 	_, cancel := context.WithTimeout(ctx, d.ContextTimeout)
 	defer cancel()
 
-	// 현재 작업 디렉토리 확인
-	currentDir, err := os.Getwd()
+	// 결과 폴더 경로 구성 (작업 폴더 기준)
+	ws, err := common.ResolveWorkspace(ctx, projectID)
 	if err != nil {
-		return response.ResLearningResults{
-			Success: false,
-			Message: "Failed to get current directory: " + err.Error(),
-		}, nil
+		return response.ResLearningResults{}, err
+	}
+	resultsPath, err := ws.Resolve("results", timestamp)
+	if err != nil {
+		return response.ResLearningResults{}, err
 	}
-
-	// 결과 폴더 경로 구성 (절대 경로 사용)
-	resultsPath := filepath.Join(currentDir, "..", "..", "shared", projectID, "results", timestamp)
-
-	// 디버깅을 위한 로그 출력
-	fmt.Printf("Current directory: %s\n", currentDir)
-	fmt.Printf("Results path: %s\n", resultsPath)
 
 	// 폴더가 존재하는지 확인
 	if _, err := os.Stat(resultsPath); os.IsNotExist(err) {
@@ -48,6 +46,12 @@ func (d *LearningResultsParkingUseCase) GetLearningResults(ctx context.Context,
 		}, nil
 	}
 
+	// 결과 폴더에 해당하는 실험의 ROI 결과와 점유 판정
+	occupancies, err := d.findResultOccupancy(ctx, projectID, timestamp)
+	if err != nil {
+		return response.ResLearningResults{}, err
+	}
+
 	// CCTV 폴더 목록 조회
 	cctvList := []response.CctvResultInfo{}
 
@@ -75,9 +79,15 @@ func (d *LearningResultsParkingUseCase) GetLearningResults(ctx context.Context,
 				}
 			}
 
+			rois := []response.RoiOccupancy{}
+			if occupancy, ok := occupancies[cctvID]; ok {
+				rois = occupancy.Rois
+			}
+
 			cctvList = append(cctvList, response.CctvResultInfo{
 				CctvID:    cctvID,
 				HasImages: hasImages,
+				Rois:      rois,
 			})
 		}
 	}
@@ -91,3 +101,38 @@ func (d *LearningResultsParkingUseCase) GetLearningResults(ctx context.Context,
 		},
 	}, nil
 }
+
+// findResultOccupancy 결과 폴더명(= 실험 이름)으로 CCTV별 ROI 점유 판정 (실험이 DB에 없으면 빈 결과)
+func (d *LearningResultsParkingUseCase) findResultOccupancy(ctx context.Context, projectID string, folder string) (map[string]response.CctvOccupancy, error) {
+	occupancies := make(map[string]response.CctvOccupancy)
+
+	session, err := d.Repository.FindExperimentSessionByName(ctx, projectID, folder)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return occupancies, nil
+	}
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("실험 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	cctvResults, err := d.Repository.FindCctvResultsBySessionIDs(ctx, []int{int(session.ID)})
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("CCTV 결과 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	roiResults, err := d.Repository.FindRoiResultsByCctvResultIDs(ctx, cctvResultIDs(cctvResults))
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("ROI 결과 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	thresholds, err := loadOccupancyThresholds(ctx, d.Repository.FindOccupancyThresholds, projectID)
+	if err != nil {
+		return nil, err
+	}
+	index, err := loadParkingSpaces(ctx, d.Repository.FindParkingSpaces, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	for cctvID, rates := range groupRoiRates(cctvResults, roiResults)[int(session.ID)] {
+		occupancies[cctvID] = buildCctvOccupancy(cctvID, rates, thresholds, index)
+	}
+	return occupancies, nil
+}
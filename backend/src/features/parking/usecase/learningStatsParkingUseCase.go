@@ -23,10 +23,15 @@ func (d *LearningStatsParkingUseCase) GetLearningStats(c context.Context, projec
 	_, cancel := context.WithTimeout(c, d.ContextTimeout)
 	defer cancel()
 
-	// 저장 경로 설정
-	uploadPath := common.Env.UploadPath
-	projectPath := filepath.Join(uploadPath, projectID)
-	targetPath := filepath.Join(projectPath, "uploads", "learningImages")
+	// 저장 경로 설정 (작업 폴더 기준)
+	ws, err := common.ResolveWorkspace(c, projectID)
+	if err != nil {
+		return response.ResLearningStats{}, err
+	}
+	targetPath, err := ws.Resolve("uploads", "learningImages")
+	if err != nil {
+		return response.ResLearningStats{}, err
+	}
 
 	var folders []response.FolderInfo
 	total := 0
@@ -27,10 +27,15 @@ func (d *LearningUploadParkingUseCase) LearningUpload(c context.Context, project
 	_, cancel := context.WithTimeout(c, d.ContextTimeout)
 	defer cancel()
 
-	// 저장 경로 설정
-	uploadPath := common.Env.UploadPath
-	projectPath := filepath.Join(uploadPath, projectID)
-	targetPath := filepath.Join(projectPath, "uploads", "learningImages")
+	// 저장 경로 설정 (작업 폴더 기준)
+	ws, err := common.ResolveWorkspace(c, projectID)
+	if err != nil {
+		return response.ResLearningUpload{}, err
+	}
+	targetPath, err := ws.Resolve("uploads", "learningImages")
+	if err != nil {
+		return response.ResLearningUpload{}, err
+	}
 
 	// 폴더 생성
 	if err := os.MkdirAll(targetPath, 0755); err != nil {
@@ -71,11 +76,19 @@ func (d *LearningUploadParkingUseCase) LearningUpload(c context.Context, project
 			relativePath = file.Filename
 		}
 
+		// 최종 저장 경로 (폴더 구조 그대로 유지, 작업 폴더 밖으로 벗어나는 경로는 제외)
+		finalPath, err := ws.Resolve("uploads", "learningImages", rootFolderName, relativePath)
+		if err != nil || !strings.HasPrefix(finalPath, rootFolderPath+string(filepath.Separator)) {
+			errorMsg := fmt.Sprintf("잘못된 파일 경로: %s", relativePath)
+			errors = append(errors, errorMsg)
+			continue
+		}
+
 		// 폴더 구조 유지하여 저장
 		fileDir := filepath.Dir(relativePath)
 		if fileDir != "." {
 			// 하위 폴더가 있는 경우 생성
-			subDir := filepath.Join(rootFolderPath, fileDir)
+			subDir := filepath.Dir(finalPath)
 			if err := os.MkdirAll(subDir, 0755); err != nil {
 				errorMsg := fmt.Sprintf("하위 폴더 생성 실패: %s - %v", subDir, err)
 				errors = append(errors, errorMsg)
@@ -83,9 +96,6 @@ func (d *LearningUploadParkingUseCase) LearningUpload(c context.Context, project
 			}
 		}
 
-		// 최종 저장 경로 (폴더 구조 그대로 유지)
-		finalPath := filepath.Join(rootFolderPath, relativePath)
-
 		// 파일 저장
 		if err := saveUploadedFile(file, finalPath); err != nil {
 			errorMsg := fmt.Sprintf("파일 저장 실패: %s - %v", filepath.Base(file.Filename), err)
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"time"
+
+	"main/common/db/mysql"
+	"main/common/jobevents"
+	"main/common/zones"
+	"main/features/parking/model/response"
+)
+
+// 실시간 점유 이벤트 종류 (snapshot은 구독 시 첫 이벤트로만 전달)
+const (
+	liveEventSnapshot     = "snapshot"
+	liveEventSpaceChanged = "space_changed"
+	liveEventImageUpdated = "image_updated"
+	liveEventRunFinished  = "live_run_finished"
+)
+
+// 실시간 학습마다 다시 그려지는 결과 이미지 종류
+var liveResultImageTypes = []string{"roi_result", "fgmask"}
+
+// publishLiveEvent 프로젝트 스트림에 이벤트 전송 (스트림이 없으면 생성)
+func publishLiveEvent(projectID string, eventType string, data interface{}) {
+	jobevents.OccupancyEvents.Ensure(projectID)
+	jobevents.OccupancyEvents.Publish(projectID, eventType, data)
+}
+
+// spaceStateChanges 이전 상태와 비교해 점유 여부가 바뀌었거나 처음 관측된 주차면
+func spaceStateChanges(previous []mysql.SpaceState, current []mysql.SpaceState) []response.LiveSpaceChange {
+	before := make(map[zones.Space]bool, len(previous))
+	for _, state := range previous {
+		before[zones.Space{CctvID: state.CctvId, ParkingID: state.ParkingId}] = state.Occupied
+	}
+
+	var changes []response.LiveSpaceChange
+	for _, state := range current {
+		change := response.LiveSpaceChange{
+			CctvID:     state.CctvId,
+			ParkingID:  state.ParkingId,
+			RoiID:      state.RoiId,
+			Occupied:   state.Occupied,
+			Rate:       state.Rate,
+			ObservedAt: state.ObservedAt.Format(time.RFC3339),
+		}
+		if occupied, ok := before[zones.Space{CctvID: state.CctvId, ParkingID: state.ParkingId}]; ok {
+			if occupied == state.Occupied {
+				continue
+			}
+			change.Previous = &occupied
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// publishLiveRun 실시간 학습 한 번의 변경 이벤트 전송 (주차면 변경 → CCTV 이미지 → 실행 완료 순)
+func publishLiveRun(projectID string, success bool, cctvs []string, states []mysql.SpaceState, changes []response.LiveSpaceChange, misaligned int, finishedAt time.Time) {
+	for _, change := range changes {
+		publishLiveEvent(projectID, liveEventSpaceChanged, change)
+	}
+
+	sources := make(map[string]string, len(states))
+	for _, state := range states {
+		sources[state.CctvId] = state.SourceImage
+	}
+	finished := response.LiveRunFinished{
+		Success:    success,
+		Cctvs:      cctvs,
+		Changed:    len(changes),
+		Misaligned: misaligned,
+		FinishedAt: finishedAt.Format(time.RFC3339),
+	}
+	if finished.Cctvs == nil {
+		finished.Cctvs = []string{}
+	}
+	if success {
+		for _, cctvID := range cctvs {
+			publishLiveEvent(projectID, liveEventImageUpdated, response.LiveImageUpdate{
+				CctvID:      cctvID,
+				ImageTypes:  liveResultImageTypes,
+				SourceImage: sources[cctvID],
+				UpdatedAt:   finishedAt.Format(time.RFC3339),
+			})
+		}
+	}
+	for _, state := range states {
+		if state.Occupied {
+			finished.Occupied++
+		} else {
+			finished.Free++
+		}
+	}
+	publishLiveEvent(projectID, liveEventRunFinished, finished)
+}
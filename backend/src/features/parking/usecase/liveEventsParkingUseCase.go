@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"main/common"
+	"main/common/jobevents"
+	_interface "main/features/parking/model/interface"
+)
+
+type LiveEventsParkingUseCase struct {
+	Repository     _interface.ILiveEventsParkingRepository
+	ContextTimeout time.Duration
+}
+
+func NewLiveEventsParkingUseCase(repo _interface.ILiveEventsParkingRepository, timeout time.Duration) _interface.ILiveEventsParkingUseCase {
+	return &LiveEventsParkingUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// SubscribeLiveEvents 실시간 점유 이벤트 구독 (첫 이벤트는 현재 주차면 상태 snapshot, lastEventID가 있으면 그 이후 이벤트를 이어서 replay)
+func (d *LiveEventsParkingUseCase) SubscribeLiveEvents(c context.Context, projectID string, lastEventID int) (jobevents.Subscription, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	// snapshot보다 먼저 구독해 그 사이의 변경을 놓치지 않음 (중복된 변경은 같은 상태라 다시 적용해도 무방)
+	jobevents.OccupancyEvents.Ensure(projectID)
+	sub, _ := jobevents.OccupancyEvents.Subscribe(projectID, lastEventID)
+	if lastEventID == 0 {
+		sub.Replay = nil
+	}
+
+	states, err := d.Repository.FindSpaceStates(ctx, projectID, "")
+	if err != nil {
+		sub.Unsubscribe()
+		return jobevents.Subscription{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("주차면 상태 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	snapshot := jobevents.Event{Type: liveEventSnapshot, Data: buildLiveStateResponse(projectID, states), Time: time.Now()}
+	sub.Replay = append([]jobevents.Event{snapshot}, sub.Replay...)
+	return sub, nil
+}
@@ -10,6 +10,11 @@ import (
 	"strings"
 	"time"
 
+	"main/common"
+	"main/common/camshift"
+	"main/common/db/mysql"
+	"main/common/roidoc"
+	"main/common/webhook"
 	_interface "main/features/parking/model/interface"
 	"main/features/parking/model/request"
 	"main/features/parking/model/response"
@@ -25,7 +30,7 @@ func NewLiveLearningParkingUseCase(repo _interface.ILiveLearningParkingRepositor
 }
 
 func (d *LiveLearningParkingUseCase) LiveLearning(c context.Context, req request.ReqLiveLearning) (response.ResLiveLearning, error) {
-	_, cancel := context.WithTimeout(c, d.ContextTimeout)
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
 	defer cancel()
 
 	// 현재 작업 디렉토리 가져오기
@@ -41,8 +46,21 @@ func (d *LiveLearningParkingUseCase) LiveLearning(c context.Context, req request
 	backendDir := filepath.Join(currentDir, "..")
 	opencvPath := filepath.Join(backendDir, "opencv", "build", "main")
 
-	// 폴더명/파일명을 전체 경로로 변환
-	fullPaths := liveBuildFullPaths(req)
+	// 폴더명/파일명을 작업 폴더 기준 전체 경로로 변환
+	ws, err := common.ResolveWorkspace(c, req.ProjectID)
+	if err != nil {
+		return response.ResLiveLearning{
+			Cctvs:      []string{},
+			TotalCctvs: 0,
+		}, err
+	}
+	fullPaths, err := liveBuildFullPaths(ws, req)
+	if err != nil {
+		return response.ResLiveLearning{
+			Cctvs:      []string{},
+			TotalCctvs: 0,
+		}, err
+	}
 	fmt.Println("변환된 전체 경로:", fullPaths)
 
 	if err := validatePaths(opencvPath); err != nil {
@@ -65,9 +83,25 @@ func (d *LiveLearningParkingUseCase) LiveLearning(c context.Context, req request
 		}, err
 	}
 
-	// OpenCV 실행
-	success, message, _, cctvIds := d.executeOpenCV(c, fullPaths, backendDir)
+	// 이번 실행의 주차면 (ROI에서 빠진 주차면의 이전 상태는 저장 시 삭제)
+	roiDoc, err := roidoc.Load(fullPaths.RoiPath)
+	if err != nil {
+		return response.ResLiveLearning{
+			Cctvs:      []string{},
+			TotalCctvs: 0,
+		}, fmt.Errorf("ROI 파일 읽기 실패: %v", err)
+	}
+	runParkingIDs := roiParkingIDs(roiDoc)
+
+	// OpenCV 실행 (요청 취소/시간 초과 시 프로세스 종료)
+	success, message, _, cctvIds := d.executeOpenCV(ctx, ws, fullPaths, backendDir)
 	fmt.Println(success, message)
+	if err := ctx.Err(); err != nil {
+		return response.ResLiveLearning{
+			Cctvs:      []string{},
+			TotalCctvs: 0,
+		}, fmt.Errorf("실시간 학습이 중단되었습니다: %v", err)
+	}
 
 	// CCTV ID 배열을 []string으로 변환
 	var cctvList []string
@@ -77,31 +111,140 @@ func (d *LiveLearningParkingUseCase) LiveLearning(c context.Context, req request
 		}
 	}
 
+	// ROI별 전경 비율에 저장된 점유 판정 기준 적용
+	results := []response.CctvOccupancy{}
+	var states []mysql.SpaceState
+	var changes []response.LiveSpaceChange
+	var zoneChanges []webhook.ZoneOccupancy
+	if success {
+		result, err := readOpenCVResult(message)
+		if err != nil {
+			fmt.Printf("실시간 학습 결과 읽기 실패: %v\n", err)
+		} else {
+			thresholds, err := loadOccupancyThresholds(ctx, d.Repository.FindOccupancyThresholds, req.ProjectID)
+			if err != nil {
+				return response.ResLiveLearning{
+					Cctvs:      []string{},
+					TotalCctvs: 0,
+				}, err
+			}
+			index, err := loadParkingSpaces(ctx, d.Repository.FindParkingSpaces, req.ProjectID)
+			if err != nil {
+				return response.ResLiveLearning{
+					Cctvs:      []string{},
+					TotalCctvs: 0,
+				}, err
+			}
+			results = buildResultOccupancy(result, thresholds, index)
+
+			// 주차면별 최신 상태 저장 (liveResults는 다음 실행에 덮어써지므로 DB에 남김)
+			currentImagesDir, _ := ws.Resolve("currentImages")
+			states = buildSpaceStates(req.ProjectID, result, results, currentImagesDir, time.Now())
+			previous, err := d.Repository.FindSpaceStates(ctx, req.ProjectID, "")
+			if err != nil {
+				return response.ResLiveLearning{
+					Cctvs:      []string{},
+					TotalCctvs: 0,
+				}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("주차면 상태 조회 실패: %v", err), common.ErrFromMysqlDB)
+			}
+			changes = spaceStateChanges(previous, states)
+			if err := d.Repository.ReplaceSpaceStates(ctx, req.ProjectID, states, runParkingIDs); err != nil {
+				return response.ResLiveLearning{
+					Cctvs:      []string{},
+					TotalCctvs: 0,
+				}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("주차면 상태 저장 실패: %v", err), common.ErrFromMysqlDB)
+			}
+
+			// 점유 이력 원본 점 추가 (같은 이미지로 다시 실행하면 관측 시각이 같아 중복 기록되지 않음)
+			if err := d.Repository.InsertOccupancySamples(ctx, buildOccupancySamplesFromStates(states)); err != nil {
+				return response.ResLiveLearning{
+					Cctvs:      []string{},
+					TotalCctvs: 0,
+				}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("점유 이력 저장 실패: %v", err), common.ErrFromMysqlDB)
+			}
+
+			// 구역 점유율 변화 (웹훅 알림에만 쓰므로 실패해도 결과는 그대로 반환)
+			zoneChanges, err = loadZoneOccupancyChanges(ctx, d.Repository, req.ProjectID, previous, states)
+			if err != nil {
+				fmt.Printf("구역 점유율 변화 계산 실패: %v\n", err)
+			}
+		}
+	}
+
+	// 구독 중인 클라이언트에 변경 사항 전달
+	misaligned := d.findMisalignedCameras(ctx, req.ProjectID)
+	publishLiveRun(req.ProjectID, success, cctvList, states, changes, len(misaligned), time.Now())
+	emitLiveRunWebhooks(req.ProjectID, changes, zoneChanges)
+
 	return response.ResLiveLearning{
 		Cctvs:      cctvList,
 		TotalCctvs: len(cctvList),
+		Results:    results,
+		Misaligned: misaligned,
 	}, nil
 }
 
+// findMisalignedCameras currentImages 중 기준 프레임에서 움직인 카메라 (검사 실패는 결과에 영향 없음)
+func (d *LiveLearningParkingUseCase) findMisalignedCameras(ctx context.Context, projectID string) []response.CameraAlignment {
+	ws, err := common.ResolveWorkspace(ctx, projectID)
+	if err != nil {
+		return nil
+	}
+	currentImagesDir, err := ws.Resolve("currentImages")
+	if err != nil {
+		return nil
+	}
+	cameras, err := checkCameraAlignment(ws, currentImagesDir, camshift.DefaultTolerance)
+	if err != nil {
+		fmt.Printf("카메라 흔들림 검사 실패: %v\n", err)
+		return nil
+	}
+	var misaligned []response.CameraAlignment
+	for _, camera := range cameras {
+		if camera.Misaligned {
+			misaligned = append(misaligned, camera)
+		}
+	}
+	return misaligned
+}
+
 // OpenCV 실행
-func (d *LiveLearningParkingUseCase) executeOpenCV(ctx context.Context, req request.ReqLiveLearning, backendDir string) (bool, string, string, interface{}) {
+func (d *LiveLearningParkingUseCase) executeOpenCV(ctx context.Context, ws common.Workspace, req request.ReqLiveLearning, backendDir string) (bool, string, string, interface{}) {
 	opencvPath := filepath.Join(backendDir, "opencv", "build", "main")
 
+	// 실시간 이미지 폴더: {workspace}/currentImages, 결과 폴더: {workspace}/liveResults
+	currentImagesDir, err := ws.Resolve("currentImages")
+	if err != nil {
+		return false, err.Error(), "", nil
+	}
+	resultDir, err := ws.Resolve("liveResults")
+	if err != nil {
+		return false, err.Error(), "", nil
+	}
+
+	// parking_id별 고정 번호(roi_id)를 적은 ROI 파일을 OpenCV에 넘김
+	detectorRoiPath, cleanup, err := prepareDetectorRoi(ctx, d.Repository.EnsureParkingSpaces, req.ProjectID, req.RoiPath)
+	if err != nil {
+		return false, err.Error(), "", nil
+	}
+	defer cleanup()
+
 	// OpenCV 실행 명령어 구성 (새로운 파라미터 순서)
 	args := []string{
-		fmt.Sprintf("%f", req.LearningRate),              // learning_rate
-		fmt.Sprintf("%d", req.Iterations),                // iterations
-		fmt.Sprintf("%f", req.VarThreshold),              // var_threshold
-		req.ProjectID,                                    // project_id
-		req.LearningPath,                                 // learning_base_path
-		"../../shared/banpo/currentImages",               // test_images_path (폴더)
-		req.RoiPath,                                      // roi_path
-		"../../shared/" + req.ProjectID + "/liveResults", // results_dir
-	}
-
-	// 명령어 실행
-	cmd := exec.Command(opencvPath, args...)
+		fmt.Sprintf("%f", req.LearningRate), // learning_rate
+		fmt.Sprintf("%d", req.Iterations),   // iterations
+		fmt.Sprintf("%f", req.VarThreshold), // var_threshold
+		req.ProjectID,                       // project_id
+		req.LearningPath,                    // learning_base_path
+		currentImagesDir,                    // test_images_path (폴더)
+		detectorRoiPath,                     // roi_path
+		resultDir,                           // results_dir
+	}
+
+	// 명령어 실행 (ctx 취소 시 프로세스 그룹 전체 종료)
+	cmd := exec.CommandContext(ctx, opencvPath, args...)
 	cmd.Dir = filepath.Join(backendDir, "opencv") // OpenCV 디렉토리를 작업 디렉토리로 설정
+	common.BindProcessGroup(cmd)
 
 	// 실행 결과 캡처
 	output, err := cmd.CombinedOutput()
@@ -110,7 +253,6 @@ func (d *LiveLearningParkingUseCase) executeOpenCV(ctx context.Context, req requ
 	}
 
 	// 결과 폴더에서 CCTV 폴더들 읽기
-	resultDir := filepath.Join("../../shared", req.ProjectID, "liveResults")
 	cctvFolders, err := d.getCctvFoldersFromResults(resultDir)
 	if err != nil {
 		return false, fmt.Sprintf("CCTV 폴더 읽기 실패: %v", err), "", nil
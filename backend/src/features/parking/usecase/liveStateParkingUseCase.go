@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/response"
+)
+
+type LiveStateParkingUseCase struct {
+	Repository     _interface.ILiveStateParkingRepository
+	ContextTimeout time.Duration
+}
+
+func NewLiveStateParkingUseCase(repo _interface.ILiveStateParkingRepository, timeout time.Duration) _interface.ILiveStateParkingUseCase {
+	return &LiveStateParkingUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// GetLiveState 실시간 학습으로 저장된 주차면별 최신 상태 (cctvID가 있으면 해당 CCTV만)
+func (d *LiveStateParkingUseCase) GetLiveState(c context.Context, projectID string, cctvID string) (response.ResLiveState, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	states, err := d.Repository.FindSpaceStates(ctx, projectID, cctvID)
+	if err != nil {
+		return response.ResLiveState{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("주차면 상태 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	return buildLiveStateResponse(projectID, states), nil
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/request"
+	"main/features/parking/model/response"
+)
+
+type OccupancyBucketsParkingUseCase struct {
+	Repository     _interface.IOccupancyBucketsParkingRepository
+	ContextTimeout time.Duration
+}
+
+func NewOccupancyBucketsParkingUseCase(repo _interface.IOccupancyBucketsParkingRepository, timeout time.Duration) _interface.IOccupancyBucketsParkingUseCase {
+	return &OccupancyBucketsParkingUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// GetOccupancyBuckets 기간 내 점유 이력을 interval 구간별로 집계
+// 5m은 원본 점만, hour/day는 시간 단위로 줄인 이력까지 합쳐 집계
+func (d *OccupancyBucketsParkingUseCase) GetOccupancyBuckets(c context.Context, projectID string, req request.ReqOccupancyHistory) (response.ResOccupancyBuckets, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	interval := req.Interval
+	if interval == "" {
+		interval = "hour"
+	}
+	if _, ok := occupancyIntervals[interval]; !ok {
+		return response.ResOccupancyBuckets{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), fmt.Sprintf("interval은 5m, hour, day 중 하나여야 합니다: %s", req.Interval), common.ErrFromClient)
+	}
+	from, to, err := parseOccupancyHistoryRange(req.From, req.To, time.Now())
+	if err != nil {
+		return response.ResOccupancyBuckets{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+	if occupancyBucketCount(from, to, interval) > maxOccupancyBuckets {
+		return response.ResOccupancyBuckets{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), fmt.Sprintf("구간이 너무 많습니다 (최대 %d개), 기간을 줄이거나 더 큰 interval을 사용하세요", maxOccupancyBuckets), common.ErrFromClient)
+	}
+
+	result := response.ResOccupancyBuckets{
+		ProjectID: projectID,
+		Interval:  interval,
+		From:      from.Format(time.RFC3339),
+		To:        to.Format(time.RFC3339),
+		Buckets:   []response.OccupancyBucket{},
+	}
+	filter, ok, err := occupancyHistoryFilter(ctx, d.Repository, projectID, req.CctvID, req.ZoneID, req.ParkingID)
+	if err != nil {
+		return response.ResOccupancyBuckets{}, err
+	}
+	if !ok {
+		return result, nil
+	}
+	filter.From, filter.To = from, to
+
+	// 원본 점은 DB에서 5분 또는 1시간 단위로 먼저 묶음
+	base := time.Hour
+	if interval == "5m" {
+		base = occupancyIntervals["5m"]
+	}
+	rows, err := d.Repository.FindOccupancySampleBuckets(ctx, filter, base)
+	if err != nil {
+		return response.ResOccupancyBuckets{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("점유 이력 집계 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	if interval != "5m" {
+		hourly, err := d.Repository.FindOccupancyHourlySamples(ctx, filter)
+		if err != nil {
+			return response.ResOccupancyBuckets{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("시간 단위 점유 이력 조회 실패: %v", err), common.ErrFromMysqlDB)
+		}
+		rows = append(rows, hourly...)
+	}
+	result.Buckets = buildOccupancyBuckets(rows, interval)
+	return result, nil
+}
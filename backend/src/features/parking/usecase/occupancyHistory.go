@@ -0,0 +1,238 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"main/common"
+	"main/common/db/mysql"
+	"main/common/zones"
+	"main/features/parking/model/entity"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/response"
+)
+
+const (
+	// 기간을 생략하면 최근 24시간
+	defaultOccupancyHistoryRange = 24 * time.Hour
+	defaultOccupancySampleLimit  = 1000
+	maxOccupancySampleLimit      = 10000
+	// 한 번에 돌려줄 수 있는 최대 구간 수 (5m 단위면 약 7일)
+	maxOccupancyBuckets = 2016
+	// 보관 정책 한 번 적용에 허용하는 시간 (원본 점이 많으면 오래 걸림)
+	occupancyRetentionTimeout = 5 * time.Minute
+)
+
+// 집계 단위 (day는 서버 시간대의 자정 기준, 나머지는 정각 기준)
+var occupancyIntervals = map[string]time.Duration{
+	"5m":   5 * time.Minute,
+	"hour": time.Hour,
+	"day":  24 * time.Hour,
+}
+
+// parseOccupancyHistoryTime RFC3339 시각 (비어 있으면 fallback)
+func parseOccupancyHistoryTime(field string, value string, fallback time.Time) (time.Time, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s는 RFC3339 형식이어야 합니다: %s", field, value)
+	}
+	return t, nil
+}
+
+// parseOccupancyHistoryRange from/to 검증 (to 생략 시 현재, from 생략 시 to의 24시간 전)
+func parseOccupancyHistoryRange(from string, to string, now time.Time) (time.Time, time.Time, error) {
+	end, err := parseOccupancyHistoryTime("to", to, now)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	start, err := parseOccupancyHistoryTime("from", from, end.Add(-defaultOccupancyHistoryRange))
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, fmt.Errorf("from은 to보다 이전이어야 합니다")
+	}
+	return start, end, nil
+}
+
+// occupancyHistoryFilter 조회 조건 생성 (zoneID가 있으면 구역의 주차면으로 좁힘, 조건에 맞는 주차면이 없으면 ok=false)
+func occupancyHistoryFilter(ctx context.Context, repo _interface.IZoneGetParkingRepository, projectID string, cctvID string, zoneID uint, parkingID string) (entity.OccupancyHistoryFilter, bool, error) {
+	filter := entity.OccupancyHistoryFilter{ProjectID: projectID, CctvID: cctvID}
+	if parkingID != "" && cctvID == "" {
+		return filter, false, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "parkingId는 cctvId와 함께 지정해야 합니다", common.ErrFromClient)
+	}
+	if zoneID == 0 {
+		if parkingID != "" {
+			filter.Spaces = []zones.Space{{CctvID: cctvID, ParkingID: parkingID}}
+		}
+		return filter, true, nil
+	}
+
+	zoneRows, err := repo.FindParkingZones(ctx, projectID)
+	if err != nil {
+		return filter, false, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("구역 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	found := false
+	for _, zone := range zoneRows {
+		if zone.ID == zoneID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return filter, false, common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), fmt.Sprintf("구역을 찾을 수 없습니다: %d", zoneID), common.ErrFromClient)
+	}
+	spaceRows, err := repo.FindParkingZoneSpaces(ctx, projectID)
+	if err != nil {
+		return filter, false, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("구역 주차면 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	for _, row := range spaceRows {
+		if row.ZoneId != zoneID || (cctvID != "" && row.CctvId != cctvID) || (parkingID != "" && row.ParkingId != parkingID) {
+			continue
+		}
+		filter.Spaces = append(filter.Spaces, zones.Space{CctvID: row.CctvId, ParkingID: row.ParkingId})
+	}
+	return filter, len(filter.Spaces) > 0, nil
+}
+
+// occupancyBucketStart t가 속한 구간의 시작 시각
+func occupancyBucketStart(t time.Time, interval string) time.Time {
+	if interval == "day" {
+		local := t.In(time.Local)
+		return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.Local)
+	}
+	return t.Truncate(occupancyIntervals[interval])
+}
+
+// occupancyBucketCount from~to를 interval로 나눈 구간 수 (요청 검증용 근사값)
+func occupancyBucketCount(from time.Time, to time.Time, interval string) int {
+	return int(to.Sub(from)/occupancyIntervals[interval]) + 1
+}
+
+// buildOccupancyBuckets 주차면별 합계를 interval 구간으로 다시 묶음 (원본 점과 시간 단위 이력을 함께 받음)
+func buildOccupancyBuckets(rows []mysql.OccupancyHourlySamples, interval string) []response.OccupancyBucket {
+	type bucket struct {
+		samples  int
+		occupied int
+		rateSum  float64
+		spaces   map[zones.Space]bool
+	}
+	buckets := make(map[time.Time]*bucket)
+	for _, row := range rows {
+		start := occupancyBucketStart(row.BucketStart, interval)
+		item, ok := buckets[start]
+		if !ok {
+			item = &bucket{spaces: make(map[zones.Space]bool)}
+			buckets[start] = item
+		}
+		item.samples += row.Samples
+		item.occupied += row.OccupiedSamples
+		item.rateSum += row.RateSum
+		item.spaces[zones.Space{CctvID: row.CctvId, ParkingID: row.ParkingId}] = true
+	}
+
+	starts := make([]time.Time, 0, len(buckets))
+	for start := range buckets {
+		starts = append(starts, start)
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i].Before(starts[j]) })
+
+	result := make([]response.OccupancyBucket, 0, len(starts))
+	for _, start := range starts {
+		item := buckets[start]
+		if item.samples == 0 {
+			continue
+		}
+		result = append(result, response.OccupancyBucket{
+			BucketStart:     start.Format(time.RFC3339),
+			Spaces:          len(item.spaces),
+			Samples:         item.samples,
+			OccupiedSamples: item.occupied,
+			OccupancyRate:   float64(item.occupied) * 100 / float64(item.samples),
+			AvgRate:         item.rateSum / float64(item.samples),
+		})
+	}
+	return result
+}
+
+// buildOccupancySamples 원본 점 응답 변환
+func buildOccupancySamples(rows []mysql.OccupancySamples) []response.OccupancySample {
+	samples := make([]response.OccupancySample, 0, len(rows))
+	for _, row := range rows {
+		samples = append(samples, response.OccupancySample{
+			CctvID:     row.CctvId,
+			ParkingID:  row.ParkingId,
+			Occupied:   row.Occupied,
+			Rate:       row.Rate,
+			ObservedAt: row.ObservedAt.Format(time.RFC3339Nano),
+		})
+	}
+	return samples
+}
+
+// buildOccupancyStateAtResponse 주차면마다 원본 점을 우선하고, 원본 점이 없으면(보관 기간 경과) 시간 단위 이력 사용
+func buildOccupancyStateAtResponse(projectID string, at time.Time, raw []mysql.OccupancySamples, hourly []mysql.OccupancyHourlySamples) response.ResOccupancyStateAt {
+	states := make(map[zones.Space]response.OccupancySpaceStateAt, len(raw)+len(hourly))
+	for _, row := range hourly {
+		if row.Samples == 0 {
+			continue
+		}
+		states[zones.Space{CctvID: row.CctvId, ParkingID: row.ParkingId}] = response.OccupancySpaceStateAt{
+			CctvID:     row.CctvId,
+			ParkingID:  row.ParkingId,
+			Occupied:   row.OccupiedSamples*2 > row.Samples,
+			Rate:       row.RateSum / float64(row.Samples),
+			ObservedAt: row.BucketStart.Format(time.RFC3339),
+			Source:     "hourly",
+		}
+	}
+	for _, row := range raw {
+		states[zones.Space{CctvID: row.CctvId, ParkingID: row.ParkingId}] = response.OccupancySpaceStateAt{
+			CctvID:     row.CctvId,
+			ParkingID:  row.ParkingId,
+			Occupied:   row.Occupied,
+			Rate:       row.Rate,
+			ObservedAt: row.ObservedAt.Format(time.RFC3339Nano),
+			Source:     "raw",
+		}
+	}
+
+	result := response.ResOccupancyStateAt{ProjectID: projectID, At: at.Format(time.RFC3339), Spaces: make([]response.OccupancySpaceStateAt, 0, len(states))}
+	for _, state := range states {
+		result.Spaces = append(result.Spaces, state)
+		if state.Occupied {
+			result.Occupied++
+		} else {
+			result.Free++
+		}
+	}
+	result.Total = len(result.Spaces)
+	sort.Slice(result.Spaces, func(i, j int) bool {
+		if result.Spaces[i].CctvID != result.Spaces[j].CctvID {
+			return result.Spaces[i].CctvID < result.Spaces[j].CctvID
+		}
+		return result.Spaces[i].ParkingID < result.Spaces[j].ParkingID
+	})
+	return result
+}
+
+// buildOccupancySamplesFromStates 이번 실시간 학습의 주차면 상태를 이력 원본 점으로
+func buildOccupancySamplesFromStates(states []mysql.SpaceState) []mysql.OccupancySamples {
+	samples := make([]mysql.OccupancySamples, 0, len(states))
+	for _, state := range states {
+		samples = append(samples, mysql.OccupancySamples{
+			ProjectId:  state.ProjectId,
+			CctvId:     state.CctvId,
+			ParkingId:  state.ParkingId,
+			Occupied:   state.Occupied,
+			Rate:       state.Rate,
+			ObservedAt: state.ObservedAt,
+		})
+	}
+	return samples
+}
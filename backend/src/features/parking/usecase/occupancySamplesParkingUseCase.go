@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"main/common"
+	"main/features/parking/model/entity"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/request"
+	"main/features/parking/model/response"
+)
+
+type OccupancySamplesParkingUseCase struct {
+	Repository     _interface.IOccupancySamplesParkingRepository
+	ContextTimeout time.Duration
+}
+
+func NewOccupancySamplesParkingUseCase(repo _interface.IOccupancySamplesParkingRepository, timeout time.Duration) _interface.IOccupancySamplesParkingUseCase {
+	return &OccupancySamplesParkingUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// GetOccupancySamples 기간 내 원본 점 (보관 기간이 지나 시간 단위로 줄인 이력은 포함하지 않음)
+func (d *OccupancySamplesParkingUseCase) GetOccupancySamples(c context.Context, projectID string, req request.ReqOccupancyHistory) (response.ResOccupancySamples, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	from, to, err := parseOccupancyHistoryRange(req.From, req.To, time.Now())
+	if err != nil {
+		return response.ResOccupancySamples{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultOccupancySampleLimit
+	}
+	if limit < 1 || limit > maxOccupancySampleLimit {
+		return response.ResOccupancySamples{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), fmt.Sprintf("limit는 1 이상 %d 이하여야 합니다: %d", maxOccupancySampleLimit, limit), common.ErrFromClient)
+	}
+
+	result := response.ResOccupancySamples{
+		ProjectID: projectID,
+		From:      from.Format(time.RFC3339),
+		To:        to.Format(time.RFC3339),
+		Samples:   []response.OccupancySample{},
+	}
+	filter, ok, err := occupancyHistoryFilter(ctx, d.Repository, projectID, req.CctvID, req.ZoneID, req.ParkingID)
+	if err != nil {
+		return response.ResOccupancySamples{}, err
+	}
+	if !ok {
+		return result, nil
+	}
+	filter.From, filter.To = from, to
+
+	// 한 개 더 읽어 limit에 걸렸는지 확인
+	rows, err := d.Repository.FindOccupancySamples(ctx, filter, limit+1)
+	if err != nil {
+		return response.ResOccupancySamples{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("점유 이력 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	if len(rows) > limit {
+		rows = rows[:limit]
+		result.Truncated = true
+	}
+	result.Samples = buildOccupancySamples(rows)
+	result.Total = len(result.Samples)
+	return result, nil
+}
+
+// StartOccupancyRetention every마다 보관 정책 적용 (every가 0 이하면 적용하지 않음)
+func (d *OccupancySamplesParkingUseCase) StartOccupancyRetention(policy entity.OccupancyRetention, every time.Duration) {
+	if every <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(every)
+		defer ticker.Stop()
+		for {
+			d.applyOccupancyRetention(policy, time.Now())
+			<-ticker.C
+		}
+	}()
+}
+
+// applyOccupancyRetention 오래된 원본 점은 시간 단위로 줄이고, 오래된 시간 단위 이력은 삭제 (실패는 로그만 남기고 다음 주기에 재시도)
+func (d *OccupancySamplesParkingUseCase) applyOccupancyRetention(policy entity.OccupancyRetention, now time.Time) {
+	ctx, cancel := context.WithTimeout(context.Background(), occupancyRetentionTimeout)
+	defer cancel()
+
+	if policy.RawDays > 0 {
+		// 한 시간 구간이 원본 점과 시간 단위 이력으로 나뉘지 않도록 정각으로 내림
+		before := now.AddDate(0, 0, -policy.RawDays).Truncate(time.Hour)
+		count, err := d.Repository.DownsampleOccupancySamples(ctx, before)
+		if err != nil {
+			fmt.Printf("점유 이력 시간 단위 변환 실패: %v\n", err)
+		} else if count > 0 {
+			fmt.Printf("점유 이력 원본 점 %d개를 시간 단위로 변환 (%s 이전)\n", count, before.Format(time.RFC3339))
+		}
+	}
+	if policy.HourlyDays > 0 {
+		before := now.AddDate(0, 0, -policy.HourlyDays).Truncate(time.Hour)
+		count, err := d.Repository.DeleteOccupancyHourlySamples(ctx, before)
+		if err != nil {
+			fmt.Printf("시간 단위 점유 이력 삭제 실패: %v\n", err)
+		} else if count > 0 {
+			fmt.Printf("시간 단위 점유 이력 %d개 삭제 (%s 이전)\n", count, before.Format(time.RFC3339))
+		}
+	}
+}
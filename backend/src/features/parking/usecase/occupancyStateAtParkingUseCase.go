@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/request"
+	"main/features/parking/model/response"
+)
+
+type OccupancyStateAtParkingUseCase struct {
+	Repository     _interface.IOccupancyStateAtParkingRepository
+	ContextTimeout time.Duration
+}
+
+func NewOccupancyStateAtParkingUseCase(repo _interface.IOccupancyStateAtParkingRepository, timeout time.Duration) _interface.IOccupancyStateAtParkingUseCase {
+	return &OccupancyStateAtParkingUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// GetOccupancyStateAt at 시각에 주차면마다 마지막으로 관측된 상태 (at 생략 시 현재)
+func (d *OccupancyStateAtParkingUseCase) GetOccupancyStateAt(c context.Context, projectID string, req request.ReqOccupancyStateAt) (response.ResOccupancyStateAt, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	at, err := parseOccupancyHistoryTime("at", req.At, time.Now())
+	if err != nil {
+		return response.ResOccupancyStateAt{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+	filter, ok, err := occupancyHistoryFilter(ctx, d.Repository, projectID, req.CctvID, req.ZoneID, req.ParkingID)
+	if err != nil {
+		return response.ResOccupancyStateAt{}, err
+	}
+	if !ok {
+		return buildOccupancyStateAtResponse(projectID, at, nil, nil), nil
+	}
+
+	raw, err := d.Repository.FindOccupancySamplesAt(ctx, filter, at)
+	if err != nil {
+		return response.ResOccupancyStateAt{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("점유 이력 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	hourly, err := d.Repository.FindOccupancyHourlySamplesAt(ctx, filter, at)
+	if err != nil {
+		return response.ResOccupancyStateAt{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("시간 단위 점유 이력 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	return buildOccupancyStateAtResponse(projectID, at, raw, hourly), nil
+}
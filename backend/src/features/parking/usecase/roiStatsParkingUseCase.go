@@ -24,10 +24,15 @@ func (d *RoiStatsParkingUseCase) GetRoiStats(c context.Context, projectID string
 	_, cancel := context.WithTimeout(c, d.ContextTimeout)
 	defer cancel()
 
-	// 저장 경로 설정
-	uploadPath := common.Env.UploadPath
-	projectPath := filepath.Join(uploadPath, projectID)
-	targetPath := filepath.Join(projectPath, "uploads", "roi")
+	// 저장 경로 설정 (작업 폴더 기준)
+	ws, err := common.ResolveWorkspace(c, projectID)
+	if err != nil {
+		return response.ResRoiStats{}, err
+	}
+	targetPath, err := ws.Resolve("uploads", "roi")
+	if err != nil {
+		return response.ResRoiStats{}, err
+	}
 
 	// 디렉토리가 존재하는지 확인
 	if _, err := os.Stat(targetPath); os.IsNotExist(err) {
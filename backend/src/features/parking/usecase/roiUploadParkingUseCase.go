@@ -3,12 +3,15 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"io"
 	"main/common"
+	"main/common/roidoc"
 	_interface "main/features/parking/model/interface"
 	"main/features/parking/model/response"
 	"mime/multipart"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 )
 
@@ -25,10 +28,15 @@ func (d *RoiUploadParkingUseCase) RoiUpload(c context.Context, projectID string,
 	_, cancel := context.WithTimeout(c, d.ContextTimeout)
 	defer cancel()
 
-	// 저장 경로 설정
-	uploadPath := common.Env.UploadPath
-	projectPath := filepath.Join(uploadPath, projectID)
-	targetPath := filepath.Join(projectPath, "uploads", "roi")
+	// 저장 경로 설정 (작업 폴더 기준)
+	ws, err := common.ResolveWorkspace(c, projectID)
+	if err != nil {
+		return response.ResRoiUpload{}, err
+	}
+	targetPath, err := ws.Resolve("uploads", "roi")
+	if err != nil {
+		return response.ResRoiUpload{}, err
+	}
 
 	// 폴더 생성
 	if err := os.MkdirAll(targetPath, 0755); err != nil {
@@ -42,11 +50,25 @@ func (d *RoiUploadParkingUseCase) RoiUpload(c context.Context, projectID string,
 		// 파일명 그대로 사용 (원본 파일명 유지)
 		fileName := file.Filename
 
+		// 파일명은 경로 한 칸으로만 허용
+		if err := common.ValidatePathSegment(fileName); err != nil {
+			errorMsg := fmt.Sprintf("잘못된 파일명: %s - %v", fileName, err)
+			errors = append(errors, errorMsg)
+			continue
+		}
+
+		// ROI 파일 구조 검증 (OpenCV가 읽지 못하는 파일은 저장하지 않음)
+		data, err := readRoiUpload(file)
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("ROI 파일 검증 실패: %s - %v", fileName, err))
+			continue
+		}
+
 		// 최종 저장 경로 (파일명 그대로)
 		finalPath := filepath.Join(targetPath, fileName)
 
 		// 파일 저장
-		if err := saveUploadedFile(file, finalPath); err != nil {
+		if err := os.WriteFile(finalPath, data, 0644); err != nil {
 			errorMsg := fmt.Sprintf("파일 저장 실패: %s - %v", fileName, err)
 			errors = append(errors, errorMsg)
 			continue
@@ -59,5 +81,28 @@ func (d *RoiUploadParkingUseCase) RoiUpload(c context.Context, projectID string,
 		TotalFiles: len(files),
 		Success:    savedCount,
 		Failed:     len(errors),
+		Errors:     errors,
 	}, nil
 }
+
+// readRoiUpload 업로드된 ROI 파일을 읽고 구조 검증 (검증을 통과한 원본 그대로 반환)
+func readRoiUpload(file *multipart.FileHeader) ([]byte, error) {
+	if !strings.EqualFold(filepath.Ext(file.Filename), ".json") {
+		return nil, fmt.Errorf("JSON 파일만 업로드할 수 있습니다")
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	data, err := io.ReadAll(src)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := roidoc.Parse(data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"main/common"
+	"main/common/db/mysql"
+	"main/common/roidoc"
+	"main/common/spaces"
+)
+
+// loadParkingSpaces 프로젝트의 parking_id ↔ ROI 번호 대응 조회
+func loadParkingSpaces(ctx context.Context, find func(ctx context.Context, projectID string) ([]mysql.ParkingSpaces, error), projectID string) (spaces.Index, error) {
+	rows, err := find(ctx, projectID)
+	if err != nil {
+		return spaces.Index{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("주차면 번호 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	return spaces.NewIndex(rows), nil
+}
+
+// prepareDetectorRoi ROI 파일의 parking_id마다 고정 번호를 정해 roi_id로 적은 OpenCV용 임시 ROI 파일 생성
+// OpenCV는 roi_id를 결과의 ROI 번호로 그대로 씀 (반환한 함수로 임시 파일 삭제)
+func prepareDetectorRoi(ctx context.Context, ensure func(ctx context.Context, projectID string, parkingIDs map[string][]string) ([]mysql.ParkingSpaces, error), projectID string, roiPath string) (string, func(), error) {
+	doc, err := roidoc.Load(roiPath)
+	if err != nil {
+		return "", nil, fmt.Errorf("ROI 파일 읽기 실패: %v", err)
+	}
+
+	rows, err := ensure(ctx, projectID, roiParkingIDs(doc))
+	if err != nil {
+		return "", nil, fmt.Errorf("주차면 번호 저장 실패: %v", err)
+	}
+	index := spaces.NewIndex(rows)
+	for _, camera := range doc.Cameras {
+		for _, match := range camera.Matches {
+			if roiID, ok := index.RoiID(camera.CctvID, match.ParkingID.Value); ok {
+				match.SetRoiID(roiID)
+			}
+		}
+	}
+
+	data, err := roidoc.Marshal(doc)
+	if err != nil {
+		return "", nil, fmt.Errorf("ROI 파일 생성 실패: %v", err)
+	}
+	tmp, err := os.CreateTemp("", "roi-*.json")
+	if err != nil {
+		return "", nil, fmt.Errorf("ROI 파일 생성 실패: %v", err)
+	}
+	cleanup := func() { os.Remove(tmp.Name()) }
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		cleanup()
+		return "", nil, fmt.Errorf("ROI 파일 생성 실패: %v", err)
+	}
+	if err := tmp.Close(); err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("ROI 파일 생성 실패: %v", err)
+	}
+	return tmp.Name(), cleanup, nil
+}
+
+// legacyParkingIDs 작업 폴더 uploads/roi의 ROI 파일(초안 제외)에 있는 CCTV별 parking_id
+// 읽을 수 없는 파일은 건너뜀
+func legacyParkingIDs(projectID string) (map[string][]string, error) {
+	ws, err := common.NewWorkspace(projectID)
+	if err != nil {
+		return nil, err
+	}
+	roiDir, err := ws.Resolve("uploads", "roi")
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(roiDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".json") {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	parkingIDs := map[string][]string{}
+	for _, name := range names {
+		doc, err := roidoc.Load(filepath.Join(roiDir, name))
+		if err != nil {
+			continue
+		}
+		for cctvID, ids := range roiParkingIDs(doc) {
+			parkingIDs[cctvID] = append(parkingIDs[cctvID], ids...)
+		}
+	}
+	return parkingIDs, nil
+}
+
+// roiParkingIDs ROI 문서의 CCTV ID -> parking_id 목록
+func roiParkingIDs(doc *roidoc.Document) map[string][]string {
+	parkingIDs := map[string][]string{}
+	for _, camera := range doc.Cameras {
+		for _, match := range camera.Matches {
+			parkingIDs[camera.CctvID] = append(parkingIDs[camera.CctvID], match.ParkingID.Value)
+		}
+	}
+	return parkingIDs
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"main/common/db/mysql"
+	"main/features/parking/model/entity"
+	"main/features/parking/model/response"
+)
+
+// buildSpaceStates 실시간 학습 결과를 주차면 상태 행으로 변환 (parking_id 대응이 없는 ROI는 제외)
+// 관측 시각은 판정에 쓴 currentImages 이미지의 수정 시각, 이미지를 찾지 못하면 finishedAt
+func buildSpaceStates(projectID string, result entity.ExperimentResult, cctvs []response.CctvOccupancy, currentImagesDir string, finishedAt time.Time) []mysql.SpaceState {
+	type source struct {
+		image      string
+		observedAt time.Time
+	}
+	sources := make(map[string]source, len(result.Results))
+	for _, cctvResult := range result.Results {
+		item := source{image: filepath.Base(cctvResult.TestImage), observedAt: finishedAt}
+		if cctvResult.TestImage == "" {
+			item.image = ""
+		} else if info, err := os.Stat(filepath.Join(currentImagesDir, item.image)); err == nil {
+			item.observedAt = info.ModTime()
+		}
+		sources[cctvResult.CctvID] = item
+	}
+
+	var states []mysql.SpaceState
+	for _, cctv := range cctvs {
+		item, ok := sources[cctv.CctvID]
+		if !ok {
+			item = source{observedAt: finishedAt}
+		}
+		for _, roi := range cctv.Rois {
+			if roi.ParkingID == "" {
+				continue
+			}
+			states = append(states, mysql.SpaceState{
+				ProjectId:   projectID,
+				CctvId:      cctv.CctvID,
+				ParkingId:   roi.ParkingID,
+				RoiId:       roi.RoiID,
+				Occupied:    roi.Occupied,
+				Rate:        roi.Rate,
+				Threshold:   roi.Threshold,
+				SourceImage: item.image,
+				ObservedAt:  item.observedAt,
+			})
+		}
+	}
+	return states
+}
+
+// buildLiveStateResponse 저장된 주차면 상태를 CCTV별로 묶어 응답 생성 (행은 cctv_id, roi_id 순)
+func buildLiveStateResponse(projectID string, states []mysql.SpaceState) response.ResLiveState {
+	result := response.ResLiveState{ProjectID: projectID, Cctvs: []response.LiveCctvState{}}
+	var latest, cctvLatest time.Time
+	for _, state := range states {
+		if n := len(result.Cctvs); n == 0 || result.Cctvs[n-1].CctvID != state.CctvId {
+			result.Cctvs = append(result.Cctvs, response.LiveCctvState{CctvID: state.CctvId, Spaces: []response.LiveSpaceState{}})
+			cctvLatest = time.Time{}
+		}
+		cctv := &result.Cctvs[len(result.Cctvs)-1]
+		cctv.Spaces = append(cctv.Spaces, response.LiveSpaceState{
+			ParkingID:   state.ParkingId,
+			RoiID:       state.RoiId,
+			Occupied:    state.Occupied,
+			Rate:        state.Rate,
+			Threshold:   state.Threshold,
+			SourceImage: state.SourceImage,
+			ObservedAt:  state.ObservedAt.Format(time.RFC3339),
+		})
+		// CCTV의 관측 이미지는 가장 최근에 관측된 주차면 기준
+		if cctv.ObservedAt == "" || state.ObservedAt.After(cctvLatest) {
+			cctvLatest = state.ObservedAt
+			cctv.SourceImage = state.SourceImage
+			cctv.ObservedAt = state.ObservedAt.Format(time.RFC3339)
+		}
+		if state.Occupied {
+			cctv.Occupied++
+			result.Occupied++
+		} else {
+			cctv.Free++
+			result.Free++
+		}
+		result.Total++
+		if state.ObservedAt.After(latest) {
+			latest = state.ObservedAt
+		}
+	}
+	if !latest.IsZero() {
+		updatedAt := latest.Format(time.RFC3339)
+		result.UpdatedAt = &updatedAt
+	}
+	return result
+}
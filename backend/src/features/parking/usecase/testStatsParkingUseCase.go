@@ -23,10 +23,15 @@ func (d *TestStatsParkingUseCase) GetTestStats(c context.Context, projectID stri
 	_, cancel := context.WithTimeout(c, d.ContextTimeout)
 	defer cancel()
 
-	// 저장 경로 설정
-	uploadPath := common.Env.UploadPath
-	projectPath := filepath.Join(uploadPath, projectID)
-	targetPath := filepath.Join(projectPath, "uploads", "testImages")
+	// 저장 경로 설정 (작업 폴더 기준)
+	ws, err := common.ResolveWorkspace(c, projectID)
+	if err != nil {
+		return response.ResTestStats{}, err
+	}
+	targetPath, err := ws.Resolve("uploads", "testImages")
+	if err != nil {
+		return response.ResTestStats{}, err
+	}
 
 	var folders []response.FolderInfo
 	total := 0
@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"main/common"
+	"main/common/db/mysql"
+	"main/common/occupancy"
+	"main/common/spaces"
+	"main/features/parking/model/entity"
+	"main/features/parking/model/response"
+)
+
+// CCTV ID 최대 길이 (occupancy_thresholds.cctv_id)
+const maxThresholdCctvIDLength = 100
+
+// thresholdScope cctvID/roiID 조합으로 기준 범위 결정
+func thresholdScope(cctvID string, roiID *int) (string, error) {
+	switch {
+	case cctvID == "" && roiID != nil:
+		return "", fmt.Errorf("roiId를 지정하려면 cctvId가 필요합니다")
+	case len(cctvID) > maxThresholdCctvIDLength:
+		return "", fmt.Errorf("cctvId는 %d자 이하여야 합니다", maxThresholdCctvIDLength)
+	case cctvID == "":
+		return mysql.ThresholdScopeProject, nil
+	case roiID == nil:
+		return mysql.ThresholdScopeCctv, nil
+	default:
+		return mysql.ThresholdScopeRoi, nil
+	}
+}
+
+// thresholdRoiID parkingId가 있으면 주차면 번호 대응으로 ROI 번호를 찾음 (roiId와 함께 쓸 수 없음)
+func thresholdRoiID(index spaces.Index, cctvID string, roiID *int, parkingID string) (*int, error) {
+	switch {
+	case parkingID == "":
+		return roiID, nil
+	case roiID != nil:
+		return nil, fmt.Errorf("roiId와 parkingId는 함께 지정할 수 없습니다")
+	}
+	id, ok := index.RoiID(cctvID, parkingID)
+	if !ok {
+		return nil, fmt.Errorf("parking_id %s의 ROI 번호를 찾을 수 없습니다", parkingID)
+	}
+	return &id, nil
+}
+
+// buildThresholdsResponse 저장된 기준을 CCTV별로 묶어 응답 생성
+func buildThresholdsResponse(projectID string, rows []mysql.OccupancyThresholds, index spaces.Index) response.ResThresholds {
+	thresholds := occupancy.NewThresholds(rows)
+	result := response.ResThresholds{
+		ProjectID:        projectID,
+		DefaultThreshold: occupancy.DefaultThreshold,
+		ProjectThreshold: thresholds.Project,
+		Cctvs:            []response.CctvThreshold{},
+	}
+
+	cctvs := make(map[string]*response.CctvThreshold)
+	cctv := func(cctvID string) *response.CctvThreshold {
+		if item, ok := cctvs[cctvID]; ok {
+			return item
+		}
+		item := &response.CctvThreshold{CctvID: cctvID, Rois: []response.RoiThreshold{}}
+		cctvs[cctvID] = item
+		return item
+	}
+	for _, row := range rows {
+		switch row.Scope {
+		case mysql.ThresholdScopeProject:
+			result.ProjectOverridden = true
+		case mysql.ThresholdScopeCctv:
+			threshold := row.Threshold
+			cctv(row.CctvId).Threshold = &threshold
+		case mysql.ThresholdScopeRoi:
+			item := cctv(row.CctvId)
+			item.Rois = append(item.Rois, response.RoiThreshold{RoiID: row.RoiId, ParkingID: index.ParkingID(row.CctvId, row.RoiId), Threshold: row.Threshold})
+		}
+	}
+
+	for _, item := range cctvs {
+		sort.Slice(item.Rois, func(i, j int) bool { return item.Rois[i].RoiID < item.Rois[j].RoiID })
+		result.Cctvs = append(result.Cctvs, *item)
+	}
+	sort.Slice(result.Cctvs, func(i, j int) bool { return result.Cctvs[i].CctvID < result.Cctvs[j].CctvID })
+	return result
+}
+
+// buildCctvOccupancy ROI 번호 순으로 전경 비율, 적용 기준, 점유 여부 정리
+func buildCctvOccupancy(cctvID string, rates map[int]float64, thresholds occupancy.Thresholds, index spaces.Index) response.CctvOccupancy {
+	roiIDs := make([]int, 0, len(rates))
+	for roiID := range rates {
+		roiIDs = append(roiIDs, roiID)
+	}
+	sort.Ints(roiIDs)
+
+	rois := make([]response.RoiOccupancy, 0, len(roiIDs))
+	for _, roiID := range roiIDs {
+		occupied, threshold := thresholds.Occupied(cctvID, roiID, rates[roiID])
+		rois = append(rois, response.RoiOccupancy{
+			RoiID:     roiID,
+			ParkingID: index.ParkingID(cctvID, roiID),
+			Rate:      rates[roiID],
+			Threshold: threshold,
+			Occupied:  occupied,
+		})
+	}
+	return response.CctvOccupancy{CctvID: cctvID, Rois: rois}
+}
+
+// buildResultOccupancy OpenCV 결과(JSON)의 CCTV별 ROI 점유 여부
+func buildResultOccupancy(result entity.ExperimentResult, thresholds occupancy.Thresholds, index spaces.Index) []response.CctvOccupancy {
+	cctvs := make([]response.CctvOccupancy, 0, len(result.Results))
+	for _, cctvResult := range result.Results {
+		rates := make(map[int]float64, len(cctvResult.RoiResults))
+		for _, roiResult := range cctvResult.RoiResults {
+			rates[roiResult.RoiID] = roiResult.ForegroundRatio
+		}
+		cctvs = append(cctvs, buildCctvOccupancy(cctvResult.CctvID, rates, thresholds, index))
+	}
+	sort.Slice(cctvs, func(i, j int) bool { return cctvs[i].CctvID < cctvs[j].CctvID })
+	return cctvs
+}
+
+// readOpenCVResult OpenCV 출력의 JSON_FILE:{경로} 줄에서 결과 파일을 찾아 읽음
+func readOpenCVResult(output string) (entity.ExperimentResult, error) {
+	var jsonFilename string
+	for _, line := range strings.Split(output, "\n") {
+		if path, ok := strings.CutPrefix(strings.TrimSpace(line), "JSON_FILE:"); ok {
+			jsonFilename = strings.TrimSpace(path)
+		}
+	}
+	if jsonFilename == "" {
+		return entity.ExperimentResult{}, fmt.Errorf("JSON 파일명을 찾을 수 없습니다")
+	}
+	return readResultFile(jsonFilename)
+}
+
+// readResultFile OpenCV 결과 JSON 파일 읽기
+func readResultFile(jsonFilename string) (entity.ExperimentResult, error) {
+	data, err := os.ReadFile(jsonFilename)
+	if err != nil {
+		return entity.ExperimentResult{}, fmt.Errorf("JSON 파일 읽기 실패: %v", err)
+	}
+	var result entity.ExperimentResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return entity.ExperimentResult{}, fmt.Errorf("JSON 파싱 실패: %v", err)
+	}
+	return result, nil
+}
+
+// loadOccupancyThresholds 프로젝트에 저장된 점유 판정 기준 조회
+func loadOccupancyThresholds(ctx context.Context, find func(ctx context.Context, projectID string) ([]mysql.OccupancyThresholds, error), projectID string) (occupancy.Thresholds, error) {
+	rows, err := find(ctx, projectID)
+	if err != nil {
+		return occupancy.Thresholds{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("점유 판정 기준 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	return occupancy.NewThresholds(rows), nil
+}
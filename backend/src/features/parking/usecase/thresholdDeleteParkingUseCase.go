@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/response"
+)
+
+type ThresholdDeleteParkingUseCase struct {
+	Repository     _interface.IThresholdDeleteParkingRepository
+	ContextTimeout time.Duration
+}
+
+func NewThresholdDeleteParkingUseCase(repo _interface.IThresholdDeleteParkingRepository, timeout time.Duration) _interface.IThresholdDeleteParkingUseCase {
+	return &ThresholdDeleteParkingUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// DeleteThreshold 기준 삭제 (상위 기준이 적용됨, 프로젝트 기준을 지우면 기본값)
+func (d *ThresholdDeleteParkingUseCase) DeleteThreshold(c context.Context, projectID string, cctvID string, roiID *int, parkingID string) (response.ResThresholds, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	index, err := loadParkingSpaces(ctx, d.Repository.FindParkingSpaces, projectID)
+	if err != nil {
+		return response.ResThresholds{}, err
+	}
+	roiID, err = thresholdRoiID(index, cctvID, roiID, parkingID)
+	if err != nil {
+		return response.ResThresholds{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+	scope, err := thresholdScope(cctvID, roiID)
+	if err != nil {
+		return response.ResThresholds{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+	id := 0
+	if roiID != nil {
+		id = *roiID
+	}
+
+	deleted, err := d.Repository.DeleteOccupancyThreshold(ctx, projectID, scope, cctvID, id)
+	if err != nil {
+		return response.ResThresholds{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("점유 판정 기준 삭제 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	if deleted == 0 {
+		return response.ResThresholds{}, common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), "저장된 점유 판정 기준이 없습니다", common.ErrFromClient)
+	}
+
+	rows, err := d.Repository.FindOccupancyThresholds(ctx, projectID)
+	if err != nil {
+		return response.ResThresholds{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("점유 판정 기준 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	return buildThresholdsResponse(projectID, rows, index), nil
+}
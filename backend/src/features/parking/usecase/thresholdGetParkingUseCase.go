@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/response"
+)
+
+type ThresholdGetParkingUseCase struct {
+	Repository     _interface.IThresholdGetParkingRepository
+	ContextTimeout time.Duration
+}
+
+func NewThresholdGetParkingUseCase(repo _interface.IThresholdGetParkingRepository, timeout time.Duration) _interface.IThresholdGetParkingUseCase {
+	return &ThresholdGetParkingUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+func (d *ThresholdGetParkingUseCase) GetThresholds(c context.Context, projectID string) (response.ResThresholds, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	rows, err := d.Repository.FindOccupancyThresholds(ctx, projectID)
+	if err != nil {
+		return response.ResThresholds{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("점유 판정 기준 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	index, err := loadParkingSpaces(ctx, d.Repository.FindParkingSpaces, projectID)
+	if err != nil {
+		return response.ResThresholds{}, err
+	}
+	return buildThresholdsResponse(projectID, rows, index), nil
+}
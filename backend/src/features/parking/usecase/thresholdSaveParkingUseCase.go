@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"main/common"
+	"main/common/db/mysql"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/request"
+	"main/features/parking/model/response"
+)
+
+type ThresholdSaveParkingUseCase struct {
+	Repository     _interface.IThresholdSaveParkingRepository
+	ContextTimeout time.Duration
+}
+
+func NewThresholdSaveParkingUseCase(repo _interface.IThresholdSaveParkingRepository, timeout time.Duration) _interface.IThresholdSaveParkingUseCase {
+	return &ThresholdSaveParkingUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// SaveThreshold 프로젝트/CCTV/parking_id 기준 저장 (이미 있으면 값 갱신)
+func (d *ThresholdSaveParkingUseCase) SaveThreshold(c context.Context, projectID string, req request.ReqSaveThreshold) (response.ResThresholds, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	index, err := loadParkingSpaces(ctx, d.Repository.FindParkingSpaces, projectID)
+	if err != nil {
+		return response.ResThresholds{}, err
+	}
+	roiID, err := thresholdRoiID(index, req.CctvID, req.RoiID, req.ParkingID)
+	if err != nil {
+		return response.ResThresholds{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+	scope, err := thresholdScope(req.CctvID, roiID)
+	if err != nil {
+		return response.ResThresholds{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+	if !isValidThreshold(req.Threshold) {
+		return response.ResThresholds{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), fmt.Sprintf("threshold는 0보다 크고 1 이하여야 합니다: %v", req.Threshold), common.ErrFromClient)
+	}
+
+	threshold := mysql.OccupancyThresholds{
+		ProjectId: projectID,
+		Scope:     scope,
+		CctvId:    req.CctvID,
+		Threshold: req.Threshold,
+	}
+	if roiID != nil {
+		threshold.RoiId = *roiID
+	}
+	if err := d.Repository.UpsertOccupancyThreshold(ctx, threshold); err != nil {
+		return response.ResThresholds{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("점유 판정 기준 저장 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	rows, err := d.Repository.FindOccupancyThresholds(ctx, projectID)
+	if err != nil {
+		return response.ResThresholds{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("점유 판정 기준 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	return buildThresholdsResponse(projectID, rows, index), nil
+}
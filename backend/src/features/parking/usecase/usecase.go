@@ -1,6 +1,8 @@
 package usecase
 
 import (
+	"bufio"
+	"bytes"
 	"fmt"
 	"io"
 	"io/fs"
@@ -12,6 +14,7 @@ import (
 	"path/filepath"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/labstack/echo/v4"
 	"golang.org/x/crypto/ssh"
@@ -181,50 +184,84 @@ func ValidateLiveLearningRequest(req request.ReqLiveLearning) error {
 	return nil
 }
 
+// scanLines 출력을 한 줄씩 읽어 buf에 모으고 onLine 호출
+func scanLines(r io.Reader, buf *bytes.Buffer, onLine func(line string)) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+		onLine(line)
+	}
+	// 너무 긴 줄 등으로 읽기가 멈춰도 프로세스가 막히지 않도록 나머지는 버림
+	io.Copy(io.Discard, r)
+}
+
+// tailOutput 출력의 마지막 max 바이트만 남김 (UTF-8 문자 경계 유지)
+func tailOutput(output string, max int) string {
+	if len(output) <= max {
+		return output
+	}
+	start := len(output) - max
+	for start < len(output) && !utf8.RuneStart(output[start]) {
+		start++
+	}
+	return output[start:]
+}
+
 func getCurrentTimestamp() string {
 	return time.Now().Format("20060102150405")
 }
 
-// buildFullPaths 폴더명/파일명을 전체 경로로 변환하는 함수
-func buildFullPaths(req request.ReqLearning) request.ReqLearning {
-	// UPLOAD_PATH 환경 변수 사용
-	basePath := common.Env.UploadPath
-	projectPath := filepath.Join(basePath, req.ProjectID)
-
-	// 학습 이미지 경로: {UPLOAD_PATH}/{projectId}/learningImages/{folderName}
-	learningPath := filepath.Join(projectPath, "uploads", "learningImages", req.LearningPath)
+// buildFullPaths 폴더명/파일명을 작업 폴더 기준 전체 경로로 변환하는 함수
+func buildFullPaths(ws common.Workspace, req request.ReqLearning) (request.ReqLearning, error) {
+	// 학습 이미지 경로: {workspace}/uploads/learningImages/{folderName}
+	learningPath, err := ws.Resolve("uploads", "learningImages", req.LearningPath)
+	if err != nil {
+		return request.ReqLearning{}, err
+	}
 
-	// 테스트 이미지 경로: {UPLOAD_PATH}/{projectId}/testImages/{folderName}
-	testPath := filepath.Join(projectPath, "uploads", "testImages", req.TestPath)
+	// 테스트 이미지 경로: {workspace}/uploads/testImages/{folderName}
+	testPath, err := ws.Resolve("uploads", "testImages", req.TestPath)
+	if err != nil {
+		return request.ReqLearning{}, err
+	}
 
-	// ROI 파일 경로: {UPLOAD_PATH}/{projectId}/roi/{fileName}
-	roiPath := filepath.Join(projectPath, "uploads", "roi", req.RoiPath)
+	// ROI 파일 경로: {workspace}/uploads/roi/{fileName}
+	roiPath, err := ws.Resolve("uploads", "roi", req.RoiPath)
+	if err != nil {
+		return request.ReqLearning{}, err
+	}
 
 	// 새로운 요청 객체 생성 (전체 경로로 변환)
 	return request.ReqLearning{
-		ProjectID:    req.ProjectID,
+		ProjectID:    ws.ProjectID,
 		LearningRate: req.LearningRate,
 		Iterations:   req.Iterations,
 		VarThreshold: req.VarThreshold,
 		LearningPath: learningPath,
 		TestPath:     testPath,
 		RoiPath:      roiPath,
-	}
+	}, nil
 }
 
-func liveBuildFullPaths(req request.ReqLiveLearning) request.ReqLiveLearning {
-	basePath := common.Env.UploadPath
-	projectPath := filepath.Join(basePath, req.ProjectID)
-
-	learningPath := filepath.Join(projectPath, "uploads", "learningImages", req.LearningPath)
-	roiPath := filepath.Join(projectPath, "uploads", "roi", req.RoiPath)
+func liveBuildFullPaths(ws common.Workspace, req request.ReqLiveLearning) (request.ReqLiveLearning, error) {
+	learningPath, err := ws.Resolve("uploads", "learningImages", req.LearningPath)
+	if err != nil {
+		return request.ReqLiveLearning{}, err
+	}
+	roiPath, err := ws.Resolve("uploads", "roi", req.RoiPath)
+	if err != nil {
+		return request.ReqLiveLearning{}, err
+	}
 
 	return request.ReqLiveLearning{
-		ProjectID:    req.ProjectID,
+		ProjectID:    ws.ProjectID,
 		LearningRate: req.LearningRate,
 		Iterations:   req.Iterations,
 		VarThreshold: req.VarThreshold,
 		LearningPath: learningPath,
 		RoiPath:      roiPath,
-	}
+	}, nil
 }
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+
+	"main/common/db/mysql"
+	"main/common/webhook"
+	"main/common/zones"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/response"
+)
+
+// spaceStateStatus 주차면 상태로 구역 집계용 상태 함수 생성 (뒤 목록이 앞 목록을 덮어씀, 상태가 없으면 Unknown)
+func spaceStateStatus(lists ...[]mysql.SpaceState) func(zones.Space) zones.Status {
+	status := make(map[zones.Space]zones.Status)
+	for _, states := range lists {
+		for _, state := range states {
+			space := zones.Space{CctvID: state.CctvId, ParkingID: state.ParkingId}
+			if state.Occupied {
+				status[space] = zones.StatusOccupied
+			} else {
+				status[space] = zones.StatusFree
+			}
+		}
+	}
+	return func(space zones.Space) zones.Status {
+		return status[space]
+	}
+}
+
+// zoneOccupancyChanges 실시간 학습 전후 점유율이 달라진 구역
+// 프로젝트 전체(ZoneID 0)는 구역 지정 여부와 관계없이 상태가 있는 모든 주차면 기준
+func zoneOccupancyChanges(zoneRows []mysql.ParkingZones, spaceRows []mysql.ParkingZoneSpaces, previous []mysql.SpaceState, current []mysql.SpaceState) []webhook.ZoneOccupancy {
+	var changes []webhook.ZoneOccupancy
+	add := func(change webhook.ZoneOccupancy, before zones.Counts, after zones.Counts) {
+		change.Capacity = after.Capacity
+		change.Occupied = after.Occupied
+		change.Free = after.Free
+		change.Unknown = after.Unknown
+		change.OccupancyRate = after.OccupancyRate()
+		change.PreviousRate = before.OccupancyRate()
+		if change.OccupancyRate != change.PreviousRate {
+			changes = append(changes, change)
+		}
+	}
+
+	var projectBefore, projectAfter zones.Counts
+	merged := make(map[zones.Space]bool, len(previous)+len(current))
+	for _, state := range previous {
+		merged[zones.Space{CctvID: state.CctvId, ParkingID: state.ParkingId}] = state.Occupied
+		if state.Occupied {
+			projectBefore.Occupied++
+		} else {
+			projectBefore.Free++
+		}
+	}
+	for _, state := range current {
+		merged[zones.Space{CctvID: state.CctvId, ParkingID: state.ParkingId}] = state.Occupied
+	}
+	for _, occupied := range merged {
+		if occupied {
+			projectAfter.Occupied++
+		} else {
+			projectAfter.Free++
+		}
+	}
+	projectAfter.Capacity = len(merged)
+	add(webhook.ZoneOccupancy{}, projectBefore, projectAfter)
+
+	// 같은 구역 목록으로 만든 계층이라 단계별 순서가 같음
+	before := zones.Build(zoneRows, spaceRows, spaceStateStatus(previous))
+	after := zones.Build(zoneRows, spaceRows, spaceStateStatus(previous, current))
+	for i, lot := range after.Lots {
+		for j, floor := range lot.Floors {
+			for k, zone := range floor.Zones {
+				add(webhook.ZoneOccupancy{ZoneID: zone.ID, Lot: lot.Name, Floor: floor.Name, Zone: zone.Name}, before.Lots[i].Floors[j].Zones[k].Counts, zone.Counts)
+			}
+		}
+	}
+	return changes
+}
+
+// loadZoneOccupancyChanges 구역을 읽어 실시간 학습 전후 점유율 변화 계산
+func loadZoneOccupancyChanges(ctx context.Context, repo _interface.IZoneGetParkingRepository, projectID string, previous []mysql.SpaceState, current []mysql.SpaceState) ([]webhook.ZoneOccupancy, error) {
+	zoneRows, err := repo.FindParkingZones(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	spaceRows, err := repo.FindParkingZoneSpaces(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return zoneOccupancyChanges(zoneRows, spaceRows, previous, current), nil
+}
+
+// emitLiveRunWebhooks 실시간 학습 한 번의 웹훅 이벤트 발행 (처음 관측된 주차면은 변경으로 보지 않음)
+func emitLiveRunWebhooks(projectID string, changes []response.LiveSpaceChange, zoneChanges []webhook.ZoneOccupancy) {
+	for _, change := range changes {
+		if change.Previous != nil {
+			webhook.Emit(projectID, webhook.EventSpaceChanged, change)
+		}
+	}
+	for _, change := range zoneChanges {
+		webhook.Emit(projectID, webhook.EventZoneThreshold, change)
+	}
+}
@@ -0,0 +1,300 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"main/common"
+	"main/common/db/mysql"
+	"main/common/occupancy"
+	"main/common/roidoc"
+	"main/common/spaces"
+	"main/common/zones"
+	"main/features/parking/model/entity"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/request"
+	"main/features/parking/model/response"
+)
+
+// 최대 이름 규칙 수 (규칙은 주차면마다 순서대로 맞춰 봄)
+const maxZoneRules = 100
+
+// loadZoneTree 저장된 구역과 주차면으로 구역 계층 생성 (status가 nil이면 수용 대수만)
+func loadZoneTree(ctx context.Context, repo _interface.IZoneGetParkingRepository, projectID string, status func(zones.Space) zones.Status) (zones.Tree, error) {
+	zoneRows, err := repo.FindParkingZones(ctx, projectID)
+	if err != nil {
+		return zones.Tree{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("구역 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	spaceRows, err := repo.FindParkingZoneSpaces(ctx, projectID)
+	if err != nil {
+		return zones.Tree{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("구역 주차면 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	return zones.Build(zoneRows, spaceRows, status), nil
+}
+
+// buildZonesResponse 구역 계층을 주차장 → 층 → 구역 → 주차면 응답으로
+func buildZonesResponse(projectID string, tree zones.Tree) response.ResZones {
+	result := response.ResZones{ProjectID: projectID, Capacity: tree.Capacity, Lots: []response.ZoneLot{}}
+	for _, lot := range tree.Lots {
+		lotInfo := response.ZoneLot{Lot: lot.Name, Capacity: lot.Capacity, Floors: []response.ZoneFloor{}}
+		for _, floor := range lot.Floors {
+			floorInfo := response.ZoneFloor{Floor: floor.Name, Capacity: floor.Capacity, Zones: []response.ZoneInfo{}}
+			for _, zone := range floor.Zones {
+				floorInfo.Zones = append(floorInfo.Zones, response.ZoneInfo{
+					ID:       zone.ID,
+					Zone:     zone.Name,
+					Capacity: zone.Capacity,
+					Spaces:   zoneSpaces(zone.Spaces),
+				})
+			}
+			lotInfo.Floors = append(lotInfo.Floors, floorInfo)
+		}
+		result.Lots = append(result.Lots, lotInfo)
+	}
+	return result
+}
+
+// buildZoneOccupancyResponse 구역 계층의 단계별 점유 집계
+func buildZoneOccupancyResponse(projectID string, tree zones.Tree) response.ResZoneOccupancy {
+	result := response.ResZoneOccupancy{ProjectID: projectID, ZoneCounts: zoneCounts(tree.Counts), Lots: []response.LotOccupancy{}}
+	for _, lot := range tree.Lots {
+		lotInfo := response.LotOccupancy{Lot: lot.Name, ZoneCounts: zoneCounts(lot.Counts), Floors: []response.FloorOccupancy{}}
+		for _, floor := range lot.Floors {
+			floorInfo := response.FloorOccupancy{Floor: floor.Name, ZoneCounts: zoneCounts(floor.Counts), Zones: []response.ZoneOccupancy{}}
+			for _, zone := range floor.Zones {
+				floorInfo.Zones = append(floorInfo.Zones, response.ZoneOccupancy{ID: zone.ID, Zone: zone.Name, ZoneCounts: zoneCounts(zone.Counts)})
+			}
+			lotInfo.Floors = append(lotInfo.Floors, floorInfo)
+		}
+		result.Lots = append(result.Lots, lotInfo)
+	}
+	return result
+}
+
+func zoneCounts(counts zones.Counts) response.ZoneCounts {
+	return response.ZoneCounts{
+		Capacity:      counts.Capacity,
+		Occupied:      counts.Occupied,
+		Free:          counts.Free,
+		Unknown:       counts.Unknown,
+		OccupancyRate: counts.OccupancyRate(),
+	}
+}
+
+func zoneSpaces(spaces []zones.Space) []response.ZoneSpace {
+	result := make([]response.ZoneSpace, 0, len(spaces))
+	for _, space := range spaces {
+		result = append(result, response.ZoneSpace{CctvID: space.CctvID, ParkingID: space.ParkingID})
+	}
+	return result
+}
+
+// buildZoneRulesResponse 저장된 이름 규칙 (priority 순)
+func buildZoneRulesResponse(projectID string, rows []mysql.ParkingZoneRules) response.ResZoneRules {
+	sorted := append([]mysql.ParkingZoneRules{}, rows...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Priority < sorted[j].Priority })
+
+	result := response.ResZoneRules{ProjectID: projectID, Rules: []response.ZoneRule{}}
+	for _, row := range sorted {
+		result.Rules = append(result.Rules, response.ZoneRule{
+			Priority:       row.Priority,
+			CctvPattern:    row.CctvPattern,
+			ParkingPattern: row.ParkingPattern,
+			Lot:            row.Lot,
+			Floor:          row.Floor,
+			Zone:           row.Zone,
+		})
+	}
+	return result
+}
+
+// zoneRuleRows 요청 규칙을 저장 형식으로 (목록 순서가 priority, 정규식 검사 포함)
+func zoneRuleRows(projectID string, rules []request.ZoneRule) ([]mysql.ParkingZoneRules, error) {
+	if len(rules) > maxZoneRules {
+		return nil, fmt.Errorf("규칙은 %d개 이하여야 합니다", maxZoneRules)
+	}
+	rows := make([]mysql.ParkingZoneRules, 0, len(rules))
+	for i, rule := range rules {
+		row := mysql.ParkingZoneRules{
+			ProjectId:      projectID,
+			Priority:       i + 1,
+			CctvPattern:    rule.CctvPattern,
+			ParkingPattern: rule.ParkingPattern,
+			Lot:            strings.TrimSpace(rule.Lot),
+			Floor:          strings.TrimSpace(rule.Floor),
+			Zone:           strings.TrimSpace(rule.Zone),
+		}
+		if len(row.Lot) > zones.MaxNameLength || len(row.Floor) > zones.MaxNameLength || len(row.Zone) > zones.MaxNameLength {
+			return nil, fmt.Errorf("%d번째 규칙: lot, floor, zone은 %d자 이하여야 합니다", i+1, zones.MaxNameLength)
+		}
+		if _, err := zones.CompileRule(row); err != nil {
+			return nil, fmt.Errorf("%d번째 규칙: %v", i+1, err)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// zoneAssignments 요청의 주차면별 구역을 구역별 주차면 목록으로 (같은 주차면이 두 번 나오면 오류)
+func zoneAssignments(spaces []request.ZoneSpace) (map[zones.Key][]zones.Space, error) {
+	assignments := make(map[zones.Key][]zones.Space)
+	seen := make(map[zones.Space]zones.Key)
+	for _, item := range spaces {
+		key := zones.Key{Lot: strings.TrimSpace(item.Lot), Floor: strings.TrimSpace(item.Floor), Zone: strings.TrimSpace(item.Zone)}
+		space := zones.Space{CctvID: item.CctvID, ParkingID: item.ParkingID}
+		if err := zones.ValidateKey(key); err != nil {
+			return nil, err
+		}
+		if err := zones.ValidateSpace(space); err != nil {
+			return nil, err
+		}
+		if other, ok := seen[space]; ok {
+			return nil, fmt.Errorf("주차면 %s/%s가 두 구역(%s, %s)에 지정되었습니다", space.CctvID, space.ParkingID, other, key)
+		}
+		seen[space] = key
+		assignments[key] = append(assignments[key], space)
+	}
+	return assignments, nil
+}
+
+// replaceZones 구역 저장 (웹훅 구독이 걸린 구역을 없애려 하면 CONFLICT)
+func replaceZones(ctx context.Context, repo _interface.IZoneSaveParkingRepository, projectID string, assignments map[zones.Key][]zones.Space) error {
+	err := repo.ReplaceParkingZones(ctx, projectID, assignments)
+	var inUse *zones.InUseError
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &inUse):
+		return common.ErrorMsg(ctx, common.ErrConflict, common.Trace(), inUse.Error()+" (웹훅 구독을 먼저 삭제하세요)", common.ErrFromClient)
+	default:
+		return common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("구역 저장 실패: %v", err), common.ErrFromMysqlDB)
+	}
+}
+
+// zoneTreeFromAssignments 저장 전 구역 계층 (미리보기용, 구역 ID는 0)
+func zoneTreeFromAssignments(projectID string, assignments map[zones.Key][]zones.Space) zones.Tree {
+	zoneRows := make([]mysql.ParkingZones, 0, len(assignments))
+	var spaceRows []mysql.ParkingZoneSpaces
+	for key, spaces := range assignments {
+		// Build가 구역 ID로 주차면을 묶으므로 임시 ID 사용
+		zoneID := uint(len(zoneRows) + 1)
+		zoneRows = append(zoneRows, mysql.ParkingZones{ID: zoneID, ProjectId: projectID, Lot: key.Lot, Floor: key.Floor, Zone: key.Zone})
+		for _, space := range spaces {
+			spaceRows = append(spaceRows, mysql.ParkingZoneSpaces{ProjectId: projectID, ZoneId: zoneID, CctvId: space.CctvID, ParkingId: space.ParkingID})
+		}
+	}
+	tree := zones.Build(zoneRows, spaceRows, nil)
+	for _, lot := range tree.Lots {
+		for _, floor := range lot.Floors {
+			for _, zone := range floor.Zones {
+				zone.ID = 0
+			}
+		}
+	}
+	return tree
+}
+
+// roiSpaces ROI 파일의 모든 주차면 (좌표가 빈, 삭제된 ROI는 제외)
+func roiSpaces(doc *roidoc.Document) []zones.Space {
+	var spaces []zones.Space
+	for _, camera := range doc.Cameras {
+		for _, match := range camera.Matches {
+			if len(match.Coords()) == 0 {
+				continue
+			}
+			spaces = append(spaces, zones.Space{CctvID: camera.CctvID, ParkingID: match.ParkingID.Value})
+		}
+	}
+	return spaces
+}
+
+// zoneStatus CCTV별 ROI 번호 전경 비율로 주차면 점유 상태 판정 (parking_id는 주차면 번호 대응으로 ROI 번호를 찾음)
+func zoneStatus(rates map[string]map[int]float64, thresholds occupancy.Thresholds, index spaces.Index) func(zones.Space) zones.Status {
+	return func(space zones.Space) zones.Status {
+		roiID, ok := index.RoiID(space.CctvID, space.ParkingID)
+		if !ok {
+			return zones.StatusUnknown
+		}
+		rate, ok := rates[space.CctvID][roiID]
+		if !ok {
+			return zones.StatusUnknown
+		}
+		if occupied, _ := thresholds.Occupied(space.CctvID, roiID, rate); occupied {
+			return zones.StatusOccupied
+		}
+		return zones.StatusFree
+	}
+}
+
+// countUnassigned 결과는 있지만 어느 구역에도 속하지 않은 ROI 수
+func countUnassigned(rates map[string]map[int]float64, tree zones.Tree, index spaces.Index) int {
+	assigned := make(map[string]map[int]bool)
+	for _, lot := range tree.Lots {
+		for _, floor := range lot.Floors {
+			for _, zone := range floor.Zones {
+				for _, space := range zone.Spaces {
+					roiID, ok := index.RoiID(space.CctvID, space.ParkingID)
+					if !ok {
+						continue
+					}
+					if assigned[space.CctvID] == nil {
+						assigned[space.CctvID] = make(map[int]bool)
+					}
+					assigned[space.CctvID][roiID] = true
+				}
+			}
+		}
+	}
+
+	unassigned := 0
+	for cctvID, roiRates := range rates {
+		for roiID := range roiRates {
+			if !assigned[cctvID][roiID] {
+				unassigned++
+			}
+		}
+	}
+	return unassigned
+}
+
+// latestLiveRates {workspace}/liveResults의 가장 최근 결과 JSON에서 CCTV별 ROI 전경 비율 (없으면 os.ErrNotExist)
+func latestLiveRates(ws common.Workspace) (map[string]map[int]float64, error) {
+	resultDir, err := ws.Resolve("liveResults")
+	if err != nil {
+		return nil, err
+	}
+	// 파일 이름이 {YYYYMMDD_HHMMSS_mmm}_parking_results.json이라 이름 순이 시간 순
+	files, err := filepath.Glob(filepath.Join(resultDir, "*_parking_results.json"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, os.ErrNotExist
+	}
+	sort.Strings(files)
+
+	result, err := readResultFile(files[len(files)-1])
+	if err != nil {
+		return nil, err
+	}
+	return experimentResultRates(result), nil
+}
+
+// experimentResultRates OpenCV 결과(JSON)의 CCTV별 ROI 전경 비율
+func experimentResultRates(result entity.ExperimentResult) map[string]map[int]float64 {
+	rates := make(map[string]map[int]float64, len(result.Results))
+	for _, cctvResult := range result.Results {
+		if rates[cctvResult.CctvID] == nil {
+			rates[cctvResult.CctvID] = make(map[int]float64, len(cctvResult.RoiResults))
+		}
+		for _, roiResult := range cctvResult.RoiResults {
+			rates[cctvResult.CctvID][roiResult.RoiID] = roiResult.ForegroundRatio
+		}
+	}
+	return rates
+}
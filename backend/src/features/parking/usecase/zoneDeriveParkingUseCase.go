@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"main/common"
+	"main/common/db/mysql"
+	"main/common/roidoc"
+	"main/common/zones"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/request"
+	"main/features/parking/model/response"
+)
+
+type ZoneDeriveParkingUseCase struct {
+	Repository     _interface.IZoneDeriveParkingRepository
+	ContextTimeout time.Duration
+}
+
+func NewZoneDeriveParkingUseCase(repo _interface.IZoneDeriveParkingRepository, timeout time.Duration) _interface.IZoneDeriveParkingUseCase {
+	return &ZoneDeriveParkingUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// DeriveZones ROI 파일의 주차면에 이름 규칙을 적용해 구역 생성 (preview가 아니면 기존 구역 교체)
+func (d *ZoneDeriveParkingUseCase) DeriveZones(c context.Context, projectID string, req request.ReqDeriveZones) (response.ResDeriveZones, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	if req.RoiPath == "" {
+		return response.ResDeriveZones{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "roiPath가 필요합니다", common.ErrFromClient)
+	}
+
+	// 요청에 규칙이 있으면 저장된 규칙 대신 사용
+	var ruleRows []mysql.ParkingZoneRules
+	var err error
+	if req.Rules != nil {
+		ruleRows, err = zoneRuleRows(projectID, req.Rules)
+		if err != nil {
+			return response.ResDeriveZones{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+		}
+	} else {
+		ruleRows, err = d.Repository.FindParkingZoneRules(ctx, projectID)
+		if err != nil {
+			return response.ResDeriveZones{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("구역 규칙 조회 실패: %v", err), common.ErrFromMysqlDB)
+		}
+	}
+	if len(ruleRows) == 0 {
+		return response.ResDeriveZones{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "구역 이름 규칙이 없습니다", common.ErrFromClient)
+	}
+	rules, err := zones.CompileRules(ruleRows)
+	if err != nil {
+		// 저장된 규칙은 저장할 때 검사하므로 여기서 실패하면 내부 오류
+		return response.ResDeriveZones{}, common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), fmt.Sprintf("구역 규칙 컴파일 실패: %v", err), common.ErrFromInternal)
+	}
+
+	ws, err := common.ResolveWorkspace(ctx, projectID)
+	if err != nil {
+		return response.ResDeriveZones{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+	roiPath, err := ws.Resolve("uploads", "roi", req.RoiPath)
+	if err != nil {
+		return response.ResDeriveZones{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+	doc, err := roidoc.Load(roiPath)
+	if os.IsNotExist(err) {
+		return response.ResDeriveZones{}, common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), fmt.Sprintf("ROI 파일을 찾을 수 없습니다: %s", req.RoiPath), common.ErrFromClient)
+	}
+	if err != nil {
+		return response.ResDeriveZones{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), fmt.Sprintf("ROI 파일 읽기 실패: %v", err), common.ErrFromClient)
+	}
+
+	assignments := make(map[zones.Key][]zones.Space)
+	unmatched := []zones.Space{}
+	for _, space := range roiSpaces(doc) {
+		key, ok := zones.Classify(rules, space)
+		if !ok {
+			unmatched = append(unmatched, space)
+			continue
+		}
+		if err := zones.ValidateKey(key); err != nil {
+			return response.ResDeriveZones{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), fmt.Sprintf("%s/%s: %v", space.CctvID, space.ParkingID, err), common.ErrFromClient)
+		}
+		if err := zones.ValidateSpace(space); err != nil {
+			return response.ResDeriveZones{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+		}
+		assignments[key] = append(assignments[key], space)
+	}
+
+	res := response.ResDeriveZones{
+		Success:   true,
+		Preview:   req.Preview,
+		Unmatched: zoneSpaces(unmatched),
+	}
+	if req.Preview {
+		res.Zones = buildZonesResponse(projectID, zoneTreeFromAssignments(projectID, assignments))
+		res.Message = fmt.Sprintf("%d개 구역 미리보기입니다 (저장하지 않음)", len(assignments))
+		return res, nil
+	}
+
+	if err := replaceZones(ctx, d.Repository, projectID, assignments); err != nil {
+		return response.ResDeriveZones{}, err
+	}
+	tree, err := loadZoneTree(ctx, d.Repository, projectID, nil)
+	if err != nil {
+		return response.ResDeriveZones{}, err
+	}
+	res.Zones = buildZonesResponse(projectID, tree)
+	res.Message = fmt.Sprintf("%d개 구역이 저장되었습니다", len(assignments))
+	return res, nil
+}
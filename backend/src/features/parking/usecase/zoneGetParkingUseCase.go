@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/response"
+)
+
+type ZoneGetParkingUseCase struct {
+	Repository     _interface.IZoneGetParkingRepository
+	ContextTimeout time.Duration
+}
+
+func NewZoneGetParkingUseCase(repo _interface.IZoneGetParkingRepository, timeout time.Duration) _interface.IZoneGetParkingUseCase {
+	return &ZoneGetParkingUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// GetZones 프로젝트 구역 계층 조회
+func (d *ZoneGetParkingUseCase) GetZones(c context.Context, projectID string) (response.ResZones, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	tree, err := loadZoneTree(ctx, d.Repository, projectID, nil)
+	if err != nil {
+		return response.ResZones{}, err
+	}
+	return buildZonesResponse(projectID, tree), nil
+}
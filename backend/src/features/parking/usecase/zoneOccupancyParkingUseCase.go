@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"main/common"
+	"main/common/spaces"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/response"
+)
+
+type ZoneOccupancyParkingUseCase struct {
+	Repository     _interface.IZoneOccupancyParkingRepository
+	ContextTimeout time.Duration
+}
+
+func NewZoneOccupancyParkingUseCase(repo _interface.IZoneOccupancyParkingRepository, timeout time.Duration) _interface.IZoneOccupancyParkingUseCase {
+	return &ZoneOccupancyParkingUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// GetZoneOccupancy 실험(experimentID) 또는 최근 실시간 결과(experimentID 없음)를 구역/층/주차장별로 집계
+func (d *ZoneOccupancyParkingUseCase) GetZoneOccupancy(c context.Context, projectID string, experimentID string) (response.ResZoneOccupancy, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	var (
+		rates   map[string]map[int]float64
+		index   spaces.Index
+		source  = "live"
+		session uint
+	)
+	if experimentID != "" {
+		scores, err := loadExperimentScores(ctx, d.Repository, projectID, experimentID)
+		if err != nil {
+			return response.ResZoneOccupancy{}, err
+		}
+		rates, index, source, session = scores.Rates, scores.Spaces, "experiment", scores.Session.ID
+	} else {
+		ws, err := common.ResolveWorkspace(ctx, projectID)
+		if err != nil {
+			return response.ResZoneOccupancy{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+		}
+		rates, err = latestLiveRates(ws)
+		if os.IsNotExist(err) {
+			return response.ResZoneOccupancy{}, common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), "실시간 학습 결과가 없습니다", common.ErrFromClient)
+		}
+		if err != nil {
+			return response.ResZoneOccupancy{}, common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), fmt.Sprintf("실시간 학습 결과 읽기 실패: %v", err), common.ErrFromInternal)
+		}
+		if index, err = loadParkingSpaces(ctx, d.Repository.FindParkingSpaces, projectID); err != nil {
+			return response.ResZoneOccupancy{}, err
+		}
+	}
+
+	thresholds, err := loadOccupancyThresholds(ctx, d.Repository.FindOccupancyThresholds, projectID)
+	if err != nil {
+		return response.ResZoneOccupancy{}, err
+	}
+	tree, err := loadZoneTree(ctx, d.Repository, projectID, zoneStatus(rates, thresholds, index))
+	if err != nil {
+		return response.ResZoneOccupancy{}, err
+	}
+
+	result := buildZoneOccupancyResponse(projectID, tree)
+	result.Source = source
+	result.ExperimentID = session
+	result.Unassigned = countUnassigned(rates, tree, index)
+	return result, nil
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/response"
+)
+
+type ZoneRulesGetParkingUseCase struct {
+	Repository     _interface.IZoneRulesGetParkingRepository
+	ContextTimeout time.Duration
+}
+
+func NewZoneRulesGetParkingUseCase(repo _interface.IZoneRulesGetParkingRepository, timeout time.Duration) _interface.IZoneRulesGetParkingUseCase {
+	return &ZoneRulesGetParkingUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// GetZoneRules 구역 이름 규칙 조회
+func (d *ZoneRulesGetParkingUseCase) GetZoneRules(c context.Context, projectID string) (response.ResZoneRules, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	rows, err := d.Repository.FindParkingZoneRules(ctx, projectID)
+	if err != nil {
+		return response.ResZoneRules{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("구역 규칙 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	return buildZoneRulesResponse(projectID, rows), nil
+}
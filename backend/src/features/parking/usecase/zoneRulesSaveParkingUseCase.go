@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/request"
+	"main/features/parking/model/response"
+)
+
+type ZoneRulesSaveParkingUseCase struct {
+	Repository     _interface.IZoneRulesSaveParkingRepository
+	ContextTimeout time.Duration
+}
+
+func NewZoneRulesSaveParkingUseCase(repo _interface.IZoneRulesSaveParkingRepository, timeout time.Duration) _interface.IZoneRulesSaveParkingUseCase {
+	return &ZoneRulesSaveParkingUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// SaveZoneRules 구역 이름 규칙 전체 교체 (저장만 하고 구역은 derive로 다시 만듦)
+func (d *ZoneRulesSaveParkingUseCase) SaveZoneRules(c context.Context, projectID string, req request.ReqSaveZoneRules) (response.ResZoneRules, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	rows, err := zoneRuleRows(projectID, req.Rules)
+	if err != nil {
+		return response.ResZoneRules{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+	if err := d.Repository.ReplaceParkingZoneRules(ctx, projectID, rows); err != nil {
+		return response.ResZoneRules{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("구역 규칙 저장 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	saved, err := d.Repository.FindParkingZoneRules(ctx, projectID)
+	if err != nil {
+		return response.ResZoneRules{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("구역 규칙 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	return buildZoneRulesResponse(projectID, saved), nil
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"main/common"
+	_interface "main/features/parking/model/interface"
+	"main/features/parking/model/request"
+	"main/features/parking/model/response"
+)
+
+type ZoneSaveParkingUseCase struct {
+	Repository     _interface.IZoneSaveParkingRepository
+	ContextTimeout time.Duration
+}
+
+func NewZoneSaveParkingUseCase(repo _interface.IZoneSaveParkingRepository, timeout time.Duration) _interface.IZoneSaveParkingUseCase {
+	return &ZoneSaveParkingUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// SaveZones 프로젝트 구역 전체 교체 (spaces가 비어 있으면 구역 모두 삭제)
+func (d *ZoneSaveParkingUseCase) SaveZones(c context.Context, projectID string, req request.ReqSaveZones) (response.ResZones, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	assignments, err := zoneAssignments(req.Spaces)
+	if err != nil {
+		return response.ResZones{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+	if err := replaceZones(ctx, d.Repository, projectID, assignments); err != nil {
+		return response.ResZones{}, err
+	}
+
+	tree, err := loadZoneTree(ctx, d.Repository, projectID, nil)
+	if err != nil {
+		return response.ResZones{}, err
+	}
+	return buildZonesResponse(projectID, tree), nil
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"main/common"
+	"main/common/db/mysql"
+	"main/common/zones"
+	"main/features/parking/model/request"
+)
+
+// fakeZoneSaveRepository ReplaceParkingZones가 replaceErr를 돌려주는 저장소
+type fakeZoneSaveRepository struct {
+	replaceErr error
+	replaced   bool
+}
+
+func (r *fakeZoneSaveRepository) FindParkingZones(ctx context.Context, projectID string) ([]mysql.ParkingZones, error) {
+	return nil, nil
+}
+
+func (r *fakeZoneSaveRepository) FindParkingZoneSpaces(ctx context.Context, projectID string) ([]mysql.ParkingZoneSpaces, error) {
+	return nil, nil
+}
+
+func (r *fakeZoneSaveRepository) ReplaceParkingZones(ctx context.Context, projectID string, assignments map[zones.Key][]zones.Space) error {
+	r.replaced = true
+	return r.replaceErr
+}
+
+func TestSaveZonesConflictWhenRemovedZoneHasSubscriptions(t *testing.T) {
+	repo := &fakeZoneSaveRepository{replaceErr: fmt.Errorf("tx: %w", &zones.InUseError{
+		Keys:            []zones.Key{{Lot: "P1", Floor: "B3", Zone: "1"}},
+		SubscriptionIDs: []uint{7, 9},
+	})}
+	useCase := NewZoneSaveParkingUseCase(repo, time.Second)
+
+	req := request.ReqSaveZones{Spaces: []request.ZoneSpace{{Lot: "P1", Floor: "B3", Zone: "2", CctvID: "cctv1", ParkingID: "1"}}}
+	_, err := useCase.SaveZones(context.Background(), "project", req)
+	if !common.IsErrType(err, common.ErrConflict) {
+		t.Fatalf("CONFLICT가 아님: %v", err)
+	}
+	for _, want := range []string{"P1 / B3 / 1", "7, 9"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("메시지에 %q 없음: %v", want, err)
+		}
+	}
+}
+
+func TestSaveZonesDBError(t *testing.T) {
+	repo := &fakeZoneSaveRepository{replaceErr: errors.New("connection refused")}
+	useCase := NewZoneSaveParkingUseCase(repo, time.Second)
+
+	_, err := useCase.SaveZones(context.Background(), "project", request.ReqSaveZones{})
+	if !common.IsErrType(err, common.ErrInternalDB) {
+		t.Fatalf("INTERNAL_DB가 아님: %v", err)
+	}
+}
+
+func TestSaveZonesSuccess(t *testing.T) {
+	repo := &fakeZoneSaveRepository{}
+	useCase := NewZoneSaveParkingUseCase(repo, time.Second)
+
+	if _, err := useCase.SaveZones(context.Background(), "project", request.ReqSaveZones{}); err != nil {
+		t.Fatalf("저장 실패: %v", err)
+	}
+	if !repo.replaced {
+		t.Fatal("ReplaceParkingZones가 호출되지 않음")
+	}
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/project/model/interface"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ArchiveProjectHandler struct {
+	UseCase _interface.IArchiveProjectUseCase
+}
+
+func NewArchiveProjectHandler(c *echo.Echo, useCase _interface.IArchiveProjectUseCase) _interface.IArchiveProjectHandler {
+	handler := &ArchiveProjectHandler{
+		UseCase: useCase,
+	}
+	c.DELETE("/v0.1/projects/:projectId", handler.ArchiveProject)
+	return handler
+}
+
+// ArchiveProject 프로젝트 보관
+// @Router /v0.1/projects/{projectId} [delete]
+// @Summary 프로젝트 보관
+// @Description
+// @Description 프로젝트를 inactive 상태로 전환합니다. DB 레코드와 업로드 파일은 삭제하지 않습니다.
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 프로젝트 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : DB 처리 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param        projectId   path      string  true  "Project ID"
+// @Success 200 {object} response.ResProject
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags project
+func (d *ArchiveProjectHandler) ArchiveProject(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	projectID := c.Param("projectId")
+	if projectID == "" {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "projectId가 필요합니다",
+		})
+	}
+
+	res, err := d.UseCase.ArchiveProject(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
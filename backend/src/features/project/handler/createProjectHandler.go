@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/project/model/interface"
+	"main/features/project/model/request"
+	"main/features/project/usecase"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CreateProjectHandler struct {
+	UseCase _interface.ICreateProjectUseCase
+}
+
+func NewCreateProjectHandler(c *echo.Echo, useCase _interface.ICreateProjectUseCase) _interface.ICreateProjectHandler {
+	handler := &CreateProjectHandler{
+		UseCase: useCase,
+	}
+	c.POST("/v0.1/projects", handler.CreateProject)
+	return handler
+}
+
+// CreateProject 프로젝트 생성
+// @Router /v0.1/projects [post]
+// @Summary 프로젝트 생성
+// @Description
+// @Description 프로젝트를 등록하고 {UPLOAD_PATH}/{projectId}/uploads/{learningImages,testImages,roi} 폴더를 생성합니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 파라미터 오류
+// @Description
+// @Description ■ errCode with 409
+// @Description ALREADY_EXISTS : 이미 존재하는 프로젝트 ID
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_SERVER : 작업 폴더 생성 실패
+// @Description INTERNAL_DB : DB 처리 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param        request     body      request.ReqCreateProject  true  "Create Project Request"
+// @Success 200 {object} response.ResProject
+// @Failure 400 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags project
+func (d *CreateProjectHandler) CreateProject(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	var req request.ReqCreateProject
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "요청 데이터 파싱 실패: " + err.Error(),
+		})
+	}
+
+	if err := usecase.ValidateCreateProjectRequest(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "파라미터 검증 실패: " + err.Error(),
+		})
+	}
+
+	res, err := d.UseCase.CreateProject(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
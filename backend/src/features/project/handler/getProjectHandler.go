@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/project/model/interface"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type GetProjectHandler struct {
+	UseCase _interface.IGetProjectUseCase
+}
+
+func NewGetProjectHandler(c *echo.Echo, useCase _interface.IGetProjectUseCase) _interface.IGetProjectHandler {
+	handler := &GetProjectHandler{
+		UseCase: useCase,
+	}
+	c.GET("/v0.1/projects/:projectId", handler.GetProject)
+	return handler
+}
+
+// GetProject 프로젝트 조회
+// @Router /v0.1/projects/{projectId} [get]
+// @Summary 프로젝트 조회
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 프로젝트 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : DB 처리 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param        projectId   path      string  true  "Project ID"
+// @Success 200 {object} response.ResProject
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags project
+func (d *GetProjectHandler) GetProject(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	projectID := c.Param("projectId")
+	if projectID == "" {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "projectId가 필요합니다",
+		})
+	}
+
+	res, err := d.UseCase.GetProject(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
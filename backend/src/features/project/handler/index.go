@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"main/common/db/mysql"
+	"main/features/project/repository"
+	"main/features/project/usecase"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+func NewProjectHandler(e *echo.Echo) error {
+	// Repository 초기화
+	listProjectRepo := repository.NewListProjectRepository(mysql.GormMysqlDB)
+	getProjectRepo := repository.NewGetProjectRepository(mysql.GormMysqlDB)
+	createProjectRepo := repository.NewCreateProjectRepository(mysql.GormMysqlDB)
+	updateProjectRepo := repository.NewUpdateProjectRepository(mysql.GormMysqlDB)
+	archiveProjectRepo := repository.NewArchiveProjectRepository(mysql.GormMysqlDB)
+
+	// UseCase 초기화
+	listProjectUseCase := usecase.NewListProjectUseCase(listProjectRepo, 30*time.Second)
+	getProjectUseCase := usecase.NewGetProjectUseCase(getProjectRepo, 30*time.Second)
+	createProjectUseCase := usecase.NewCreateProjectUseCase(createProjectRepo, 30*time.Second)
+	updateProjectUseCase := usecase.NewUpdateProjectUseCase(updateProjectRepo, 30*time.Second)
+	archiveProjectUseCase := usecase.NewArchiveProjectUseCase(archiveProjectRepo, 30*time.Second)
+
+	// Handler 초기화
+	NewListProjectHandler(e, listProjectUseCase)
+	NewGetProjectHandler(e, getProjectUseCase)
+	NewCreateProjectHandler(e, createProjectUseCase)
+	NewUpdateProjectHandler(e, updateProjectUseCase)
+	NewArchiveProjectHandler(e, archiveProjectUseCase)
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/project/model/interface"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ListProjectHandler struct {
+	UseCase _interface.IListProjectUseCase
+}
+
+func NewListProjectHandler(c *echo.Echo, useCase _interface.IListProjectUseCase) _interface.IListProjectHandler {
+	handler := &ListProjectHandler{
+		UseCase: useCase,
+	}
+	c.GET("/v0.1/projects", handler.ListProject)
+	return handler
+}
+
+// ListProject 프로젝트 목록 조회
+// @Router /v0.1/projects [get]
+// @Summary 프로젝트 목록 조회
+// @Description
+// @Description 등록된 프로젝트 목록을 조회합니다. status로 필터링할 수 있습니다.
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : DB 처리 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param        status   query     string  false  "active | inactive"
+// @Success 200 {object} response.ResListProject
+// @Failure 500 {object} map[string]interface{}
+// @Tags project
+func (d *ListProjectHandler) ListProject(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	status := c.QueryParam("status")
+
+	res, err := d.UseCase.ListProject(ctx, status)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
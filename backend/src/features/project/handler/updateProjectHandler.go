@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/project/model/interface"
+	"main/features/project/model/request"
+	"main/features/project/usecase"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type UpdateProjectHandler struct {
+	UseCase _interface.IUpdateProjectUseCase
+}
+
+func NewUpdateProjectHandler(c *echo.Echo, useCase _interface.IUpdateProjectUseCase) _interface.IUpdateProjectHandler {
+	handler := &UpdateProjectHandler{
+		UseCase: useCase,
+	}
+	c.PUT("/v0.1/projects/:projectId", handler.UpdateProject)
+	return handler
+}
+
+// UpdateProject 프로젝트 수정
+// @Router /v0.1/projects/{projectId} [put]
+// @Summary 프로젝트 수정
+// @Description
+// @Description 요청에 포함된 name, description, location, status만 수정합니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 파라미터 오류
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 프로젝트 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : DB 처리 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param        projectId   path      string  true  "Project ID"
+// @Param        request     body      request.ReqUpdateProject  true  "Update Project Request"
+// @Success 200 {object} response.ResProject
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags project
+func (d *UpdateProjectHandler) UpdateProject(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	projectID := c.Param("projectId")
+	if projectID == "" {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "projectId가 필요합니다",
+		})
+	}
+
+	var req request.ReqUpdateProject
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "요청 데이터 파싱 실패: " + err.Error(),
+		})
+	}
+
+	if err := usecase.ValidateUpdateProjectRequest(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "파라미터 검증 실패: " + err.Error(),
+		})
+	}
+
+	res, err := d.UseCase.UpdateProject(ctx, projectID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
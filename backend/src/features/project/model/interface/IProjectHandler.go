@@ -0,0 +1,23 @@
+package _interface
+
+import "github.com/labstack/echo/v4"
+
+type IListProjectHandler interface {
+	ListProject(c echo.Context) error
+}
+
+type IGetProjectHandler interface {
+	GetProject(c echo.Context) error
+}
+
+type ICreateProjectHandler interface {
+	CreateProject(c echo.Context) error
+}
+
+type IUpdateProjectHandler interface {
+	UpdateProject(c echo.Context) error
+}
+
+type IArchiveProjectHandler interface {
+	ArchiveProject(c echo.Context) error
+}
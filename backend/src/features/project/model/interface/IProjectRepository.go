@@ -0,0 +1,29 @@
+package _interface
+
+import (
+	"context"
+	"main/common/db/mysql"
+)
+
+type IListProjectRepository interface {
+	FindProjects(ctx context.Context, status string) ([]mysql.Projects, error)
+}
+
+type IGetProjectRepository interface {
+	FindProjectByID(ctx context.Context, projectID string) (mysql.Projects, error)
+}
+
+type ICreateProjectRepository interface {
+	FindProjectByID(ctx context.Context, projectID string) (mysql.Projects, error)
+	CreateProject(ctx context.Context, project mysql.Projects) error
+}
+
+type IUpdateProjectRepository interface {
+	FindProjectByID(ctx context.Context, projectID string) (mysql.Projects, error)
+	UpdateProject(ctx context.Context, project mysql.Projects) error
+}
+
+type IArchiveProjectRepository interface {
+	FindProjectByID(ctx context.Context, projectID string) (mysql.Projects, error)
+	UpdateProjectStatus(ctx context.Context, projectID string, status string) error
+}
@@ -0,0 +1,27 @@
+package _interface
+
+import (
+	"context"
+	"main/features/project/model/request"
+	"main/features/project/model/response"
+)
+
+type IListProjectUseCase interface {
+	ListProject(ctx context.Context, status string) (response.ResListProject, error)
+}
+
+type IGetProjectUseCase interface {
+	GetProject(ctx context.Context, projectID string) (response.ResProject, error)
+}
+
+type ICreateProjectUseCase interface {
+	CreateProject(ctx context.Context, req request.ReqCreateProject) (response.ResProject, error)
+}
+
+type IUpdateProjectUseCase interface {
+	UpdateProject(ctx context.Context, projectID string, req request.ReqUpdateProject) (response.ResProject, error)
+}
+
+type IArchiveProjectUseCase interface {
+	ArchiveProject(ctx context.Context, projectID string) (response.ResProject, error)
+}
@@ -0,0 +1,21 @@
+package response
+
+type ResListProject struct {
+	Success bool          `json:"success"`
+	Data    []ProjectItem `json:"data"`
+}
+
+type ResProject struct {
+	Success bool        `json:"success"`
+	Data    ProjectItem `json:"data"`
+}
+
+type ProjectItem struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Location    string `json:"location"`
+	Status      string `json:"status"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/project/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewArchiveProjectRepository(gormDB *gorm.DB) _interface.IArchiveProjectRepository {
+	return &ArchiveProjectRepository{GormDB: gormDB}
+}
+
+func (r *ArchiveProjectRepository) FindProjectByID(ctx context.Context, projectID string) (mysql.Projects, error) {
+	return findProjectByID(ctx, r.GormDB, projectID)
+}
+
+func (r *ArchiveProjectRepository) UpdateProjectStatus(ctx context.Context, projectID string, status string) error {
+	result := r.GormDB.WithContext(ctx).Model(&mysql.Projects{}).Where("id = ?", projectID).Update("status", status)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
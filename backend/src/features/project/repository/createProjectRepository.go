@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/project/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewCreateProjectRepository(gormDB *gorm.DB) _interface.ICreateProjectRepository {
+	return &CreateProjectRepository{GormDB: gormDB}
+}
+
+func (r *CreateProjectRepository) FindProjectByID(ctx context.Context, projectID string) (mysql.Projects, error) {
+	return findProjectByID(ctx, r.GormDB, projectID)
+}
+
+func (r *CreateProjectRepository) CreateProject(ctx context.Context, project mysql.Projects) error {
+	result := r.GormDB.WithContext(ctx).Create(&project)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/project/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewGetProjectRepository(gormDB *gorm.DB) _interface.IGetProjectRepository {
+	return &GetProjectRepository{GormDB: gormDB}
+}
+
+func (r *GetProjectRepository) FindProjectByID(ctx context.Context, projectID string) (mysql.Projects, error) {
+	return findProjectByID(ctx, r.GormDB, projectID)
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/project/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewListProjectRepository(gormDB *gorm.DB) _interface.IListProjectRepository {
+	return &ListProjectRepository{GormDB: gormDB}
+}
+
+func (r *ListProjectRepository) FindProjects(ctx context.Context, status string) ([]mysql.Projects, error) {
+	var projects []mysql.Projects
+	query := r.GormDB.WithContext(ctx)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	result := query.Order("created_at ASC").Find(&projects)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return projects, nil
+}
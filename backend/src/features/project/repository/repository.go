@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+
+	"gorm.io/gorm"
+)
+
+type ListProjectRepository struct {
+	GormDB *gorm.DB
+}
+
+type GetProjectRepository struct {
+	GormDB *gorm.DB
+}
+
+type CreateProjectRepository struct {
+	GormDB *gorm.DB
+}
+
+type UpdateProjectRepository struct {
+	GormDB *gorm.DB
+}
+
+type ArchiveProjectRepository struct {
+	GormDB *gorm.DB
+}
+
+// findProjectByID 프로젝트 단건 조회 (없으면 gorm.ErrRecordNotFound)
+func findProjectByID(ctx context.Context, db *gorm.DB, projectID string) (mysql.Projects, error) {
+	var project mysql.Projects
+	result := db.WithContext(ctx).Where("id = ?", projectID).First(&project)
+	if result.Error != nil {
+		return mysql.Projects{}, result.Error
+	}
+	return project, nil
+}
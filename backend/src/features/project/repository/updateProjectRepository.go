@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/project/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewUpdateProjectRepository(gormDB *gorm.DB) _interface.IUpdateProjectRepository {
+	return &UpdateProjectRepository{GormDB: gormDB}
+}
+
+func (r *UpdateProjectRepository) FindProjectByID(ctx context.Context, projectID string) (mysql.Projects, error) {
+	return findProjectByID(ctx, r.GormDB, projectID)
+}
+
+func (r *UpdateProjectRepository) UpdateProject(ctx context.Context, project mysql.Projects) error {
+	result := r.GormDB.WithContext(ctx).Model(&mysql.Projects{}).Where("id = ?", project.ID).Updates(map[string]interface{}{
+		"name":        project.Name,
+		"description": project.Description,
+		"location":    project.Location,
+		"status":      project.Status,
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"main/common"
+	"main/common/db/mysql"
+	_interface "main/features/project/model/interface"
+	"main/features/project/model/response"
+	"time"
+)
+
+type ArchiveProjectUseCase struct {
+	Repository     _interface.IArchiveProjectRepository
+	ContextTimeout time.Duration
+}
+
+func NewArchiveProjectUseCase(repo _interface.IArchiveProjectRepository, timeout time.Duration) _interface.IArchiveProjectUseCase {
+	return &ArchiveProjectUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// ArchiveProject 프로젝트를 inactive 상태로 전환 (업로드 파일은 유지)
+func (d *ArchiveProjectUseCase) ArchiveProject(c context.Context, projectID string) (response.ResProject, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	project, err := findProject(ctx, d.Repository.FindProjectByID, projectID)
+	if err != nil {
+		return response.ResProject{}, err
+	}
+
+	if err := d.Repository.UpdateProjectStatus(ctx, projectID, mysql.ProjectStatusInactive); err != nil {
+		return response.ResProject{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("프로젝트 보관 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	project.Status = mysql.ProjectStatusInactive
+	project.UpdatedAt = time.Now()
+
+	return response.ResProject{
+		Success: true,
+		Data:    toProjectItem(project),
+	}, nil
+}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/common"
+	"main/common/db/mysql"
+	_interface "main/features/project/model/interface"
+	"main/features/project/model/request"
+	"main/features/project/model/response"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type CreateProjectUseCase struct {
+	Repository     _interface.ICreateProjectRepository
+	ContextTimeout time.Duration
+}
+
+func NewCreateProjectUseCase(repo _interface.ICreateProjectRepository, timeout time.Duration) _interface.ICreateProjectUseCase {
+	return &CreateProjectUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// CreateProject 프로젝트 등록 후 업로드 작업 폴더 생성
+func (d *CreateProjectUseCase) CreateProject(c context.Context, req request.ReqCreateProject) (response.ResProject, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	// 중복 ID 확인
+	_, err := d.Repository.FindProjectByID(ctx, req.ID)
+	if err == nil {
+		return response.ResProject{}, common.ErrorMsg(ctx, common.ErrAlreadyExists, common.Trace(), fmt.Sprintf("이미 존재하는 프로젝트입니다: %s", req.ID), common.ErrFromClient)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return response.ResProject{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("프로젝트 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	status := req.Status
+	if status == "" {
+		status = mysql.ProjectStatusActive
+	}
+
+	now := time.Now()
+	project := mysql.Projects{
+		ID:          req.ID,
+		Name:        req.Name,
+		Description: req.Description,
+		Location:    req.Location,
+		Status:      status,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	// 작업 폴더를 먼저 만들어 DB에만 남는 프로젝트가 생기지 않도록 함
+	if err := provisionWorkspace(project.ID); err != nil {
+		return response.ResProject{}, common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), err.Error(), common.ErrFromInternal)
+	}
+
+	if err := d.Repository.CreateProject(ctx, project); err != nil {
+		return response.ResProject{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("프로젝트 생성 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	return response.ResProject{
+		Success: true,
+		Data:    toProjectItem(project),
+	}, nil
+}
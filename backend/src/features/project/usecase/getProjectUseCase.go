@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+	_interface "main/features/project/model/interface"
+	"main/features/project/model/response"
+	"time"
+)
+
+type GetProjectUseCase struct {
+	Repository     _interface.IGetProjectRepository
+	ContextTimeout time.Duration
+}
+
+func NewGetProjectUseCase(repo _interface.IGetProjectRepository, timeout time.Duration) _interface.IGetProjectUseCase {
+	return &GetProjectUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+func (d *GetProjectUseCase) GetProject(c context.Context, projectID string) (response.ResProject, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	project, err := findProject(ctx, d.Repository.FindProjectByID, projectID)
+	if err != nil {
+		return response.ResProject{}, err
+	}
+
+	return response.ResProject{
+		Success: true,
+		Data:    toProjectItem(project),
+	}, nil
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"main/common"
+	_interface "main/features/project/model/interface"
+	"main/features/project/model/response"
+	"time"
+)
+
+type ListProjectUseCase struct {
+	Repository     _interface.IListProjectRepository
+	ContextTimeout time.Duration
+}
+
+func NewListProjectUseCase(repo _interface.IListProjectRepository, timeout time.Duration) _interface.IListProjectUseCase {
+	return &ListProjectUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+func (d *ListProjectUseCase) ListProject(c context.Context, status string) (response.ResListProject, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	projects, err := d.Repository.FindProjects(ctx, status)
+	if err != nil {
+		return response.ResListProject{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("프로젝트 목록 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	items := []response.ProjectItem{}
+	for _, project := range projects {
+		items = append(items, toProjectItem(project))
+	}
+
+	return response.ResListProject{
+		Success: true,
+		Data:    items,
+	}, nil
+}
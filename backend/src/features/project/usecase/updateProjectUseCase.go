@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"main/common"
+	_interface "main/features/project/model/interface"
+	"main/features/project/model/request"
+	"main/features/project/model/response"
+	"time"
+)
+
+type UpdateProjectUseCase struct {
+	Repository     _interface.IUpdateProjectRepository
+	ContextTimeout time.Duration
+}
+
+func NewUpdateProjectUseCase(repo _interface.IUpdateProjectRepository, timeout time.Duration) _interface.IUpdateProjectUseCase {
+	return &UpdateProjectUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// UpdateProject 요청에 포함된 필드만 수정
+func (d *UpdateProjectUseCase) UpdateProject(c context.Context, projectID string, req request.ReqUpdateProject) (response.ResProject, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	project, err := findProject(ctx, d.Repository.FindProjectByID, projectID)
+	if err != nil {
+		return response.ResProject{}, err
+	}
+
+	if req.Name != "" {
+		project.Name = req.Name
+	}
+	if req.Description != "" {
+		project.Description = req.Description
+	}
+	if req.Location != "" {
+		project.Location = req.Location
+	}
+	if req.Status != "" {
+		project.Status = req.Status
+	}
+	project.UpdatedAt = time.Now()
+
+	if err := d.Repository.UpdateProject(ctx, project); err != nil {
+		return response.ResProject{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("프로젝트 수정 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	return response.ResProject{
+		Success: true,
+		Data:    toProjectItem(project),
+	}, nil
+}
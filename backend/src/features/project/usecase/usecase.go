@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/common"
+	"main/common/db/mysql"
+	"main/features/project/model/request"
+	"main/features/project/model/response"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// 프로젝트 생성 시 만들어지는 업로드 폴더 목록
+var workspaceFolders = []string{"learningImages", "testImages", "roi"}
+
+// 파라미터 검증 함수
+func ValidateCreateProjectRequest(req request.ReqCreateProject) error {
+	if !common.IsValidProjectID(req.ID) {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("id는 영문, 숫자, '-', '_'로 이루어진 50자 이하여야 합니다. %s", req.ID))
+	}
+	if req.Name == "" || len(req.Name) > 100 {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("name은 1자 이상 100자 이하여야 합니다. %s", req.Name))
+	}
+	if len(req.Location) > 200 {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("location은 200자 이하여야 합니다. %s", req.Location))
+	}
+	if req.Status != "" && !isValidStatus(req.Status) {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("status는 active 또는 inactive여야 합니다. %s", req.Status))
+	}
+	return nil
+}
+
+// 파라미터 검증 함수
+func ValidateUpdateProjectRequest(req request.ReqUpdateProject) error {
+	if len(req.Name) > 100 {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("name은 100자 이하여야 합니다. %s", req.Name))
+	}
+	if len(req.Location) > 200 {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("location은 200자 이하여야 합니다. %s", req.Location))
+	}
+	if req.Status != "" && !isValidStatus(req.Status) {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("status는 active 또는 inactive여야 합니다. %s", req.Status))
+	}
+	return nil
+}
+
+func isValidStatus(status string) bool {
+	return status == mysql.ProjectStatusActive || status == mysql.ProjectStatusInactive
+}
+
+// findProject 프로젝트 조회 후 없으면 NOT_FOUND 에러로 변환
+func findProject(ctx context.Context, find func(context.Context, string) (mysql.Projects, error), projectID string) (mysql.Projects, error) {
+	project, err := find(ctx, projectID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return mysql.Projects{}, common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), fmt.Sprintf("프로젝트를 찾을 수 없습니다: %s", projectID), common.ErrFromClient)
+	}
+	if err != nil {
+		return mysql.Projects{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("프로젝트 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	return project, nil
+}
+
+// provisionWorkspace {UPLOAD_PATH}/{projectId}/uploads 하위 폴더 생성
+func provisionWorkspace(projectID string) error {
+	ws, err := common.NewWorkspace(projectID)
+	if err != nil {
+		return err
+	}
+	for _, folder := range workspaceFolders {
+		folderPath, err := ws.Resolve("uploads", folder)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(folderPath, 0755); err != nil {
+			return fmt.Errorf("작업 폴더 생성 실패: %v", err)
+		}
+	}
+	return nil
+}
+
+func toProjectItem(project mysql.Projects) response.ProjectItem {
+	return response.ProjectItem{
+		ID:          project.ID,
+		Name:        project.Name,
+		Description: project.Description,
+		Location:    project.Location,
+		Status:      project.Status,
+		CreatedAt:   project.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   project.UpdatedAt.Format(time.RFC3339),
+	}
+}
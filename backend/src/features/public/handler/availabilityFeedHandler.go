@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/public/model/interface"
+
+	"github.com/labstack/echo/v4"
+)
+
+type AvailabilityFeedHandler struct {
+	UseCase     _interface.IAvailabilityFeedUseCase
+	CacheMaxAge int
+}
+
+func NewAvailabilityFeedHandler(c *echo.Group, useCase _interface.IAvailabilityFeedUseCase, cacheMaxAge int) _interface.IAvailabilityFeedHandler {
+	handler := &AvailabilityFeedHandler{
+		UseCase:     useCase,
+		CacheMaxAge: cacheMaxAge,
+	}
+	c.GET("/projects/:projectId/availability/feed", handler.GetAvailabilityFeed)
+	return handler
+}
+
+// GetAvailabilityFeed 공개 가용 현황 피드
+// @Router /public/v1/projects/{projectId}/availability/feed [get]
+// @Summary 공개 가용 현황 피드
+// @Description
+// @Description 주기적으로 가져가는 파트너용으로 주차장 단위로 줄인 형식입니다.
+// @Description lots마다 lot, capacity, free, last_updated와 종류별 빈 자리 수(free_by_category)를 반환합니다.
+// @Description 인증, schema_version, 캐시 헤더(ETag, Last-Modified, 304)는 availability와 같습니다.
+// @Description
+// @Description ■ errCode with 401
+// @Description INVALID_API_KEY : 키 누락, 폐기된 키 또는 다른 프로젝트의 키
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 조회 실패
+// @Description
+// @Produce json
+// @Param        X-API-Key   header    string  true  "공개 API 키"
+// @Param        projectId   path      string  true  "Project ID"
+// @Success 200 {object} response.ResAvailabilityFeed
+// @Success 304
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags public
+func (d *AvailabilityFeedHandler) GetAvailabilityFeed(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	res, lastUpdated, err := d.UseCase.GetAvailabilityFeed(ctx, c.Param("projectId"))
+	if err != nil {
+		return err
+	}
+
+	return writeCached(c, res, lastUpdated, d.CacheMaxAge)
+}
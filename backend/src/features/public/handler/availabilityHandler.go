@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/public/model/interface"
+
+	"github.com/labstack/echo/v4"
+)
+
+type AvailabilityHandler struct {
+	UseCase     _interface.IAvailabilityUseCase
+	CacheMaxAge int
+}
+
+func NewAvailabilityHandler(c *echo.Group, useCase _interface.IAvailabilityUseCase, cacheMaxAge int) _interface.IAvailabilityHandler {
+	handler := &AvailabilityHandler{
+		UseCase:     useCase,
+		CacheMaxAge: cacheMaxAge,
+	}
+	c.GET("/projects/:projectId/availability", handler.GetAvailability)
+	return handler
+}
+
+// GetAvailability 공개 가용 현황
+// @Router /public/v1/projects/{projectId}/availability [get]
+// @Summary 공개 가용 현황
+// @Description
+// @Description 파트너용 읽기 전용 API입니다. 프로젝트에서 발급한 키를 X-API-Key 헤더로 보냅니다.
+// @Description 실시간 학습 결과로 주차장 → 층 → 구역별 capacity, free, occupied, unknown, last_updated와 주차면 종류(categories)별 수를 반환합니다.
+// @Description 구역에 속한 주차면만 집계하며, 실시간 결과가 없는 주차면은 unknown입니다. 이미지나 파일 경로는 포함하지 않습니다.
+// @Description
+// @Description ■ 형식
+// @Description schema_version이 같으면 필드를 지우거나 의미를 바꾸지 않습니다 (새 필드는 추가될 수 있음). 시각은 UTC RFC3339, 관측이 없으면 null입니다.
+// @Description
+// @Description ■ 캐시
+// @Description Cache-Control: private, max-age=PUBLIC_CACHE_MAX_AGE_SECONDS, ETag, Last-Modified(마지막 관측 시각)
+// @Description If-None-Match 또는 If-Modified-Since로 요청하면 바뀌지 않은 경우 본문 없이 304를 반환합니다.
+// @Description
+// @Description ■ errCode with 401
+// @Description INVALID_API_KEY : 키 누락, 폐기된 키 또는 다른 프로젝트의 키
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : 조회 실패
+// @Description
+// @Produce json
+// @Param        X-API-Key   header    string  true  "공개 API 키"
+// @Param        projectId   path      string  true  "Project ID"
+// @Success 200 {object} response.ResAvailability
+// @Success 304
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags public
+func (d *AvailabilityHandler) GetAvailability(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	res, lastUpdated, err := d.UseCase.GetAvailability(ctx, c.Param("projectId"))
+	if err != nil {
+		return err
+	}
+
+	return writeCached(c, res, lastUpdated, d.CacheMaxAge)
+}
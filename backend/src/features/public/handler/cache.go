@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"main/common"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// writeCached 캐시 헤더와 함께 JSON 응답 (본문이 같으면 ETag가 같으므로 조건부 요청에는 304)
+// 응답에 생성 시각을 넣지 않아 관측이 바뀌지 않는 동안 본문과 ETag가 유지됨
+func writeCached(c echo.Context, body interface{}, lastUpdated time.Time, maxAge int) error {
+	ctx := c.Request().Context()
+	data, err := json.Marshal(body)
+	if err != nil {
+		fmt.Printf("공개 응답 생성 실패: %v\n", err)
+		return common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), "응답 생성 실패", common.ErrFromInternal)
+	}
+	sum := sha256.Sum256(data)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	header := c.Response().Header()
+	header.Set("Cache-Control", fmt.Sprintf("private, max-age=%d", maxAge))
+	header.Set("ETag", etag)
+	header.Add("Vary", common.PublicAPIKeyHeader)
+	if !lastUpdated.IsZero() {
+		header.Set("Last-Modified", lastUpdated.UTC().Format(http.TimeFormat))
+	}
+
+	if notModified(c.Request(), etag, lastUpdated) {
+		return c.NoContent(http.StatusNotModified)
+	}
+	return c.JSONBlob(http.StatusOK, data)
+}
+
+// notModified If-None-Match가 있으면 ETag로, 없으면 If-Modified-Since로 판단
+func notModified(req *http.Request, etag string, lastUpdated time.Time) bool {
+	if ifNoneMatch := req.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		for _, candidate := range strings.Split(ifNoneMatch, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == etag || candidate == "*" {
+				return true
+			}
+		}
+		return false
+	}
+	if ifModifiedSince := req.Header.Get("If-Modified-Since"); ifModifiedSince != "" && !lastUpdated.IsZero() {
+		since, err := http.ParseTime(ifModifiedSince)
+		// Last-Modified는 초 단위라 같은 초 안의 관측은 바뀌지 않은 것으로 봄
+		return err == nil && !lastUpdated.Truncate(time.Second).After(since)
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/public/model/interface"
+	"main/features/public/model/request"
+	"main/features/public/usecase"
+	_middleware "main/middleware"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CreateApiKeyHandler struct {
+	UseCase _interface.ICreateApiKeyUseCase
+}
+
+func NewCreateApiKeyHandler(c *echo.Echo, useCase _interface.ICreateApiKeyUseCase) _interface.ICreateApiKeyHandler {
+	handler := &CreateApiKeyHandler{
+		UseCase: useCase,
+	}
+	c.POST("/v0.1/projects/:projectId/api-keys", handler.CreateApiKey, _middleware.ProjectScope)
+	return handler
+}
+
+// CreateApiKey 공개 API 키 발급
+// @Router /v0.1/projects/{projectId}/api-keys [post]
+// @Summary 공개 API 키 발급
+// @Description
+// @Description 파트너가 /public/v1 공개 가용 현황을 조회할 때 X-API-Key 헤더로 보내는 키를 발급합니다.
+// @Description 키는 해시만 저장하므로 이 응답의 key에서만 확인할 수 있습니다. 이후에는 key_prefix로 구분합니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 파라미터 오류
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 프로젝트 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_SERVER : 키 생성 실패
+// @Description INTERNAL_DB : DB 처리 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param        projectId   path      string  true  "Project ID"
+// @Param        request     body      request.ReqCreateApiKey  true  "Create Api Key Request"
+// @Success 200 {object} response.ResApiKey
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags public
+func (d *CreateApiKeyHandler) CreateApiKey(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	projectID := c.Param("projectId")
+
+	var req request.ReqCreateApiKey
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "요청 데이터 파싱 실패: " + err.Error(),
+		})
+	}
+
+	if err := usecase.ValidateCreateApiKeyRequest(req); err != nil {
+		return err
+	}
+
+	res, err := d.UseCase.CreateApiKey(ctx, projectID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"main/common"
+	"main/common/db/mysql"
+	"main/features/public/repository"
+	"main/features/public/usecase"
+	_middleware "main/middleware"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+func NewPublicHandler(e *echo.Echo) error {
+	// Repository 초기화
+	createApiKeyRepo := repository.NewCreateApiKeyRepository(mysql.GormMysqlDB)
+	listApiKeyRepo := repository.NewListApiKeyRepository(mysql.GormMysqlDB)
+	revokeApiKeyRepo := repository.NewRevokeApiKeyRepository(mysql.GormMysqlDB)
+	availabilityRepo := repository.NewAvailabilityRepository(mysql.GormMysqlDB)
+	availabilityFeedRepo := repository.NewAvailabilityFeedRepository(mysql.GormMysqlDB)
+
+	// UseCase 초기화
+	createApiKeyUseCase := usecase.NewCreateApiKeyUseCase(createApiKeyRepo, 30*time.Second)
+	listApiKeyUseCase := usecase.NewListApiKeyUseCase(listApiKeyRepo, 30*time.Second)
+	revokeApiKeyUseCase := usecase.NewRevokeApiKeyUseCase(revokeApiKeyRepo, 30*time.Second)
+	availabilityUseCase := usecase.NewAvailabilityUseCase(availabilityRepo, 30*time.Second)
+	availabilityFeedUseCase := usecase.NewAvailabilityFeedUseCase(availabilityFeedRepo, 30*time.Second)
+
+	// 공개 API 라우팅 그룹 (내부 /v0.1 API와 분리, 프로젝트별 API 키로 인증)
+	publicGroup := e.Group("/public/v1", _middleware.PublicAPIKey)
+
+	// Handler 초기화
+	NewCreateApiKeyHandler(e, createApiKeyUseCase)
+	NewListApiKeyHandler(e, listApiKeyUseCase)
+	NewRevokeApiKeyHandler(e, revokeApiKeyUseCase)
+	NewAvailabilityHandler(publicGroup, availabilityUseCase, common.Env.PublicCacheMaxAgeSeconds)
+	NewAvailabilityFeedHandler(publicGroup, availabilityFeedUseCase, common.Env.PublicCacheMaxAgeSeconds)
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/public/model/interface"
+	_middleware "main/middleware"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ListApiKeyHandler struct {
+	UseCase _interface.IListApiKeyUseCase
+}
+
+func NewListApiKeyHandler(c *echo.Echo, useCase _interface.IListApiKeyUseCase) _interface.IListApiKeyHandler {
+	handler := &ListApiKeyHandler{
+		UseCase: useCase,
+	}
+	c.GET("/v0.1/projects/:projectId/api-keys", handler.ListApiKey, _middleware.ProjectScope)
+	return handler
+}
+
+// ListApiKey 공개 API 키 목록
+// @Router /v0.1/projects/{projectId}/api-keys [get]
+// @Summary 공개 API 키 목록
+// @Description
+// @Description 프로젝트의 공개 API 키를 최근 발급 순으로 반환합니다. 폐기된 키도 포함되며(active=false) 키 값은 포함되지 않습니다.
+// @Description last_used_at은 1분 단위로 갱신됩니다.
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 프로젝트 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : DB 처리 실패
+// @Description
+// @Produce json
+// @Param        projectId   path      string  true  "Project ID"
+// @Success 200 {object} response.ResListApiKey
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags public
+func (d *ListApiKeyHandler) ListApiKey(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	res, err := d.UseCase.ListApiKey(ctx, c.Param("projectId"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
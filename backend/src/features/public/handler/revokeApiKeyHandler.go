@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/public/model/interface"
+	_middleware "main/middleware"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type RevokeApiKeyHandler struct {
+	UseCase _interface.IRevokeApiKeyUseCase
+}
+
+func NewRevokeApiKeyHandler(c *echo.Echo, useCase _interface.IRevokeApiKeyUseCase) _interface.IRevokeApiKeyHandler {
+	handler := &RevokeApiKeyHandler{
+		UseCase: useCase,
+	}
+	c.DELETE("/v0.1/projects/:projectId/api-keys/:apiKeyId", handler.RevokeApiKey, _middleware.ProjectScope)
+	return handler
+}
+
+// RevokeApiKey 공개 API 키 폐기
+// @Router /v0.1/projects/{projectId}/api-keys/{apiKeyId} [delete]
+// @Summary 공개 API 키 폐기
+// @Description
+// @Description 키를 폐기합니다. 다음 요청부터 INVALID_API_KEY(401)로 거부되며, 기록은 목록에 남습니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 잘못된 키 ID
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 프로젝트 또는 키 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : DB 처리 실패
+// @Description
+// @Produce json
+// @Param        projectId   path      string  true  "Project ID"
+// @Param        apiKeyId    path      int     true  "Api Key ID"
+// @Success 200 {object} response.ResApiKey
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags public
+func (d *RevokeApiKeyHandler) RevokeApiKey(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	res, err := d.UseCase.RevokeApiKey(ctx, c.Param("projectId"), c.Param("apiKeyId"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,23 @@
+package _interface
+
+import "github.com/labstack/echo/v4"
+
+type ICreateApiKeyHandler interface {
+	CreateApiKey(c echo.Context) error
+}
+
+type IListApiKeyHandler interface {
+	ListApiKey(c echo.Context) error
+}
+
+type IRevokeApiKeyHandler interface {
+	RevokeApiKey(c echo.Context) error
+}
+
+type IAvailabilityHandler interface {
+	GetAvailability(c echo.Context) error
+}
+
+type IAvailabilityFeedHandler interface {
+	GetAvailabilityFeed(c echo.Context) error
+}
@@ -0,0 +1,32 @@
+package _interface
+
+import (
+	"context"
+	"main/common/db/mysql"
+	"time"
+)
+
+type ICreateApiKeyRepository interface {
+	CreatePublicApiKey(ctx context.Context, apiKey mysql.PublicApiKeys) (uint, error)
+}
+
+type IListApiKeyRepository interface {
+	FindPublicApiKeys(ctx context.Context, projectID string) ([]mysql.PublicApiKeys, error)
+}
+
+type IRevokeApiKeyRepository interface {
+	FindPublicApiKey(ctx context.Context, projectID string, apiKeyID uint) (mysql.PublicApiKeys, error)
+	RevokePublicApiKey(ctx context.Context, apiKeyID uint, revokedAt time.Time) error
+}
+
+// IAvailabilityRepository 구역, 주차면 종류, 실시간 상태 조회 (이미지 경로 등은 조회하지 않음)
+type IAvailabilityRepository interface {
+	FindParkingZones(ctx context.Context, projectID string) ([]mysql.ParkingZones, error)
+	FindParkingZoneSpaces(ctx context.Context, projectID string) ([]mysql.ParkingZoneSpaces, error)
+	FindParkingSpaceCategories(ctx context.Context, projectID string) ([]mysql.ParkingSpaceCategories, error)
+	FindSpaceStates(ctx context.Context, projectID string) ([]mysql.SpaceState, error)
+}
+
+type IAvailabilityFeedRepository interface {
+	IAvailabilityRepository
+}
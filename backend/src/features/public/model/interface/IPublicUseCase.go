@@ -0,0 +1,29 @@
+package _interface
+
+import (
+	"context"
+	"main/features/public/model/request"
+	"main/features/public/model/response"
+	"time"
+)
+
+type ICreateApiKeyUseCase interface {
+	CreateApiKey(ctx context.Context, projectID string, req request.ReqCreateApiKey) (response.ResApiKey, error)
+}
+
+type IListApiKeyUseCase interface {
+	ListApiKey(ctx context.Context, projectID string) (response.ResListApiKey, error)
+}
+
+type IRevokeApiKeyUseCase interface {
+	RevokeApiKey(ctx context.Context, projectID string, apiKeyID string) (response.ResApiKey, error)
+}
+
+// IAvailabilityUseCase 가용 현황과 마지막 관측 시각 (관측이 없으면 zero, Last-Modified용)
+type IAvailabilityUseCase interface {
+	GetAvailability(ctx context.Context, projectID string) (response.ResAvailability, time.Time, error)
+}
+
+type IAvailabilityFeedUseCase interface {
+	GetAvailabilityFeed(ctx context.Context, projectID string) (response.ResAvailabilityFeed, time.Time, error)
+}
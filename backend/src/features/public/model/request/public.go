@@ -0,0 +1,6 @@
+package request
+
+// ReqCreateApiKey 공개 API 키 발급 (name은 키를 쓰는 파트너 등 구분용)
+type ReqCreateApiKey struct {
+	Name string `json:"name"`
+}
@@ -0,0 +1,24 @@
+package response
+
+type ResListApiKey struct {
+	Success bool         `json:"success"`
+	Data    []ApiKeyItem `json:"data"`
+}
+
+type ResApiKey struct {
+	Success bool       `json:"success"`
+	Data    ApiKeyItem `json:"data"`
+}
+
+// ApiKeyItem 공개 API 키 (key는 발급 응답에만 포함, 이후에는 key_prefix로 구분)
+type ApiKeyItem struct {
+	ID         uint   `json:"id"`
+	ProjectID  string `json:"project_id"`
+	Name       string `json:"name"`
+	Key        string `json:"key,omitempty"`
+	KeyPrefix  string `json:"key_prefix"`
+	Active     bool   `json:"active"`
+	LastUsedAt string `json:"last_used_at"`
+	RevokedAt  string `json:"revoked_at"`
+	CreatedAt  string `json:"created_at"`
+}
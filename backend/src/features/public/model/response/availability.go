@@ -0,0 +1,66 @@
+package response
+
+// 공개 가용 현황 응답 (schema_version이 같으면 필드를 지우거나 의미를 바꾸지 않음, 새 필드만 추가)
+// 시각은 모두 UTC RFC3339, 관측된 적이 없으면 null
+
+// ResAvailability 프로젝트 가용 현황 (주차장 → 층 → 구역, 단계마다 이름 순)
+type ResAvailability struct {
+	SchemaVersion string `json:"schema_version"`
+	ProjectID     string `json:"project_id"`
+	Availability
+	Lots []AvailabilityLot `json:"lots"`
+}
+
+// Availability 한 단계의 주차면 수 (capacity = free + occupied + unknown, unknown은 관측 결과가 없는 주차면)
+type Availability struct {
+	Capacity      int                    `json:"capacity"`
+	Free          int                    `json:"free"`
+	Occupied      int                    `json:"occupied"`
+	Unknown       int                    `json:"unknown"`
+	OccupancyRate float64                `json:"occupancy_rate"`
+	LastUpdated   *string                `json:"last_updated"`
+	Categories    []CategoryAvailability `json:"categories"`
+}
+
+// CategoryAvailability 주차면 종류별 수 (종류 이름 순)
+type CategoryAvailability struct {
+	Category string `json:"category"`
+	Capacity int    `json:"capacity"`
+	Free     int    `json:"free"`
+	Occupied int    `json:"occupied"`
+	Unknown  int    `json:"unknown"`
+}
+
+type AvailabilityLot struct {
+	Name string `json:"name"`
+	Availability
+	Floors []AvailabilityFloor `json:"floors"`
+}
+
+type AvailabilityFloor struct {
+	Name string `json:"name"`
+	Availability
+	Zones []AvailabilityZone `json:"zones"`
+}
+
+type AvailabilityZone struct {
+	Name string `json:"name"`
+	Availability
+}
+
+// ResAvailabilityFeed 주차장 단위로 줄인 폴링용 피드
+type ResAvailabilityFeed struct {
+	SchemaVersion string    `json:"schema_version"`
+	ProjectID     string    `json:"project_id"`
+	LastUpdated   *string   `json:"last_updated"`
+	Lots          []FeedLot `json:"lots"`
+}
+
+// FeedLot free_by_category는 종류별 빈 자리 수
+type FeedLot struct {
+	Lot            string         `json:"lot"`
+	Capacity       int            `json:"capacity"`
+	Free           int            `json:"free"`
+	LastUpdated    *string        `json:"last_updated"`
+	FreeByCategory map[string]int `json:"free_by_category"`
+}
@@ -0,0 +1,11 @@
+package repository
+
+import (
+	_interface "main/features/public/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewAvailabilityFeedRepository(gormDB *gorm.DB) _interface.IAvailabilityFeedRepository {
+	return &AvailabilityFeedRepository{AvailabilityRepository{GormDB: gormDB}}
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/public/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewAvailabilityRepository(gormDB *gorm.DB) _interface.IAvailabilityRepository {
+	return &AvailabilityRepository{GormDB: gormDB}
+}
+
+func (r *AvailabilityRepository) FindParkingZones(ctx context.Context, projectID string) ([]mysql.ParkingZones, error) {
+	var parkingZones []mysql.ParkingZones
+	result := r.GormDB.WithContext(ctx).Where("project_id = ?", projectID).Order("lot ASC, floor ASC, zone ASC").Find(&parkingZones)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return parkingZones, nil
+}
+
+func (r *AvailabilityRepository) FindParkingZoneSpaces(ctx context.Context, projectID string) ([]mysql.ParkingZoneSpaces, error) {
+	var spaces []mysql.ParkingZoneSpaces
+	result := r.GormDB.WithContext(ctx).Where("project_id = ?", projectID).Order("cctv_id ASC, parking_id ASC").Find(&spaces)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return spaces, nil
+}
+
+func (r *AvailabilityRepository) FindParkingSpaceCategories(ctx context.Context, projectID string) ([]mysql.ParkingSpaceCategories, error) {
+	var categories []mysql.ParkingSpaceCategories
+	result := r.GormDB.WithContext(ctx).Where("project_id = ?", projectID).Find(&categories)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return categories, nil
+}
+
+// FindSpaceStates 주차면별 점유 여부와 관측 시각만 조회 (source_image 등 내부 값은 읽지 않음)
+func (r *AvailabilityRepository) FindSpaceStates(ctx context.Context, projectID string) ([]mysql.SpaceState, error) {
+	var states []mysql.SpaceState
+	result := r.GormDB.WithContext(ctx).Select("cctv_id", "parking_id", "occupied", "observed_at").Where("project_id = ?", projectID).Find(&states)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return states, nil
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/public/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewCreateApiKeyRepository(gormDB *gorm.DB) _interface.ICreateApiKeyRepository {
+	return &CreateApiKeyRepository{GormDB: gormDB}
+}
+
+func (r *CreateApiKeyRepository) CreatePublicApiKey(ctx context.Context, apiKey mysql.PublicApiKeys) (uint, error) {
+	result := r.GormDB.WithContext(ctx).Create(&apiKey)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return apiKey.ID, nil
+}
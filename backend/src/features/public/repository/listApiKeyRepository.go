@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/public/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewListApiKeyRepository(gormDB *gorm.DB) _interface.IListApiKeyRepository {
+	return &ListApiKeyRepository{GormDB: gormDB}
+}
+
+// FindPublicApiKeys 프로젝트의 공개 API 키 (폐기된 키 포함, 최근 발급 순)
+func (r *ListApiKeyRepository) FindPublicApiKeys(ctx context.Context, projectID string) ([]mysql.PublicApiKeys, error) {
+	var apiKeys []mysql.PublicApiKeys
+	result := r.GormDB.WithContext(ctx).Where("project_id = ?", projectID).Order("id DESC").Find(&apiKeys)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return apiKeys, nil
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+
+	"gorm.io/gorm"
+)
+
+type CreateApiKeyRepository struct {
+	GormDB *gorm.DB
+}
+
+type ListApiKeyRepository struct {
+	GormDB *gorm.DB
+}
+
+type RevokeApiKeyRepository struct {
+	GormDB *gorm.DB
+}
+
+type AvailabilityRepository struct {
+	GormDB *gorm.DB
+}
+
+type AvailabilityFeedRepository struct {
+	AvailabilityRepository
+}
+
+// findPublicApiKey 프로젝트의 공개 API 키 단건 조회 (없으면 gorm.ErrRecordNotFound)
+func findPublicApiKey(ctx context.Context, db *gorm.DB, projectID string, apiKeyID uint) (mysql.PublicApiKeys, error) {
+	var apiKey mysql.PublicApiKeys
+	result := db.WithContext(ctx).Where("id = ? AND project_id = ?", apiKeyID, projectID).First(&apiKey)
+	if result.Error != nil {
+		return mysql.PublicApiKeys{}, result.Error
+	}
+	return apiKey, nil
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/public/model/interface"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func NewRevokeApiKeyRepository(gormDB *gorm.DB) _interface.IRevokeApiKeyRepository {
+	return &RevokeApiKeyRepository{GormDB: gormDB}
+}
+
+func (r *RevokeApiKeyRepository) FindPublicApiKey(ctx context.Context, projectID string, apiKeyID uint) (mysql.PublicApiKeys, error) {
+	return findPublicApiKey(ctx, r.GormDB, projectID, apiKeyID)
+}
+
+// RevokePublicApiKey 키 폐기 (사용 기록을 남기기 위해 삭제하지 않음)
+func (r *RevokeApiKeyRepository) RevokePublicApiKey(ctx context.Context, apiKeyID uint, revokedAt time.Time) error {
+	result := r.GormDB.WithContext(ctx).Model(&mysql.PublicApiKeys{}).Where("id = ? AND revoked_at IS NULL", apiKeyID).Update("revoked_at", revokedAt)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"main/common"
+	"main/common/db/mysql"
+	"main/common/zones"
+	_interface "main/features/public/model/interface"
+	"main/features/public/model/response"
+	"math"
+	"sort"
+	"time"
+)
+
+// SchemaVersion 공개 가용 현황 응답 형식 버전 (필드를 지우거나 의미를 바꾸면 올림)
+const SchemaVersion = "1.0"
+
+type counts struct {
+	capacity int
+	free     int
+	occupied int
+	unknown  int
+}
+
+// tally 한 단계(프로젝트, 주차장, 층, 구역)의 주차면 수, 종류별 수, 마지막 관측 시각
+type tally struct {
+	counts
+	categories  map[string]*counts
+	lastUpdated time.Time
+}
+
+// add 주차면 하나 집계 (observed가 false면 실시간 상태가 없는 주차면)
+func (t *tally) add(category string, state mysql.SpaceState, observed bool) {
+	if t.categories == nil {
+		t.categories = make(map[string]*counts)
+	}
+	byCategory, ok := t.categories[category]
+	if !ok {
+		byCategory = &counts{}
+		t.categories[category] = byCategory
+	}
+	for _, c := range []*counts{&t.counts, byCategory} {
+		c.capacity++
+		switch {
+		case !observed:
+			c.unknown++
+		case state.Occupied:
+			c.occupied++
+		default:
+			c.free++
+		}
+	}
+	if observed && state.ObservedAt.After(t.lastUpdated) {
+		t.lastUpdated = state.ObservedAt
+	}
+}
+
+func (t tally) availability() response.Availability {
+	rate := zones.Counts{Capacity: t.capacity, Occupied: t.occupied, Free: t.free, Unknown: t.unknown}.OccupancyRate()
+	result := response.Availability{
+		Capacity:      t.capacity,
+		Free:          t.free,
+		Occupied:      t.occupied,
+		Unknown:       t.unknown,
+		OccupancyRate: math.Round(rate*100) / 100,
+		LastUpdated:   formatPublicTime(t.lastUpdated),
+		Categories:    []response.CategoryAvailability{},
+	}
+	names := make([]string, 0, len(t.categories))
+	for name := range t.categories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		c := t.categories[name]
+		result.Categories = append(result.Categories, response.CategoryAvailability{
+			Category: name,
+			Capacity: c.capacity,
+			Free:     c.free,
+			Occupied: c.occupied,
+			Unknown:  c.unknown,
+		})
+	}
+	return result
+}
+
+// formatPublicTime 공개 응답 시각 (UTC RFC3339, zero면 null)
+func formatPublicTime(t time.Time) *string {
+	if t.IsZero() {
+		return nil
+	}
+	formatted := t.UTC().Format(time.RFC3339)
+	return &formatted
+}
+
+// buildAvailability 구역 계층별 가용 현황 (구역에 속한 주차면만 집계, 상태가 없는 주차면은 unknown)
+func buildAvailability(projectID string, zoneRows []mysql.ParkingZones, spaceRows []mysql.ParkingZoneSpaces, categoryRows []mysql.ParkingSpaceCategories, states []mysql.SpaceState) (response.ResAvailability, time.Time) {
+	stateBySpace := make(map[zones.Space]mysql.SpaceState, len(states))
+	for _, state := range states {
+		stateBySpace[zones.Space{CctvID: state.CctvId, ParkingID: state.ParkingId}] = state
+	}
+	categories := zones.NewCategories(categoryRows)
+	tree := zones.Build(zoneRows, spaceRows, nil)
+
+	var project tally
+	lots := []response.AvailabilityLot{}
+	for _, lot := range tree.Lots {
+		var lotTally tally
+		floors := []response.AvailabilityFloor{}
+		for _, floor := range lot.Floors {
+			var floorTally tally
+			zoneItems := []response.AvailabilityZone{}
+			for _, zone := range floor.Zones {
+				var zoneTally tally
+				for _, space := range zone.Spaces {
+					state, observed := stateBySpace[space]
+					category := categories.Of(space)
+					for _, t := range []*tally{&project, &lotTally, &floorTally, &zoneTally} {
+						t.add(category, state, observed)
+					}
+				}
+				zoneItems = append(zoneItems, response.AvailabilityZone{Name: zone.Name, Availability: zoneTally.availability()})
+			}
+			floors = append(floors, response.AvailabilityFloor{Name: floor.Name, Availability: floorTally.availability(), Zones: zoneItems})
+		}
+		lots = append(lots, response.AvailabilityLot{Name: lot.Name, Availability: lotTally.availability(), Floors: floors})
+	}
+
+	return response.ResAvailability{
+		SchemaVersion: SchemaVersion,
+		ProjectID:     projectID,
+		Availability:  project.availability(),
+		Lots:          lots,
+	}, project.lastUpdated
+}
+
+// buildAvailabilityFeed 주차장 단위 피드로 줄임
+func buildAvailabilityFeed(availability response.ResAvailability) response.ResAvailabilityFeed {
+	feed := response.ResAvailabilityFeed{
+		SchemaVersion: availability.SchemaVersion,
+		ProjectID:     availability.ProjectID,
+		LastUpdated:   availability.LastUpdated,
+		Lots:          []response.FeedLot{},
+	}
+	for _, lot := range availability.Lots {
+		freeByCategory := make(map[string]int, len(lot.Categories))
+		for _, category := range lot.Categories {
+			freeByCategory[category.Category] = category.Free
+		}
+		feed.Lots = append(feed.Lots, response.FeedLot{
+			Lot:            lot.Name,
+			Capacity:       lot.Capacity,
+			Free:           lot.Free,
+			LastUpdated:    lot.LastUpdated,
+			FreeByCategory: freeByCategory,
+		})
+	}
+	return feed
+}
+
+// loadAvailability 저장된 구역, 종류, 실시간 상태로 가용 현황 생성
+// 공개 응답에는 내부 오류 내용을 넣지 않고 서버 로그에만 남김
+func loadAvailability(ctx context.Context, repo _interface.IAvailabilityRepository, projectID string) (response.ResAvailability, time.Time, error) {
+	zoneRows, err := repo.FindParkingZones(ctx, projectID)
+	if err != nil {
+		return response.ResAvailability{}, time.Time{}, availabilityDBError(ctx, projectID, "구역", err)
+	}
+	spaceRows, err := repo.FindParkingZoneSpaces(ctx, projectID)
+	if err != nil {
+		return response.ResAvailability{}, time.Time{}, availabilityDBError(ctx, projectID, "구역 주차면", err)
+	}
+	categoryRows, err := repo.FindParkingSpaceCategories(ctx, projectID)
+	if err != nil {
+		return response.ResAvailability{}, time.Time{}, availabilityDBError(ctx, projectID, "주차면 종류", err)
+	}
+	states, err := repo.FindSpaceStates(ctx, projectID)
+	if err != nil {
+		return response.ResAvailability{}, time.Time{}, availabilityDBError(ctx, projectID, "실시간 상태", err)
+	}
+	availability, lastUpdated := buildAvailability(projectID, zoneRows, spaceRows, categoryRows, states)
+	return availability, lastUpdated, nil
+}
+
+func availabilityDBError(ctx context.Context, projectID string, target string, err error) error {
+	fmt.Printf("공개 가용 현황 %s 조회 실패 (%s): %v\n", target, projectID, err)
+	return common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), "가용 현황 조회 실패", common.ErrFromMysqlDB)
+}
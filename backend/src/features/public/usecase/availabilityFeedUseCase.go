@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+	_interface "main/features/public/model/interface"
+	"main/features/public/model/response"
+	"time"
+)
+
+type AvailabilityFeedUseCase struct {
+	Repository     _interface.IAvailabilityFeedRepository
+	ContextTimeout time.Duration
+}
+
+func NewAvailabilityFeedUseCase(repo _interface.IAvailabilityFeedRepository, timeout time.Duration) _interface.IAvailabilityFeedUseCase {
+	return &AvailabilityFeedUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// GetAvailabilityFeed 주차장 단위로 줄인 가용 현황 (빈 자리 수와 종류별 빈 자리 수)
+func (d *AvailabilityFeedUseCase) GetAvailabilityFeed(c context.Context, projectID string) (response.ResAvailabilityFeed, time.Time, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	availability, lastUpdated, err := loadAvailability(ctx, d.Repository, projectID)
+	if err != nil {
+		return response.ResAvailabilityFeed{}, time.Time{}, err
+	}
+	return buildAvailabilityFeed(availability), lastUpdated, nil
+}
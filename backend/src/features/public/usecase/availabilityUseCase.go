@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"context"
+	_interface "main/features/public/model/interface"
+	"main/features/public/model/response"
+	"time"
+)
+
+type AvailabilityUseCase struct {
+	Repository     _interface.IAvailabilityRepository
+	ContextTimeout time.Duration
+}
+
+func NewAvailabilityUseCase(repo _interface.IAvailabilityRepository, timeout time.Duration) _interface.IAvailabilityUseCase {
+	return &AvailabilityUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// GetAvailability 주차장 → 층 → 구역별 가용 현황과 종류별 수
+func (d *AvailabilityUseCase) GetAvailability(c context.Context, projectID string) (response.ResAvailability, time.Time, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	return loadAvailability(ctx, d.Repository, projectID)
+}
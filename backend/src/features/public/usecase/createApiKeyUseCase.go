@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"main/common"
+	"main/common/db/mysql"
+	_interface "main/features/public/model/interface"
+	"main/features/public/model/request"
+	"main/features/public/model/response"
+	"strings"
+	"time"
+)
+
+type CreateApiKeyUseCase struct {
+	Repository     _interface.ICreateApiKeyRepository
+	ContextTimeout time.Duration
+}
+
+func NewCreateApiKeyUseCase(repo _interface.ICreateApiKeyRepository, timeout time.Duration) _interface.ICreateApiKeyUseCase {
+	return &CreateApiKeyUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// CreateApiKey 공개 API 키 발급 (키는 해시만 저장하므로 이 응답에서만 확인 가능)
+func (d *CreateApiKeyUseCase) CreateApiKey(c context.Context, projectID string, req request.ReqCreateApiKey) (response.ResApiKey, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	key, prefix, err := common.NewPublicAPIKey()
+	if err != nil {
+		return response.ResApiKey{}, common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), fmt.Sprintf("API 키 생성 실패: %v", err), common.ErrFromInternal)
+	}
+
+	now := time.Now()
+	apiKey := mysql.PublicApiKeys{
+		ProjectId: projectID,
+		Name:      strings.TrimSpace(req.Name),
+		KeyPrefix: prefix,
+		KeyHash:   common.HashPublicAPIKey(key),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	id, err := d.Repository.CreatePublicApiKey(ctx, apiKey)
+	if err != nil {
+		return response.ResApiKey{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("API 키 저장 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	apiKey.ID = id
+
+	item := toApiKeyItem(apiKey)
+	item.Key = key
+	return response.ResApiKey{
+		Success: true,
+		Data:    item,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"main/common"
+	_interface "main/features/public/model/interface"
+	"main/features/public/model/response"
+	"time"
+)
+
+type ListApiKeyUseCase struct {
+	Repository     _interface.IListApiKeyRepository
+	ContextTimeout time.Duration
+}
+
+func NewListApiKeyUseCase(repo _interface.IListApiKeyRepository, timeout time.Duration) _interface.IListApiKeyUseCase {
+	return &ListApiKeyUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// ListApiKey 프로젝트의 공개 API 키 목록 (폐기된 키 포함)
+func (d *ListApiKeyUseCase) ListApiKey(c context.Context, projectID string) (response.ResListApiKey, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	apiKeys, err := d.Repository.FindPublicApiKeys(ctx, projectID)
+	if err != nil {
+		return response.ResListApiKey{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("API 키 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	items := make([]response.ApiKeyItem, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		items = append(items, toApiKeyItem(apiKey))
+	}
+	return response.ResListApiKey{
+		Success: true,
+		Data:    items,
+	}, nil
+}
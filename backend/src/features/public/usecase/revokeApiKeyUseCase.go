@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/common"
+	_interface "main/features/public/model/interface"
+	"main/features/public/model/response"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type RevokeApiKeyUseCase struct {
+	Repository     _interface.IRevokeApiKeyRepository
+	ContextTimeout time.Duration
+}
+
+func NewRevokeApiKeyUseCase(repo _interface.IRevokeApiKeyRepository, timeout time.Duration) _interface.IRevokeApiKeyUseCase {
+	return &RevokeApiKeyUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// RevokeApiKey 키 폐기 (바로 다음 요청부터 401, 이미 폐기된 키는 그대로 반환)
+func (d *RevokeApiKeyUseCase) RevokeApiKey(c context.Context, projectID string, apiKeyID string) (response.ResApiKey, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	id, err := parseApiKeyID(apiKeyID)
+	if err != nil {
+		return response.ResApiKey{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+	apiKey, err := d.Repository.FindPublicApiKey(ctx, projectID, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return response.ResApiKey{}, common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), fmt.Sprintf("API 키를 찾을 수 없습니다: %s", apiKeyID), common.ErrFromClient)
+	}
+	if err != nil {
+		return response.ResApiKey{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("API 키 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	if apiKey.RevokedAt == nil {
+		now := time.Now()
+		if err := d.Repository.RevokePublicApiKey(ctx, apiKey.ID, now); err != nil {
+			return response.ResApiKey{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("API 키 폐기 실패: %v", err), common.ErrFromMysqlDB)
+		}
+		apiKey.RevokedAt = &now
+	}
+
+	return response.ResApiKey{
+		Success: true,
+		Data:    toApiKeyItem(apiKey),
+	}, nil
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"fmt"
+	"main/common/db/mysql"
+	"main/features/public/model/request"
+	"main/features/public/model/response"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// 키 이름 최대 길이 (public_api_keys.name)
+const maxApiKeyNameLength = 100
+
+// 파라미터 검증 함수
+func ValidateCreateApiKeyRequest(req request.ReqCreateApiKey) error {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "name이 필요합니다")
+	}
+	if len(name) > maxApiKeyNameLength {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("name은 %d자 이하여야 합니다", maxApiKeyNameLength))
+	}
+	return nil
+}
+
+func parseApiKeyID(apiKeyID string) (uint, error) {
+	id, err := strconv.ParseUint(apiKeyID, 10, 32)
+	if err != nil || id == 0 {
+		return 0, fmt.Errorf("잘못된 API 키 ID입니다: %s", apiKeyID)
+	}
+	return uint(id), nil
+}
+
+// toApiKeyItem 키 응답 변환 (원래 키는 저장하지 않으므로 포함되지 않음)
+func toApiKeyItem(apiKey mysql.PublicApiKeys) response.ApiKeyItem {
+	return response.ApiKeyItem{
+		ID:         apiKey.ID,
+		ProjectID:  apiKey.ProjectId,
+		Name:       apiKey.Name,
+		KeyPrefix:  apiKey.KeyPrefix,
+		Active:     apiKey.RevokedAt == nil,
+		LastUsedAt: formatTime(apiKey.LastUsedAt),
+		RevokedAt:  formatTime(apiKey.RevokedAt),
+		CreatedAt:  apiKey.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -12,11 +12,11 @@ type CreateDraftRoiHandler struct {
 	UseCase _interface.ICreateDraftRoiUseCase
 }
 
-func NewCreateDraftRoiHandler(c *echo.Echo, useCase _interface.ICreateDraftRoiUseCase) _interface.ICreateDraftRoiHandler {
+func NewCreateDraftRoiHandler(c *echo.Group, useCase _interface.ICreateDraftRoiUseCase) _interface.ICreateDraftRoiHandler {
 	handler := &CreateDraftRoiHandler{
 		UseCase: useCase,
 	}
-	c.POST("/v0.1/roi/:projectId/draft", handler.CreateDraftRoi)
+	c.POST("/:projectId/draft", handler.CreateDraftRoi)
 	return handler
 }
 
@@ -25,10 +25,18 @@ func NewCreateDraftRoiHandler(c *echo.Echo, useCase _interface.ICreateDraftRoiUs
 // @Summary ROI 드래프트 생성
 // @Description
 // @Description 지정된 ROI 파일을 기반으로 draft 폴더에 초안을 생성합니다.
+// @Description 응답의 ETag 헤더(version)를 ROI 생성/수정/삭제 요청의 If-Match에 넣어야 합니다.
+// @Description 초안이 이미 있으면 If-Match가 필요하며, 현재 ETag와 같을 때만 원본 내용으로 교체합니다.
 // @Description
 // @Description ■ errCode with 400
 // @Description PARAM_BAD : 파라미터 오류
 // @Description
+// @Description ■ errCode with 409
+// @Description CONFLICT : draft 조회 이후 다른 수정이 있었음 (다시 조회 후 재시도)
+// @Description
+// @Description ■ errCode with 428
+// @Description PRECONDITION_REQUIRED : 초안이 있는데 If-Match 헤더 누락
+// @Description
 // @Description ■ errCode with 500
 // @Description INTERNAL_SERVER : 내부 로직 처리 실패
 // @Description INTERNAL_DB : DB 처리 실패
@@ -37,6 +45,7 @@ func NewCreateDraftRoiHandler(c *echo.Echo, useCase _interface.ICreateDraftRoiUs
 // @Produce json
 // @Param        projectId   path      string  true  "Project ID"
 // @Param        file        query     string  true  "ROI File Name"
+// @Param        If-Match    header    string  false "초안 ETag (초안이 이미 있을 때 필수)"
 // @Success 200 {object} map[string]interface{}
 // @Failure 400 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
@@ -60,7 +69,10 @@ func (d *CreateDraftRoiHandler) CreateDraftRoi(c echo.Context) error {
 		})
 	}
 
-	err := d.UseCase.CreateDraftRoi(ctx, projectID, roiFileName)
+	etag, err := d.UseCase.CreateDraftRoi(ctx, projectID, roiFileName, c.Request().Header.Get("If-Match"))
+	if common.IsErrType(err, common.ErrPreconditionRequired) || common.IsErrType(err, common.ErrConflict) {
+		return err
+	}
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"success": false,
@@ -68,8 +80,10 @@ func (d *CreateDraftRoiHandler) CreateDraftRoi(c echo.Context) error {
 		})
 	}
 
+	c.Response().Header().Set("ETag", etag)
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"success": true,
 		"message": "드래프트가 성공적으로 생성되었습니다",
+		"version": etag,
 	})
 }
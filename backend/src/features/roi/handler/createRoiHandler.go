@@ -13,11 +13,11 @@ type CreateRoiHandler struct {
 	UseCase _interface.ICreateRoiUseCase
 }
 
-func NewCreateRoiHandler(c *echo.Echo, useCase _interface.ICreateRoiUseCase) _interface.ICreateRoiHandler {
+func NewCreateRoiHandler(c *echo.Group, useCase _interface.ICreateRoiUseCase) _interface.ICreateRoiHandler {
 	handler := &CreateRoiHandler{
 		UseCase: useCase,
 	}
-	c.POST("/v0.1/roi/:projectId/create", handler.CreateRoi)
+	c.POST("/:projectId/create", handler.CreateRoi)
 	return handler
 }
 
@@ -27,18 +27,30 @@ func NewCreateRoiHandler(c *echo.Echo, useCase _interface.ICreateRoiUseCase) _in
 // @Description
 // @Description CCTV ID와 ROI ID에 해당하는 좌표를 입력한 좌표로 변경합니다.
 // @Description
+// @Description 꼬인 다각형, 최소 넓이 미만, 기준 이미지 범위를 벗어난 좌표, 다른 주차면과 80% 이상 겹침은 저장하지 않고 400과 errors를 반환합니다.
+// @Description 5% 이상 겹침, 기준 이미지 없음은 저장 후 warnings로 알려줍니다.
+// @Description
 // @Description ■ errCode with 400
 // @Description PARAM_BAD : 파라미터 오류
 // @Description
+// @Description ■ errCode with 409
+// @Description CONFLICT : draft 조회 이후 다른 수정이 있었음 (다시 조회 후 재시도)
+// @Description
+// @Description ■ errCode with 428
+// @Description PRECONDITION_REQUIRED : If-Match 헤더 누락
+// @Description
 // @Description ■ errCode with 500
 // @Description INTERNAL_SERVER : 내부 로직 처리 실패
 // @Description
 // @Accept json
 // @Produce json
 // @Param        projectId   path      string  true  "Project ID"
+// @Param        If-Match    header    string  true  "draft 조회 시 받은 ETag (* 는 항상 일치)"
 // @Param        request     body      request.CreateRoiRequest  true  "Create ROI Request"
 // @Success 200 {object} response.ResCreateRoi
 // @Failure 400 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 428 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
 // @Tags roi
 func (d *CreateRoiHandler) CreateRoi(c echo.Context) error {
@@ -65,7 +77,13 @@ func (d *CreateRoiHandler) CreateRoi(c echo.Context) error {
 		})
 	}
 
+	// 조회 시 받은 ETag (없으면 428, 다르면 409)
+	req.IfMatch = c.Request().Header.Get("If-Match")
+
 	res, err := d.UseCase.CreateRoi(ctx, projectID, req)
+	if common.IsErrType(err, common.ErrPreconditionRequired) || common.IsErrType(err, common.ErrConflict) {
+		return err
+	}
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"success": false,
@@ -73,5 +91,11 @@ func (d *CreateRoiHandler) CreateRoi(c echo.Context) error {
 		})
 	}
 
+	// 형상 검사 오류 (errors에 사유)
+	if !res.Success {
+		return c.JSON(http.StatusBadRequest, res)
+	}
+
+	c.Response().Header().Set("ETag", res.Version)
 	return c.JSON(http.StatusOK, res)
 }
@@ -13,11 +13,11 @@ type DeleteRoiHandler struct {
 	UseCase _interface.IDeleteRoiUseCase
 }
 
-func NewDeleteRoiHandler(c *echo.Echo, useCase _interface.IDeleteRoiUseCase) _interface.IDeleteRoiHandler {
+func NewDeleteRoiHandler(c *echo.Group, useCase _interface.IDeleteRoiUseCase) _interface.IDeleteRoiHandler {
 	handler := &DeleteRoiHandler{
 		UseCase: useCase,
 	}
-	c.DELETE("/v0.1/roi/:projectId/delete", handler.DeleteRoi)
+	c.DELETE("/:projectId/delete", handler.DeleteRoi)
 	return handler
 }
 
@@ -30,15 +30,24 @@ func NewDeleteRoiHandler(c *echo.Echo, useCase _interface.IDeleteRoiUseCase) _in
 // @Description ■ errCode with 400
 // @Description PARAM_BAD : 파라미터 오류
 // @Description
+// @Description ■ errCode with 409
+// @Description CONFLICT : draft 조회 이후 다른 수정이 있었음 (다시 조회 후 재시도)
+// @Description
+// @Description ■ errCode with 428
+// @Description PRECONDITION_REQUIRED : If-Match 헤더 누락
+// @Description
 // @Description ■ errCode with 500
 // @Description INTERNAL_SERVER : 내부 로직 처리 실패
 // @Description
 // @Accept json
 // @Produce json
 // @Param        projectId   path      string  true  "Project ID"
+// @Param        If-Match    header    string  true  "draft 조회 시 받은 ETag (* 는 항상 일치)"
 // @Param        request     body      request.DeleteRoiRequest  true  "Delete ROI Request"
 // @Success 200 {object} response.ResDeleteRoi
 // @Failure 400 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 428 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
 // @Tags roi
 func (d *DeleteRoiHandler) DeleteRoi(c echo.Context) error {
@@ -66,7 +75,13 @@ func (d *DeleteRoiHandler) DeleteRoi(c echo.Context) error {
 		})
 	}
 
+	// 조회 시 받은 ETag (없으면 428, 다르면 409)
+	req.IfMatch = c.Request().Header.Get("If-Match")
+
 	res, err := d.UseCase.DeleteRoi(ctx, projectID, req)
+	if common.IsErrType(err, common.ErrPreconditionRequired) || common.IsErrType(err, common.ErrConflict) {
+		return err
+	}
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"success": false,
@@ -74,5 +89,6 @@ func (d *DeleteRoiHandler) DeleteRoi(c echo.Context) error {
 		})
 	}
 
+	c.Response().Header().Set("ETag", res.Version)
 	return c.JSON(http.StatusOK, res)
 }
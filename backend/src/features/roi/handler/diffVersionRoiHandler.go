@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/roi/model/interface"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type DiffVersionRoiHandler struct {
+	UseCase _interface.IDiffVersionRoiUseCase
+}
+
+func NewDiffVersionRoiHandler(c *echo.Group, useCase _interface.IDiffVersionRoiUseCase) _interface.IDiffVersionRoiHandler {
+	handler := &DiffVersionRoiHandler{
+		UseCase: useCase,
+	}
+	c.GET("/:projectId/versions/diff", handler.DiffVersions)
+	return handler
+}
+
+// DiffVersions ROI 버전 비교
+// @Router /v0.1/roi/{projectId}/versions/diff [get]
+// @Summary ROI 버전 비교
+// @Description
+// @Description 두 버전 사이에서 CCTV별로 추가/삭제된 parking_id와 좌표가 바뀐 parking_id(꼭짓점 최대 이동 거리)를 반환합니다.
+// @Description to에 draft를 넣으면 현재 초안과 비교합니다. 좌표가 빈 주차면은 삭제된 것으로 봅니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 파라미터 오류
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_SERVER : 내부 로직 처리 실패
+// @Description
+// @Produce json
+// @Param        projectId   path      string  true  "Project ID"
+// @Param        base        query     string  true  "ROI 이름"
+// @Param        from        query     string  true  "기준 버전"
+// @Param        to          query     string  true  "비교 버전 (draft 가능)"
+// @Success 200 {object} response.ResRoiVersionDiff
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags roi
+func (d *DiffVersionRoiHandler) DiffVersions(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	projectID := c.Param("projectId")
+	baseName := c.QueryParam("base")
+	from := c.QueryParam("from")
+	to := c.QueryParam("to")
+	if baseName == "" || from == "" || to == "" {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "base, from, to 파라미터가 필요합니다",
+		})
+	}
+
+	res, err := d.UseCase.DiffVersions(ctx, projectID, baseName, from, to)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"success": false,
+			"message": "버전 비교 중 오류가 발생했습니다: " + err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
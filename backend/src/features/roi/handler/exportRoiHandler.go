@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/roi/model/interface"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ExportRoiHandler struct {
+	UseCase _interface.IExportRoiUseCase
+}
+
+func NewExportRoiHandler(c *echo.Group, useCase _interface.IExportRoiUseCase) _interface.IExportRoiHandler {
+	handler := &ExportRoiHandler{
+		UseCase: useCase,
+	}
+	c.GET("/:projectId/export", handler.ExportRoi)
+	return handler
+}
+
+// ExportRoi ROI 내보내기
+// @Router /v0.1/roi/{projectId}/export [get]
+// @Summary ROI 내보내기
+// @Description
+// @Description ROI 파일을 외부 라벨링 도구 형식으로 내려받습니다.
+// @Description cvat : CVAT for images 1.1 XML (CCTV마다 image, 주차면은 parking 라벨 polygon + parking_id/cctv_id/camera_key 속성)
+// @Description labelme : LabelMe JSON (CCTV마다 파일, parking_id가 라벨, CCTV가 둘 이상이면 zip)
+// @Description geojson : GeoJSON FeatureCollection (이미지 픽셀 좌표, properties에 parking_id/cctv_id/camera_key)
+// @Description 좌표가 빈(삭제된) ROI는 포함하지 않습니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 파라미터 오류 (지원하지 않는 format, source)
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_SERVER : 내부 로직 처리 실패
+// @Description
+// @Produce application/xml
+// @Produce application/json
+// @Produce application/zip
+// @Param        projectId   path      string  true   "Project ID"
+// @Param        format      query     string  true   "cvat, labelme, geojson"
+// @Param        roi_file    query     string  true   "ROI 파일 이름 (확장자 제외)"
+// @Param        source      query     string  false  "draft 또는 original (없으면 초안이 있을 때 초안)"
+// @Success 200 {file} file
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags roi
+func (d *ExportRoiHandler) ExportRoi(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	projectID := c.Param("projectId")
+	roiFile := c.QueryParam("roi_file")
+	if roiFile == "" {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "roi_file이 필요합니다",
+		})
+	}
+
+	file, err := d.UseCase.ExportRoi(ctx, projectID, roiFile, c.QueryParam("source"), c.QueryParam("format"))
+	if common.IsErrType(err, common.ErrBadParameter) {
+		return err
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"success": false,
+			"message": "ROI 내보내기 중 오류가 발생했습니다: " + err.Error(),
+		})
+	}
+
+	c.Response().Header().Set("Content-Disposition", `attachment; filename="`+file.Name+`"`)
+	return c.Blob(http.StatusOK, file.ContentType, file.Data)
+}
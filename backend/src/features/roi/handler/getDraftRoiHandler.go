@@ -12,11 +12,11 @@ type GetDraftRoiHandler struct {
 	UseCase _interface.IGetDraftRoiUseCase
 }
 
-func NewGetDraftRoiHandler(c *echo.Echo, useCase _interface.IGetDraftRoiUseCase) _interface.IGetDraftRoiHandler {
+func NewGetDraftRoiHandler(c *echo.Group, useCase _interface.IGetDraftRoiUseCase) _interface.IGetDraftRoiHandler {
 	handler := &GetDraftRoiHandler{
 		UseCase: useCase,
 	}
-	c.GET("/v0.1/roi/:projectId/draft", handler.GetDraftRoi)
+	c.GET("/:projectId/draft", handler.GetDraftRoi)
 	return handler
 }
 
@@ -25,6 +25,7 @@ func NewGetDraftRoiHandler(c *echo.Echo, useCase _interface.IGetDraftRoiUseCase)
 // @Summary ROI 초안 조회
 // @Description
 // @Description 초안 JSON 파일을 읽어서 필요한 정보만 응답합니다.
+// @Description 응답의 ETag 헤더(version)를 ROI 생성/수정/삭제 요청의 If-Match에 넣어야 합니다.
 // @Description
 // @Description ■ errCode with 400
 // @Description PARAM_BAD : 파라미터 오류
@@ -67,5 +68,6 @@ func (d *GetDraftRoiHandler) GetDraftRoi(c echo.Context) error {
 		})
 	}
 
+	c.Response().Header().Set("ETag", res.Version)
 	return c.JSON(http.StatusOK, res)
 }
@@ -12,11 +12,11 @@ type GetImageRoiHandler struct {
 	UseCase _interface.IGetImageRoiUseCase
 }
 
-func NewGetImageRoiHandler(c *echo.Echo, useCase _interface.IGetImageRoiUseCase) _interface.IGetImageRoiHandler {
+func NewGetImageRoiHandler(c *echo.Group, useCase _interface.IGetImageRoiUseCase) _interface.IGetImageRoiHandler {
 	handler := &GetImageRoiHandler{
 		UseCase: useCase,
 	}
-	c.GET("/v0.1/roi/:projectId/:folderPath", handler.GetImageRoi)
+	c.GET("/:projectId/:folderPath", handler.GetImageRoi)
 	return handler
 }
 
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/roi/model/interface"
+	"main/features/roi/model/request"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ImportRoiHandler struct {
+	UseCase _interface.IImportRoiUseCase
+}
+
+func NewImportRoiHandler(c *echo.Group, useCase _interface.IImportRoiUseCase) _interface.IImportRoiHandler {
+	handler := &ImportRoiHandler{
+		UseCase: useCase,
+	}
+	c.POST("/:projectId/import", handler.ImportRoi)
+	return handler
+}
+
+// ImportRoi ROI 가져오기
+// @Router /v0.1/roi/{projectId}/import [post]
+// @Summary ROI 가져오기
+// @Description
+// @Description CVAT XML, LabelMe JSON(여러 파일은 zip), GeoJSON 파일을 {roi_file}_draft.json 초안에 반영합니다.
+// @Description 기존 초안(없으면 원본 ROI 파일)에서 파일에 있는 CCTV만 교체하고, 나머지 CCTV와 기존 주차면의 추가 필드(roi_id 등)는 유지합니다.
+// @Description parking_id는 CVAT parking_id 속성(없으면 라벨), LabelMe 라벨, GeoJSON properties.parking_id에서 읽습니다.
+// @Description cctv_id는 속성/properties가 없으면 이미지 이름({cctvId}.jpg, {cctvId}_Current.jpg)에서 읽습니다.
+// @Description IP 키는 기존 초안(없으면 원본 ROI 파일)에서 같은 cctv_id의 키를 사용합니다. 좌표는 정수 픽셀로 반올림합니다.
+// @Description 초안이 이미 있으면 If-Match가 필요합니다. 형상 오류는 초안에 그대로 저장하고 errors로 알려줍니다 (초안 저장 전에 수정 필요).
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 파라미터 오류 (지원하지 않는 format, 파일 형식 오류, parking_id 누락/중복 등)
+// @Description
+// @Description ■ errCode with 409
+// @Description CONFLICT : draft 조회 이후 다른 수정이 있었음 (다시 조회 후 재시도)
+// @Description
+// @Description ■ errCode with 428
+// @Description PRECONDITION_REQUIRED : 초안이 있는데 If-Match 헤더 누락
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_SERVER : 내부 로직 처리 실패
+// @Description
+// @Accept multipart/form-data
+// @Produce json
+// @Param        projectId   path      string  true   "Project ID"
+// @Param        If-Match    header    string  false  "draft 조회 시 받은 ETag (초안이 있으면 필수)"
+// @Param        roi_file    formData  string  true   "ROI 파일 이름 (확장자 제외)"
+// @Param        format      formData  string  true   "cvat, labelme, geojson"
+// @Param        file        formData  file    true   "가져올 파일"
+// @Success 200 {object} response.ResImportRoi
+// @Failure 400 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 428 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags roi
+func (d *ImportRoiHandler) ImportRoi(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	projectID := c.Param("projectId")
+	req := request.ImportRoiRequest{
+		RoiFile: c.FormValue("roi_file"),
+		Format:  c.FormValue("format"),
+		IfMatch: c.Request().Header.Get("If-Match"),
+	}
+	if req.RoiFile == "" || req.Format == "" {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "roi_file과 format이 필요합니다",
+		})
+	}
+	file, err := c.FormFile("file")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "가져올 파일이 없습니다. 'file' 키로 전송해주세요.",
+		})
+	}
+
+	res, err := d.UseCase.ImportRoi(ctx, projectID, req, file)
+	if common.IsErrType(err, common.ErrBadParameter) || common.IsErrType(err, common.ErrPreconditionRequired) || common.IsErrType(err, common.ErrConflict) {
+		return err
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"success": false,
+			"message": "ROI 가져오기 중 오류가 발생했습니다: " + err.Error(),
+		})
+	}
+
+	c.Response().Header().Set("ETag", res.Version)
+	return c.JSON(http.StatusOK, res)
+}
@@ -4,6 +4,7 @@ import (
 	"main/common/db/mysql"
 	"main/features/roi/repository"
 	"main/features/roi/usecase"
+	_middleware "main/middleware"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -21,6 +22,13 @@ func NewRoiHandler(e *echo.Echo) error {
 	updateRoiRepo := repository.NewUpdateRoiRepository(mysql.GormMysqlDB)
 	deleteRoiRepo := repository.NewDeleteRoiRepository(mysql.GormMysqlDB)
 	getImageRoiRepo := repository.NewGetImageRoiRepository(mysql.GormMysqlDB)
+	listVersionRoiRepo := repository.NewListVersionRoiRepository(mysql.GormMysqlDB)
+	diffVersionRoiRepo := repository.NewDiffVersionRoiRepository(mysql.GormMysqlDB)
+	restoreVersionRoiRepo := repository.NewRestoreVersionRoiRepository(mysql.GormMysqlDB)
+	transformRoiRepo := repository.NewTransformRoiRepository(mysql.GormMysqlDB)
+	exportRoiRepo := repository.NewExportRoiRepository(mysql.GormMysqlDB)
+	renderRoiRepo := repository.NewRenderRoiRepository(mysql.GormMysqlDB)
+	importRoiRepo := repository.NewImportRoiRepository(mysql.GormMysqlDB)
 	// UseCase 초기화
 	uploadRoiUseCase := usecase.NewUploadRoiUseCase(uploadRoiRepo, 30*time.Second)
 	testStatsRoiUseCase := usecase.NewTestStatsRoiUseCase(testStatsRoiRepo, 30*time.Second)
@@ -32,17 +40,34 @@ func NewRoiHandler(e *echo.Echo) error {
 	updateRoiUseCase := usecase.NewUpdateRoiUseCase(updateRoiRepo, 30*time.Second)
 	deleteRoiUseCase := usecase.NewDeleteRoiUseCase(deleteRoiRepo, 30*time.Second)
 	getImageRoiUseCase := usecase.NewGetImageRoiUseCase(getImageRoiRepo, 30*time.Second)
+	listVersionRoiUseCase := usecase.NewListVersionRoiUseCase(listVersionRoiRepo, 30*time.Second)
+	diffVersionRoiUseCase := usecase.NewDiffVersionRoiUseCase(diffVersionRoiRepo, 30*time.Second)
+	restoreVersionRoiUseCase := usecase.NewRestoreVersionRoiUseCase(restoreVersionRoiRepo, createDraftRoiUseCase, 30*time.Second)
+	transformRoiUseCase := usecase.NewTransformRoiUseCase(transformRoiRepo, 30*time.Second)
+	exportRoiUseCase := usecase.NewExportRoiUseCase(exportRoiRepo, 30*time.Second)
+	renderRoiUseCase := usecase.NewRenderRoiUseCase(renderRoiRepo, 30*time.Second)
+	importRoiUseCase := usecase.NewImportRoiUseCase(importRoiRepo, 30*time.Second)
+
+	// 라우팅 그룹 (프로젝트 검증 및 작업 폴더 설정, 토큰이 있으면 작성자 기록용으로 사용자 확인)
+	roiGroup := e.Group("/v0.1/roi", _middleware.ProjectScope, _middleware.OptionalTokenChecker)
 
 	// Handler 초기화 (구체적인 라우팅을 먼저 등록)
-	NewCreateRoiHandler(e, createRoiUseCase)
-	NewReadRoiHandler(e, readRoiUseCase)
-	NewUpdateRoiHandler(e, updateRoiUseCase)
-	NewDeleteRoiHandler(e, deleteRoiUseCase)
-	NewCreateDraftRoiHandler(e, createDraftRoiUseCase)
-	NewGetDraftRoiHandler(e, getDraftRoiUseCase)
-	NewSaveDraftRoiHandler(e, saveDraftRoiUseCase)
-	NewUploadRoiHandler(e, uploadRoiUseCase)
-	NewTestStatsRoiHandler(e, testStatsRoiUseCase)
-	NewGetImageRoiHandler(e, getImageRoiUseCase)
+	NewCreateRoiHandler(roiGroup, createRoiUseCase)
+	NewListVersionRoiHandler(roiGroup, listVersionRoiUseCase)
+	NewDiffVersionRoiHandler(roiGroup, diffVersionRoiUseCase)
+	NewRestoreVersionRoiHandler(roiGroup, restoreVersionRoiUseCase)
+	NewReadRoiHandler(roiGroup, readRoiUseCase)
+	NewUpdateRoiHandler(roiGroup, updateRoiUseCase)
+	NewDeleteRoiHandler(roiGroup, deleteRoiUseCase)
+	NewTransformRoiHandler(roiGroup, transformRoiUseCase)
+	NewExportRoiHandler(roiGroup, exportRoiUseCase)
+	NewRenderRoiHandler(roiGroup, renderRoiUseCase)
+	NewImportRoiHandler(roiGroup, importRoiUseCase)
+	NewCreateDraftRoiHandler(roiGroup, createDraftRoiUseCase)
+	NewGetDraftRoiHandler(roiGroup, getDraftRoiUseCase)
+	NewSaveDraftRoiHandler(roiGroup, saveDraftRoiUseCase)
+	NewUploadRoiHandler(roiGroup, uploadRoiUseCase)
+	NewTestStatsRoiHandler(roiGroup, testStatsRoiUseCase)
+	NewGetImageRoiHandler(roiGroup, getImageRoiUseCase)
 	return nil
 }
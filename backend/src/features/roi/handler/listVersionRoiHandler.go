@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/roi/model/interface"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ListVersionRoiHandler struct {
+	UseCase _interface.IListVersionRoiUseCase
+}
+
+func NewListVersionRoiHandler(c *echo.Group, useCase _interface.IListVersionRoiUseCase) _interface.IListVersionRoiHandler {
+	handler := &ListVersionRoiHandler{
+		UseCase: useCase,
+	}
+	c.GET("/:projectId/versions", handler.ListVersions)
+	return handler
+}
+
+// ListVersions ROI 버전 목록
+// @Router /v0.1/roi/{projectId}/versions [get]
+// @Summary ROI 버전 목록
+// @Description
+// @Description 원본 ROI 파일({base}.json)과 초안 저장으로 만들어진 버전({base}_YYYYMMDD_HHMMSS.json)을 최신순으로 조회합니다.
+// @Description 작성자는 저장할 때 tkn 헤더가 있었던 버전만 채워집니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 파라미터 오류
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_SERVER : 내부 로직 처리 실패
+// @Description
+// @Produce json
+// @Param        projectId   path      string  true  "Project ID"
+// @Param        base        query     string  true  "ROI 이름 (확장자, 날짜 제외)"
+// @Success 200 {object} response.ResRoiVersions
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags roi
+func (d *ListVersionRoiHandler) ListVersions(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	projectID := c.Param("projectId")
+	baseName := c.QueryParam("base")
+	if baseName == "" {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "base 파라미터가 필요합니다",
+		})
+	}
+
+	res, err := d.UseCase.ListVersions(ctx, projectID, baseName)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"success": false,
+			"message": "버전 목록 조회 중 오류가 발생했습니다: " + err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
@@ -13,11 +13,11 @@ type ReadRoiHandler struct {
 	UseCase _interface.IReadRoiUseCase
 }
 
-func NewReadRoiHandler(c *echo.Echo, useCase _interface.IReadRoiUseCase) _interface.IReadRoiHandler {
+func NewReadRoiHandler(c *echo.Group, useCase _interface.IReadRoiUseCase) _interface.IReadRoiHandler {
 	handler := &ReadRoiHandler{
 		UseCase: useCase,
 	}
-	c.POST("/v0.1/roi/:projectId/read", handler.ReadRoi)
+	c.POST("/:projectId/read", handler.ReadRoi)
 	return handler
 }
 
@@ -26,6 +26,7 @@ func NewReadRoiHandler(c *echo.Echo, useCase _interface.IReadRoiUseCase) _interf
 // @Summary ROI 읽기
 // @Description
 // @Description CCTV ID에 해당하는 모든 ROI 좌표를 배열로 반환합니다.
+// @Description 응답의 ETag 헤더(version)를 ROI 생성/수정/삭제 요청의 If-Match에 넣어야 합니다.
 // @Description
 // @Description ■ errCode with 400
 // @Description PARAM_BAD : 파라미터 오류
@@ -81,5 +82,8 @@ func (d *ReadRoiHandler) ReadRoi(c echo.Context) error {
 		})
 	}
 
+	if res.Version != "" {
+		c.Response().Header().Set("ETag", res.Version)
+	}
 	return c.JSON(http.StatusOK, res)
 }
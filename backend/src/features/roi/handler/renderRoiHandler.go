@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/roi/model/interface"
+	"main/features/roi/model/request"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type RenderRoiHandler struct {
+	UseCase _interface.IRenderRoiUseCase
+}
+
+func NewRenderRoiHandler(c *echo.Group, useCase _interface.IRenderRoiUseCase) _interface.IRenderRoiHandler {
+	handler := &RenderRoiHandler{
+		UseCase: useCase,
+	}
+	c.GET("/:projectId/render", handler.RenderRoi)
+	return handler
+}
+
+// RenderRoi ROI 오버레이 이미지
+// @Router /v0.1/roi/{projectId}/render [get]
+// @Summary ROI 오버레이 이미지
+// @Description
+// @Description 프로젝트 이미지 위에 CCTV의 ROI 다각형과 parking_id 라벨을 그린 이미지를 받습니다.
+// @Description 학습을 돌리지 않아도 현재 초안(또는 원본) ROI가 이미지와 맞는지 확인할 수 있습니다.
+// @Description experiment_id를 주면 해당 실험의 전경 비율로 점유(빨강)/빈 자리(초록)를 칠하고 라벨에 비율을 표시합니다.
+// @Description 결과가 없는 주차면과 experiment_id가 없을 때는 파랑으로 그립니다.
+// @Description 선 굵기와 글자 크기는 이미지 해상도에 맞춰 커집니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 파라미터 오류 (잘못된 base, format, 파일 이름, 이미지 형식)
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 이미지, CCTV 또는 실험을 찾을 수 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_SERVER : 내부 로직 처리 실패
+// @Description INTERNAL_DB : DB 처리 실패
+// @Description
+// @Produce image/png
+// @Produce image/jpeg
+// @Param        projectId      path      string  true   "Project ID"
+// @Param        file           query     string  true   "이미지 파일 이름"
+// @Param        roi_file       query     string  true   "ROI 파일 이름 (확장자 제외)"
+// @Param        base           query     string  false  "test(기본, uploads/testImages) 또는 current(currentImages)"
+// @Param        folder         query     string  false  "base 아래 폴더"
+// @Param        source         query     string  false  "draft 또는 original (없으면 초안이 있을 때 초안)"
+// @Param        cctv_id        query     string  false  "CCTV ID (없으면 이미지 이름에서 추출)"
+// @Param        experiment_id  query     int     false  "점유 색상에 쓸 실험 ID"
+// @Param        format         query     string  false  "png(기본) 또는 jpeg"
+// @Success 200 {file} file
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags roi
+func (d *RenderRoiHandler) RenderRoi(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	projectID := c.Param("projectId")
+	var req request.RenderRoiRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "잘못된 요청 형식입니다: " + err.Error(),
+		})
+	}
+	if req.File == "" || req.RoiFile == "" {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "file과 roi_file이 필요합니다",
+		})
+	}
+
+	res, err := d.UseCase.RenderRoi(ctx, projectID, req)
+	if common.IsErrType(err, common.ErrBadParameter) || common.IsErrType(err, common.ErrNotFound) || common.IsErrType(err, common.ErrInternalDB) {
+		return err
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"success": false,
+			"message": "ROI 오버레이 생성 중 오류가 발생했습니다: " + err.Error(),
+		})
+	}
+
+	return c.Blob(http.StatusOK, res.ContentType, res.Data)
+}
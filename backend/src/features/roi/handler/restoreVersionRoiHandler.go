@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/roi/model/interface"
+	"main/features/roi/model/request"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type RestoreVersionRoiHandler struct {
+	UseCase _interface.IRestoreVersionRoiUseCase
+}
+
+func NewRestoreVersionRoiHandler(c *echo.Group, useCase _interface.IRestoreVersionRoiUseCase) _interface.IRestoreVersionRoiHandler {
+	handler := &RestoreVersionRoiHandler{
+		UseCase: useCase,
+	}
+	c.POST("/:projectId/versions/restore", handler.RestoreVersion)
+	return handler
+}
+
+// RestoreVersion ROI 버전 복원
+// @Router /v0.1/roi/{projectId}/versions/restore [post]
+// @Summary ROI 버전 복원
+// @Description
+// @Description 선택한 버전을 {base_name}_draft.json 초안으로 복사합니다. 초안이 이미 있으면 If-Match가 필요합니다.
+// @Description 복원한 초안은 기존 초안 편집/저장 API로 그대로 수정하고 새 버전으로 저장할 수 있습니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 파라미터 오류
+// @Description
+// @Description ■ errCode with 409
+// @Description CONFLICT : draft 조회 이후 다른 수정이 있었음 (다시 조회 후 재시도)
+// @Description
+// @Description ■ errCode with 428
+// @Description PRECONDITION_REQUIRED : 초안이 있는데 If-Match 헤더 누락
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_SERVER : 내부 로직 처리 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param        projectId   path      string  true  "Project ID"
+// @Param        If-Match    header    string  false "초안 ETag (초안이 이미 있을 때 필수)"
+// @Param        request     body      request.RestoreRoiVersionRequest  true  "Restore ROI Version Request"
+// @Success 200 {object} response.ResRestoreRoiVersion
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags roi
+func (d *RestoreVersionRoiHandler) RestoreVersion(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	projectID := c.Param("projectId")
+	var req request.RestoreRoiVersionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "요청 데이터 파싱 실패: " + err.Error(),
+		})
+	}
+	if req.BaseName == "" || req.Version == "" {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "base_name과 version이 필요합니다",
+		})
+	}
+
+	req.IfMatch = c.Request().Header.Get("If-Match")
+
+	res, err := d.UseCase.RestoreVersion(ctx, projectID, req)
+	if common.IsErrType(err, common.ErrPreconditionRequired) || common.IsErrType(err, common.ErrConflict) {
+		return err
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"success": false,
+			"message": "버전 복원 중 오류가 발생했습니다: " + err.Error(),
+		})
+	}
+
+	c.Response().Header().Set("ETag", res.DraftETag)
+	return c.JSON(http.StatusOK, res)
+}
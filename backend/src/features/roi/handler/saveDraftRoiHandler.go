@@ -12,11 +12,11 @@ type SaveDraftRoiHandler struct {
 	UseCase _interface.ISaveDraftRoiUseCase
 }
 
-func NewSaveDraftRoiHandler(c *echo.Echo, useCase _interface.ISaveDraftRoiUseCase) _interface.ISaveDraftRoiHandler {
+func NewSaveDraftRoiHandler(c *echo.Group, useCase _interface.ISaveDraftRoiUseCase) _interface.ISaveDraftRoiHandler {
 	handler := &SaveDraftRoiHandler{
 		UseCase: useCase,
 	}
-	c.POST("/v0.1/roi/:projectId/draft/save", handler.SaveDraftRoi)
+	c.POST("/:projectId/draft/save", handler.SaveDraftRoi)
 	return handler
 }
 
@@ -25,6 +25,11 @@ func NewSaveDraftRoiHandler(c *echo.Echo, useCase _interface.ISaveDraftRoiUseCas
 // @Summary ROI 초안 저장
 // @Description
 // @Description 초안 JSON 파일을 현재 날짜를 붙여서 roi 폴더에 저장합니다.
+// @Description 저장한 파일은 원본 이름의 버전으로 기록되고, tkn 헤더가 있으면 작성자도 함께 기록합니다.
+// @Description
+// @Description 꼬인 다각형, 최소 넓이 미만, 기준 이미지 범위를 벗어난 좌표, 다른 주차면과 80% 이상 겹침은 저장하지 않고 400과 errors를 반환합니다.
+// @Description 5% 이상 겹침, 기준 이미지 없음은 저장 후 warnings로 알려줍니다.
+// @Description 저장 시 각 CCTV의 기준 이미지를 카메라 흔들림 검사용 기준 프레임으로 함께 저장합니다 (references).
 // @Description
 // @Description ■ errCode with 400
 // @Description PARAM_BAD : 파라미터 오류
@@ -35,13 +40,14 @@ func NewSaveDraftRoiHandler(c *echo.Echo, useCase _interface.ISaveDraftRoiUseCas
 // @Accept json
 // @Produce json
 // @Param        projectId   path      string  true  "Project ID"
+// @Param        tkn         header    string  false "Access Token (작성자 기록용)"
 // @Param        file        query     string  true  "ROI File Name"
 // @Success 200 {object} response.ResSaveDraft
 // @Failure 400 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
 // @Tags roi
 func (d *SaveDraftRoiHandler) SaveDraftRoi(c echo.Context) error {
-	ctx, _, _ := common.CtxGenerate(c)
+	ctx, userID, email := common.CtxGenerate(c)
 
 	projectID := c.Param("projectId")
 	if projectID == "" {
@@ -59,7 +65,7 @@ func (d *SaveDraftRoiHandler) SaveDraftRoi(c echo.Context) error {
 		})
 	}
 
-	res, err := d.UseCase.SaveDraftRoi(ctx, projectID, roiFileName)
+	res, err := d.UseCase.SaveDraftRoi(ctx, projectID, roiFileName, userID, email)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"success": false,
@@ -67,5 +73,10 @@ func (d *SaveDraftRoiHandler) SaveDraftRoi(c echo.Context) error {
 		})
 	}
 
+	// 형상 검사 오류 (errors에 사유)
+	if !res.Success {
+		return c.JSON(http.StatusBadRequest, res)
+	}
+
 	return c.JSON(http.StatusOK, res)
 }
@@ -12,11 +12,11 @@ type TestStatsRoiHandler struct {
 	UseCase _interface.ITestStatsRoiUseCase
 }
 
-func NewTestStatsRoiHandler(c *echo.Echo, useCase _interface.ITestStatsRoiUseCase) _interface.ITestStatsRoiHandler {
+func NewTestStatsRoiHandler(c *echo.Group, useCase _interface.ITestStatsRoiUseCase) _interface.ITestStatsRoiHandler {
 	handler := &TestStatsRoiHandler{
 		UseCase: useCase,
 	}
-	c.GET("/v0.1/roi/:projectId/:folderPath/images", handler.GetTestStats)
+	c.GET("/:projectId/:folderPath/images", handler.GetTestStats)
 	return handler
 }
 
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/roi/model/interface"
+	"main/features/roi/model/request"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TransformRoiHandler struct {
+	UseCase _interface.ITransformRoiUseCase
+}
+
+func NewTransformRoiHandler(c *echo.Group, useCase _interface.ITransformRoiUseCase) _interface.ITransformRoiHandler {
+	handler := &TransformRoiHandler{
+		UseCase: useCase,
+	}
+	c.POST("/:projectId/transform", handler.TransformRoi)
+	return handler
+}
+
+// TransformRoi CCTV 하나의 ROI 일괄 변환
+// @Router /v0.1/roi/{projectId}/transform [post]
+// @Summary ROI 일괄 변환
+// @Description
+// @Description 카메라가 틀어졌을 때 draft에서 해당 cctv_id의 모든 ROI에 같은 아핀 변환을 적용합니다.
+// @Description translate(dx, dy), matrix(2x3 행렬 [a, b, tx, c, d, ty]), points(이전/새 프레임 대응점 3쌍 이상) 중 하나를 지정합니다.
+// @Description points는 최소제곱으로 행렬을 추정하고 residual(RMS, 픽셀)을 함께 반환합니다. 결과 좌표는 정수 픽셀로 반올림합니다.
+// @Description
+// @Description preview가 true면 저장하지 않고 변환 결과와 형상 검사 결과만 반환합니다 (If-Match 불필요).
+// @Description preview가 false면 If-Match가 필요하며, 형상 검사 오류가 있으면 저장하지 않고 400과 errors를 반환합니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 파라미터 오류 (변환 지정 누락/중복, 역변환 없는 행렬, 한 직선 위의 대응점 등)
+// @Description
+// @Description ■ errCode with 409
+// @Description CONFLICT : draft 조회 이후 다른 수정이 있었음 (다시 조회 후 재시도)
+// @Description
+// @Description ■ errCode with 428
+// @Description PRECONDITION_REQUIRED : If-Match 헤더 누락
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_SERVER : 내부 로직 처리 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param        projectId   path      string  true   "Project ID"
+// @Param        If-Match    header    string  false  "draft 조회 시 받은 ETag (preview가 아니면 필수)"
+// @Param        request     body      request.TransformRoiRequest  true  "Transform ROI Request"
+// @Success 200 {object} response.ResTransformRoi
+// @Failure 400 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 428 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags roi
+func (d *TransformRoiHandler) TransformRoi(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	projectID := c.Param("projectId")
+	var req request.TransformRoiRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "요청 데이터 파싱 실패: " + err.Error(),
+		})
+	}
+	if req.RoiFile == "" || req.CctvID == "" {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "roi_file과 cctv_id가 필요합니다",
+		})
+	}
+
+	// 조회 시 받은 ETag (저장할 때 없으면 428, 다르면 409)
+	req.IfMatch = c.Request().Header.Get("If-Match")
+
+	res, err := d.UseCase.TransformRoi(ctx, projectID, req)
+	if common.IsErrType(err, common.ErrBadParameter) || common.IsErrType(err, common.ErrPreconditionRequired) || common.IsErrType(err, common.ErrConflict) {
+		return err
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"success": false,
+			"message": "ROI 변환 중 오류가 발생했습니다: " + err.Error(),
+		})
+	}
+
+	// 형상 검사 오류 (errors에 사유)
+	if !res.Success {
+		return c.JSON(http.StatusBadRequest, res)
+	}
+
+	if res.Version != "" {
+		c.Response().Header().Set("ETag", res.Version)
+	}
+	return c.JSON(http.StatusOK, res)
+}
@@ -13,11 +13,11 @@ type UpdateRoiHandler struct {
 	UseCase _interface.IUpdateRoiUseCase
 }
 
-func NewUpdateRoiHandler(c *echo.Echo, useCase _interface.IUpdateRoiUseCase) _interface.IUpdateRoiHandler {
+func NewUpdateRoiHandler(c *echo.Group, useCase _interface.IUpdateRoiUseCase) _interface.IUpdateRoiHandler {
 	handler := &UpdateRoiHandler{
 		UseCase: useCase,
 	}
-	c.PUT("/v0.1/roi/:projectId/update", handler.UpdateRoi)
+	c.PUT("/:projectId/update", handler.UpdateRoi)
 	return handler
 }
 
@@ -27,18 +27,30 @@ func NewUpdateRoiHandler(c *echo.Echo, useCase _interface.IUpdateRoiUseCase) _in
 // @Description
 // @Description CCTV ID와 ROI ID에 해당하는 좌표를 새로운 좌표로 변경합니다.
 // @Description
+// @Description 꼬인 다각형, 최소 넓이 미만, 기준 이미지 범위를 벗어난 좌표, 다른 주차면과 80% 이상 겹침은 저장하지 않고 400과 errors를 반환합니다.
+// @Description 5% 이상 겹침, 기준 이미지 없음은 저장 후 warnings로 알려줍니다.
+// @Description
 // @Description ■ errCode with 400
 // @Description PARAM_BAD : 파라미터 오류
 // @Description
+// @Description ■ errCode with 409
+// @Description CONFLICT : draft 조회 이후 다른 수정이 있었음 (다시 조회 후 재시도)
+// @Description
+// @Description ■ errCode with 428
+// @Description PRECONDITION_REQUIRED : If-Match 헤더 누락
+// @Description
 // @Description ■ errCode with 500
 // @Description INTERNAL_SERVER : 내부 로직 처리 실패
 // @Description
 // @Accept json
 // @Produce json
 // @Param        projectId   path      string  true  "Project ID"
+// @Param        If-Match    header    string  true  "draft 조회 시 받은 ETag (* 는 항상 일치)"
 // @Param        request     body      request.UpdateRoiRequest  true  "Update ROI Request"
 // @Success 200 {object} response.ResUpdateRoi
 // @Failure 400 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 428 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
 // @Tags roi
 func (d *UpdateRoiHandler) UpdateRoi(c echo.Context) error {
@@ -66,7 +78,13 @@ func (d *UpdateRoiHandler) UpdateRoi(c echo.Context) error {
 		})
 	}
 
+	// 조회 시 받은 ETag (없으면 428, 다르면 409)
+	req.IfMatch = c.Request().Header.Get("If-Match")
+
 	res, err := d.UseCase.UpdateRoi(ctx, projectID, req)
+	if common.IsErrType(err, common.ErrPreconditionRequired) || common.IsErrType(err, common.ErrConflict) {
+		return err
+	}
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"success": false,
@@ -74,5 +92,11 @@ func (d *UpdateRoiHandler) UpdateRoi(c echo.Context) error {
 		})
 	}
 
+	// 형상 검사 오류 (errors에 사유)
+	if !res.Success {
+		return c.JSON(http.StatusBadRequest, res)
+	}
+
+	c.Response().Header().Set("ETag", res.Version)
 	return c.JSON(http.StatusOK, res)
 }
@@ -13,11 +13,11 @@ type UploadRoiHandler struct {
 	UseCase _interface.IUploadRoiUseCase
 }
 
-func NewUploadRoiHandler(c *echo.Echo, useCase _interface.IUploadRoiUseCase) _interface.IUploadRoiHandler {
+func NewUploadRoiHandler(c *echo.Group, useCase _interface.IUploadRoiUseCase) _interface.IUploadRoiHandler {
 	handler := &UploadRoiHandler{
 		UseCase: useCase,
 	}
-	c.POST("/v0.1/roi/:projectId/test-images", handler.UploadRoi)
+	c.POST("/:projectId/test-images", handler.UploadRoi)
 	return handler
 }
 
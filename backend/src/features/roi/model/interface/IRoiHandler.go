@@ -42,3 +42,32 @@ type IDeleteRoiHandler interface {
 type IGetImageRoiHandler interface {
 	GetImageRoi(c echo.Context) error
 }
+
+// ROI 버전 Handler 인터페이스들
+type IListVersionRoiHandler interface {
+	ListVersions(c echo.Context) error
+}
+
+type IDiffVersionRoiHandler interface {
+	DiffVersions(c echo.Context) error
+}
+
+type IRestoreVersionRoiHandler interface {
+	RestoreVersion(c echo.Context) error
+}
+
+type ITransformRoiHandler interface {
+	TransformRoi(c echo.Context) error
+}
+
+type IExportRoiHandler interface {
+	ExportRoi(c echo.Context) error
+}
+
+type IImportRoiHandler interface {
+	ImportRoi(c echo.Context) error
+}
+
+type IRenderRoiHandler interface {
+	RenderRoi(c echo.Context) error
+}
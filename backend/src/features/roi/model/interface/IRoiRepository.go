@@ -1,5 +1,10 @@
 package _interface
 
+import (
+	"context"
+	"main/common/db/mysql"
+)
+
 type IUploadRoiRepository interface {
 }
 
@@ -13,6 +18,7 @@ type IGetDraftRoiRepository interface {
 }
 
 type ISaveDraftRoiRepository interface {
+	CreateRoiVersion(ctx context.Context, version mysql.RoiVersions) error
 }
 
 // ROI CRUD Repository 인터페이스들
@@ -30,3 +36,31 @@ type IDeleteRoiRepository interface {
 
 type IGetImageRoiRepository interface {
 }
+
+// ROI 버전 Repository 인터페이스들
+type IListVersionRoiRepository interface {
+	FindRoiVersions(ctx context.Context, projectID string, baseName string) ([]mysql.RoiVersions, error)
+}
+
+type IDiffVersionRoiRepository interface {
+}
+
+type IRestoreVersionRoiRepository interface {
+}
+
+type ITransformRoiRepository interface {
+}
+
+type IExportRoiRepository interface {
+}
+
+type IImportRoiRepository interface {
+}
+
+type IRenderRoiRepository interface {
+	FindExperimentSessionByID(ctx context.Context, experimentID uint) (mysql.ExperimentSessions, error)
+	FindCctvResult(ctx context.Context, experimentID int, cctvID string) (mysql.CctvResults, error)
+	FindRoiResults(ctx context.Context, cctvResultID int) ([]mysql.RoiResults, error)
+	FindOccupancyThresholds(ctx context.Context, projectID string) ([]mysql.OccupancyThresholds, error)
+	FindParkingSpaces(ctx context.Context, projectID string) ([]mysql.ParkingSpaces, error)
+}
@@ -2,6 +2,7 @@ package _interface
 
 import (
 	"context"
+	"main/common/roiformat"
 	"main/features/roi/model/request"
 	"main/features/roi/model/response"
 	"mime/multipart"
@@ -16,7 +17,8 @@ type ITestStatsRoiUseCase interface {
 }
 
 type ICreateDraftRoiUseCase interface {
-	CreateDraftRoi(ctx context.Context, projectID string, originFile string) error
+	CreateDraftRoi(ctx context.Context, projectID string, originFile string, ifMatch string) (string, error)
+	CreateDraftRoiFrom(ctx context.Context, projectID string, sourceFile string, draftName string, ifMatch string) (string, error)
 }
 
 type IGetDraftRoiUseCase interface {
@@ -24,7 +26,7 @@ type IGetDraftRoiUseCase interface {
 }
 
 type ISaveDraftRoiUseCase interface {
-	SaveDraftRoi(ctx context.Context, projectID string, roiFileName string) (response.ResSaveDraft, error)
+	SaveDraftRoi(ctx context.Context, projectID string, roiFileName string, userID uint, email string) (response.ResSaveDraft, error)
 }
 
 // ROI CRUD UseCase 인터페이스들
@@ -47,3 +49,32 @@ type IDeleteRoiUseCase interface {
 type IGetImageRoiUseCase interface {
 	GetImageRoi(ctx context.Context, projectID string, folderPath string, fileName string) (response.ResGetImageRoi, error)
 }
+
+// ROI 버전 UseCase 인터페이스들
+type IListVersionRoiUseCase interface {
+	ListVersions(ctx context.Context, projectID string, baseName string) (response.ResRoiVersions, error)
+}
+
+type IDiffVersionRoiUseCase interface {
+	DiffVersions(ctx context.Context, projectID string, baseName string, from string, to string) (response.ResRoiVersionDiff, error)
+}
+
+type IRestoreVersionRoiUseCase interface {
+	RestoreVersion(ctx context.Context, projectID string, req request.RestoreRoiVersionRequest) (response.ResRestoreRoiVersion, error)
+}
+
+type ITransformRoiUseCase interface {
+	TransformRoi(ctx context.Context, projectID string, req request.TransformRoiRequest) (response.ResTransformRoi, error)
+}
+
+type IExportRoiUseCase interface {
+	ExportRoi(ctx context.Context, projectID string, roiFile string, source string, format string) (roiformat.File, error)
+}
+
+type IImportRoiUseCase interface {
+	ImportRoi(ctx context.Context, projectID string, req request.ImportRoiRequest, file *multipart.FileHeader) (response.ResImportRoi, error)
+}
+
+type IRenderRoiUseCase interface {
+	RenderRoi(ctx context.Context, projectID string, req request.RenderRoiRequest) (response.ResRenderRoi, error)
+}
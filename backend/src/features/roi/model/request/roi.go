@@ -5,6 +5,8 @@ type CreateRoiRequest struct {
 	CctvID  string `json:"cctv_id"`
 	RoiFile string `json:"roi_file"`
 	Coords  []int  `json:"coords"`
+	// IfMatch If-Match 헤더 (draft 조회 시 받은 ETag)
+	IfMatch string `json:"-"`
 }
 
 type ReadRoiRequest struct {
@@ -17,10 +19,74 @@ type UpdateRoiRequest struct {
 	CctvID  string `json:"cctv_id"`
 	RoiFile string `json:"roi_file"`
 	Coords  []int  `json:"coords"`
+	// IfMatch If-Match 헤더 (draft 조회 시 받은 ETag)
+	IfMatch string `json:"-"`
 }
 
 type DeleteRoiRequest struct {
 	RoiID   string `json:"roi_id"`
 	CctvID  string `json:"cctv_id"`
 	RoiFile string `json:"roi_file"`
+	// IfMatch If-Match 헤더 (draft 조회 시 받은 ETag)
+	IfMatch string `json:"-"`
+}
+
+// RestoreRoiVersionRequest 저장된 버전을 base_name의 초안으로 복원
+type RestoreRoiVersionRequest struct {
+	BaseName string `json:"base_name"`
+	Version  string `json:"version"`
+	IfMatch  string `json:"-"` // If-Match 헤더 (초안이 이미 있을 때 필요)
+}
+
+// TransformRoiRequest 한 CCTV의 모든 ROI에 같은 변환 적용
+// translate, matrix, points 중 하나만 사용
+type TransformRoiRequest struct {
+	RoiFile string `json:"roi_file"`
+	CctvID  string `json:"cctv_id"`
+	// Translate 평행 이동 (픽셀)
+	Translate *RoiTranslate `json:"translate,omitempty"`
+	// Matrix 2x3 아핀 행렬 [a, b, tx, c, d, ty]
+	Matrix []float64 `json:"matrix,omitempty"`
+	// Points 이전 프레임 -> 새 프레임 대응점 (3쌍 이상, 최소제곱으로 행렬 추정)
+	Points []RoiPointPair `json:"points,omitempty"`
+	// Preview true면 저장하지 않고 결과만 반환
+	Preview bool `json:"preview"`
+	// IfMatch If-Match 헤더 (draft 조회 시 받은 ETag)
+	IfMatch string `json:"-"`
+}
+
+type RoiTranslate struct {
+	Dx float64 `json:"dx"`
+	Dy float64 `json:"dy"`
+}
+
+// RoiPointPair from은 이전 프레임, to는 새 프레임의 같은 지점 [x, y]
+type RoiPointPair struct {
+	From [2]float64 `json:"from"`
+	To   [2]float64 `json:"to"`
+}
+
+// ImportRoiRequest 외부 라벨링 도구 파일을 초안으로 가져오기 (multipart form: roi_file, format, file)
+type ImportRoiRequest struct {
+	RoiFile string `form:"roi_file"`
+	Format  string `form:"format"`
+	// IfMatch If-Match 헤더 (초안이 이미 있을 때 필요)
+	IfMatch string `form:"-"`
+}
+
+// RenderRoiRequest 이미지 위에 ROI를 그려서 받기 (query)
+type RenderRoiRequest struct {
+	// Base 이미지 위치 (test: uploads/testImages, current: currentImages)
+	Base   string `query:"base"`
+	Folder string `query:"folder"`
+	File   string `query:"file"`
+	// RoiFile ROI 파일 이름 (확장자 제외), Source는 draft 또는 original (없으면 초안이 있을 때 초안)
+	RoiFile string `query:"roi_file"`
+	Source  string `query:"source"`
+	// CctvID 없으면 이미지 이름에서 추출 ({cctvId}.jpg, {cctvId}_Current.jpg)
+	CctvID string `query:"cctv_id"`
+	// ExperimentID 있으면 해당 실험의 전경 비율과 점유 판정 기준으로 색칠
+	ExperimentID uint `query:"experiment_id"`
+	// Format png(기본) 또는 jpeg
+	Format string `query:"format"`
 }
@@ -1,38 +1,63 @@
 package response
 
+// ResDraftRoi version은 draft 파일의 ETag (수정 요청의 If-Match에 사용)
 type ResDraftRoi struct {
 	CctvList []CctvRoiInfo `json:"cctv_list"`
+	Version  string        `json:"version"`
 }
 
 type CctvRoiInfo struct {
-	CctvID    string        `json:"cctv_id"`
-	ParkingID string        `json:"parking_id"`
-	RoiCoords []interface{} `json:"roi_coords"`
+	CctvID    string    `json:"cctv_id"`
+	ParkingID string    `json:"parking_id"`
+	RoiCoords []float64 `json:"roi_coords"`
 }
 
+// ResSaveDraft references는 카메라 흔들림 검사용 기준 프레임을 저장한 CCTV 목록
 type ResSaveDraft struct {
-	Success  bool   `json:"success"`
-	Message  string `json:"message"`
-	FileName string `json:"file_name"`
+	Success    bool       `json:"success"`
+	Message    string     `json:"message"`
+	FileName   string     `json:"file_name"`
+	References []string   `json:"references,omitempty"`
+	Errors     []RoiIssue `json:"errors,omitempty"`
+	Warnings   []RoiIssue `json:"warnings,omitempty"`
+}
+
+// RoiIssue ROI 형상 검사 결과 (errors는 저장 차단, warnings는 저장 후 안내)
+type RoiIssue struct {
+	CctvID         string  `json:"cctv_id"`
+	ParkingID      string  `json:"parking_id,omitempty"`
+	OtherParkingID string  `json:"other_parking_id,omitempty"`
+	Code           string  `json:"code"`
+	Ratio          float64 `json:"ratio,omitempty"`
+	Message        string  `json:"message"`
 }
 
 // ROI CRUD 응답 모델들
 type ResCreateRoi struct {
-	Success bool   `json:"success"`
-	Message string `json:"message"`
+	Success  bool       `json:"success"`
+	Message  string     `json:"message"`
+	Version  string     `json:"version,omitempty"`
+	Errors   []RoiIssue `json:"errors,omitempty"`
+	Warnings []RoiIssue `json:"warnings,omitempty"`
 }
 
+// ResReadRoi version은 draft를 읽었을 때만 채워짐 (원본을 읽었으면 빈 값)
 type ResReadRoi struct {
-	CctvID string                   `json:"cctv_id"`
-	Rois   map[string][]interface{} `json:"rois"`
+	CctvID  string               `json:"cctv_id"`
+	Rois    map[string][]float64 `json:"rois"`
+	Version string               `json:"version,omitempty"`
 }
 
 type ResUpdateRoi struct {
-	Success bool   `json:"success"`
-	Message string `json:"message"`
+	Success  bool       `json:"success"`
+	Message  string     `json:"message"`
+	Version  string     `json:"version,omitempty"`
+	Errors   []RoiIssue `json:"errors,omitempty"`
+	Warnings []RoiIssue `json:"warnings,omitempty"`
 }
 
 type ResDeleteRoi struct {
 	Success bool   `json:"success"`
 	Message string `json:"message"`
+	Version string `json:"version,omitempty"`
 }
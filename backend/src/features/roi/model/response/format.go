@@ -0,0 +1,13 @@
+package response
+
+// ResImportRoi 가져온 초안 정보 (errors는 초안 저장 전에 고쳐야 하는 형상 오류)
+type ResImportRoi struct {
+	Success   bool       `json:"success"`
+	Message   string     `json:"message"`
+	DraftFile string     `json:"draft_file"`
+	Cameras   int        `json:"cameras"`
+	Rois      int        `json:"rois"`
+	Version   string     `json:"version"`
+	Errors    []RoiIssue `json:"errors,omitempty"`
+	Warnings  []RoiIssue `json:"warnings,omitempty"`
+}
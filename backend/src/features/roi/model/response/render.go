@@ -0,0 +1,7 @@
+package response
+
+// ResRenderRoi ROI를 그린 이미지
+type ResRenderRoi struct {
+	Data        []byte `json:"-"`
+	ContentType string `json:"content_type"`
+}
@@ -0,0 +1,17 @@
+package response
+
+// ResTransformRoi 변환 결과 (preview면 저장하지 않음, version은 저장 후 또는 미리보기 기준 draft의 ETag)
+type ResTransformRoi struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+	Preview bool   `json:"preview"`
+	CctvID  string `json:"cctv_id"`
+	// Matrix 적용한 2x3 아핀 행렬 [a, b, tx, c, d, ty]
+	Matrix []float64 `json:"matrix"`
+	// Residual 대응점으로 추정했을 때 평균제곱근 오차 (픽셀)
+	Residual *float64      `json:"residual,omitempty"`
+	Rois     []CctvRoiInfo `json:"rois"`
+	Version  string        `json:"version,omitempty"`
+	Errors   []RoiIssue    `json:"errors,omitempty"`
+	Warnings []RoiIssue    `json:"warnings,omitempty"`
+}
@@ -0,0 +1,48 @@
+package response
+
+type ResRoiVersions struct {
+	BaseName string       `json:"base_name"`
+	Versions []RoiVersion `json:"versions"`
+}
+
+// RoiVersion 저장된 ROI 파일 하나 (original은 업로드한 원본 {base_name}.json)
+type RoiVersion struct {
+	Version     string `json:"version"`
+	FileName    string `json:"file_name"`
+	CreatedAt   string `json:"created_at"`
+	Original    bool   `json:"original"`
+	AuthorID    *uint  `json:"author_id"`
+	AuthorEmail string `json:"author_email"`
+}
+
+type ResRoiVersionDiff struct {
+	BaseName string        `json:"base_name"`
+	From     string        `json:"from"`
+	To       string        `json:"to"`
+	Changed  bool          `json:"changed"`
+	Cctvs    []CctvRoiDiff `json:"cctvs"`
+}
+
+// CctvRoiDiff CCTV별 변경 내용 (바뀐 것이 있는 CCTV만)
+type CctvRoiDiff struct {
+	CctvID  string     `json:"cctv_id"`
+	Status  string     `json:"status"` // added, removed, modified
+	Added   []string   `json:"added"`
+	Removed []string   `json:"removed"`
+	Moved   []MovedRoi `json:"moved"`
+}
+
+// MovedRoi 좌표가 바뀐 주차면 (max_displacement는 꼭짓점이 움직인 최대 거리, 픽셀)
+type MovedRoi struct {
+	ParkingID          string  `json:"parking_id"`
+	MaxDisplacement    float64 `json:"max_displacement"`
+	VertexCountChanged bool    `json:"vertex_count_changed"`
+}
+
+type ResRestoreRoiVersion struct {
+	Success   bool   `json:"success"`
+	Message   string `json:"message"`
+	Version   string `json:"version"`
+	DraftFile string `json:"draft_file"`
+	DraftETag string `json:"draft_version"`
+}
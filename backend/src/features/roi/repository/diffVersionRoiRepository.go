@@ -0,0 +1,11 @@
+package repository
+
+import (
+	_interface "main/features/roi/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewDiffVersionRoiRepository(db *gorm.DB) _interface.IDiffVersionRoiRepository {
+	return &DiffVersionRoiRepository{GormDB: db}
+}
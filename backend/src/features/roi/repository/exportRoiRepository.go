@@ -0,0 +1,11 @@
+package repository
+
+import (
+	_interface "main/features/roi/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewExportRoiRepository(db *gorm.DB) _interface.IExportRoiRepository {
+	return &ExportRoiRepository{GormDB: db}
+}
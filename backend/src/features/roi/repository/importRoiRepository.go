@@ -0,0 +1,11 @@
+package repository
+
+import (
+	_interface "main/features/roi/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewImportRoiRepository(db *gorm.DB) _interface.IImportRoiRepository {
+	return &ImportRoiRepository{GormDB: db}
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/roi/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewListVersionRoiRepository(db *gorm.DB) _interface.IListVersionRoiRepository {
+	return &ListVersionRoiRepository{GormDB: db}
+}
+
+func (r *ListVersionRoiRepository) FindRoiVersions(ctx context.Context, projectID string, baseName string) ([]mysql.RoiVersions, error) {
+	var versions []mysql.RoiVersions
+	result := r.GormDB.WithContext(ctx).Where("project_id = ? AND base_name = ?", projectID, baseName).Find(&versions)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return versions, nil
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/roi/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewRenderRoiRepository(db *gorm.DB) _interface.IRenderRoiRepository {
+	return &RenderRoiRepository{GormDB: db}
+}
+
+func (r *RenderRoiRepository) FindExperimentSessionByID(ctx context.Context, experimentID uint) (mysql.ExperimentSessions, error) {
+	var experimentSession mysql.ExperimentSessions
+	result := r.GormDB.WithContext(ctx).Where("id = ?", experimentID).First(&experimentSession)
+	if result.Error != nil {
+		return mysql.ExperimentSessions{}, result.Error
+	}
+	return experimentSession, nil
+}
+
+func (r *RenderRoiRepository) FindCctvResult(ctx context.Context, experimentID int, cctvID string) (mysql.CctvResults, error) {
+	var cctvResult mysql.CctvResults
+	result := r.GormDB.WithContext(ctx).Where("experiment_session_id = ? AND cctv_id = ?", experimentID, cctvID).First(&cctvResult)
+	if result.Error != nil {
+		return mysql.CctvResults{}, result.Error
+	}
+	return cctvResult, nil
+}
+
+func (r *RenderRoiRepository) FindRoiResults(ctx context.Context, cctvResultID int) ([]mysql.RoiResults, error) {
+	var roiResults []mysql.RoiResults
+	result := r.GormDB.WithContext(ctx).Where("cctv_result_id = ?", cctvResultID).Find(&roiResults)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return roiResults, nil
+}
+
+func (r *RenderRoiRepository) FindOccupancyThresholds(ctx context.Context, projectID string) ([]mysql.OccupancyThresholds, error) {
+	var thresholds []mysql.OccupancyThresholds
+	result := r.GormDB.WithContext(ctx).Where("project_id = ?", projectID).Find(&thresholds)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return thresholds, nil
+}
+
+func (r *RenderRoiRepository) FindParkingSpaces(ctx context.Context, projectID string) ([]mysql.ParkingSpaces, error) {
+	var rows []mysql.ParkingSpaces
+	result := r.GormDB.WithContext(ctx).Where("project_id = ?", projectID).Find(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return rows, nil
+}
@@ -39,3 +39,31 @@ type DeleteRoiRepository struct {
 type GetImageRoiRepository struct {
 	GormDB *gorm.DB
 }
+
+type ListVersionRoiRepository struct {
+	GormDB *gorm.DB
+}
+
+type DiffVersionRoiRepository struct {
+	GormDB *gorm.DB
+}
+
+type RestoreVersionRoiRepository struct {
+	GormDB *gorm.DB
+}
+
+type TransformRoiRepository struct {
+	GormDB *gorm.DB
+}
+
+type ExportRoiRepository struct {
+	GormDB *gorm.DB
+}
+
+type ImportRoiRepository struct {
+	GormDB *gorm.DB
+}
+
+type RenderRoiRepository struct {
+	GormDB *gorm.DB
+}
@@ -0,0 +1,11 @@
+package repository
+
+import (
+	_interface "main/features/roi/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewRestoreVersionRoiRepository(db *gorm.DB) _interface.IRestoreVersionRoiRepository {
+	return &RestoreVersionRoiRepository{GormDB: db}
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+	"main/common/db/mysql"
 	_interface "main/features/roi/model/interface"
 
 	"gorm.io/gorm"
@@ -9,3 +11,7 @@ import (
 func NewSaveDraftRoiRepository(db *gorm.DB) _interface.ISaveDraftRoiRepository {
 	return &SaveDraftRoiRepository{GormDB: db}
 }
+
+func (r *SaveDraftRoiRepository) CreateRoiVersion(ctx context.Context, version mysql.RoiVersions) error {
+	return r.GormDB.WithContext(ctx).Create(&version).Error
+}
@@ -0,0 +1,11 @@
+package repository
+
+import (
+	_interface "main/features/roi/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewTransformRoiRepository(db *gorm.DB) _interface.ITransformRoiRepository {
+	return &TransformRoiRepository{GormDB: db}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"main/common"
+	"main/common/roidoc"
 	_interface "main/features/roi/model/interface"
 	"os"
 	"path/filepath"
@@ -19,47 +20,65 @@ func NewCreateDraftRoiUseCase(repo _interface.ICreateDraftRoiRepository, timeout
 	return &CreateDraftRoiUseCase{Repository: repo, ContextTimeout: timeout}
 }
 
-func (d *CreateDraftRoiUseCase) CreateDraftRoi(c context.Context, projectID string, roiFileName string) error {
+func (d *CreateDraftRoiUseCase) CreateDraftRoi(c context.Context, projectID string, roiFileName string, ifMatch string) (string, error) {
+	return d.CreateDraftRoiFrom(c, projectID, roiFileName, roiFileName, ifMatch)
+}
+
+// CreateDraftRoiFrom sourceFile(.json) 내용으로 draftName의 초안 생성 (버전 복원 시 원본 이름의 초안 자리에 씀)
+// 초안이 이미 있으면 If-Match가 현재 ETag와 같을 때만 교체, 새 초안의 ETag 반환
+func (d *CreateDraftRoiUseCase) CreateDraftRoiFrom(c context.Context, projectID string, sourceFile string, draftName string, ifMatch string) (string, error) {
 	_, cancel := context.WithTimeout(c, d.ContextTimeout)
 	defer cancel()
 
-	// 저장 경로 설정
-	uploadPath := common.Env.UploadPath
-	projectPath := filepath.Join(uploadPath, projectID)
-
-	// 원본 ROI 파일 경로 (json 파일)
-	roiFolderPath := filepath.Join(projectPath, "uploads", "roi")
-	roiFileName += ".json"
-	roiFilePath := filepath.Join(roiFolderPath, roiFileName)
+	// 원본 ROI 파일 경로 (json 파일, 작업 폴더 기준)
+	roiFileName := sourceFile + ".json"
+	roiFilePath, err := resolveRoiPath(c, projectID, roiFileName)
+	if err != nil {
+		return "", err
+	}
 
 	// ROI 파일 존재 확인
 	if _, err := os.Stat(roiFilePath); os.IsNotExist(err) {
-		return fmt.Errorf("ROI 파일을 찾을 수 없습니다: %s", roiFileName)
+		return "", fmt.Errorf("ROI 파일을 찾을 수 없습니다: %s", roiFileName)
 	}
 
 	// draft 폴더 생성
-	draftPath := filepath.Join(roiFolderPath, "draft")
+	draftPath := filepath.Join(filepath.Dir(roiFilePath), "draft")
 	if err := os.MkdirAll(draftPath, 0755); err != nil {
-		return fmt.Errorf("draft 폴더 생성 실패: %v", err)
+		return "", fmt.Errorf("draft 폴더 생성 실패: %v", err)
 	}
 
-	// draft 파일명 생성 (원본 파일명에 _draft 추가)
-	ext := filepath.Ext(roiFileName)
-	nameWithoutExt := roiFileName[:len(roiFileName)-len(ext)]
-	draftFileName := fmt.Sprintf("%s_draft%s", nameWithoutExt, ext)
+	// draft 파일명 생성 (파일명에 _draft 추가)
+	if err := common.ValidatePathSegment(draftName); err != nil {
+		return "", err
+	}
+	draftFileName := fmt.Sprintf("%s_draft.json", draftName)
 	draftFilePath := filepath.Join(draftPath, draftFileName)
 
-	// 기존 draft 파일이 있으면 삭제
+	// 기존 초안이 있으면 조회 이후 다른 수정이 없었는지 확인
 	if _, err := os.Stat(draftFilePath); err == nil {
-		if err := os.Remove(draftFilePath); err != nil {
-			return fmt.Errorf("기존 draft 파일 삭제 실패: %v", err)
+		_, _, unlock, err := lockDraftRoi(c, projectID, draftName, ifMatch)
+		if err != nil {
+			return "", err
+		}
+		defer unlock()
+	} else {
+		unlock := roidoc.Lock(draftFilePath)
+		defer unlock()
+		// 잠금을 기다리는 사이 다른 요청이 초안을 만들었으면 덮어쓰지 않음
+		if _, err := os.Stat(draftFilePath); err == nil {
+			return "", common.ErrorMsg(c, common.ErrConflict, common.Trace(), "다른 사용자가 draft를 먼저 생성했습니다. 다시 조회한 뒤 If-Match와 함께 요청하세요", common.ErrFromClient)
 		}
 	}
 
-	// 파일 복사
-	if err := copyFile(roiFilePath, draftFilePath); err != nil {
-		return fmt.Errorf("파일 복사 실패: %v", err)
+	// 파일 복사 (잠금을 잡은 상태이므로 copyFile 대신 직접 교체)
+	data, err := os.ReadFile(roiFilePath)
+	if err != nil {
+		return "", fmt.Errorf("파일 복사 실패: %v", err)
+	}
+	if err := roidoc.WriteFileAtomic(draftFilePath, data, 0644); err != nil {
+		return "", fmt.Errorf("파일 복사 실패: %v", err)
 	}
 
-	return nil
+	return roidoc.ETag(data), nil
 }
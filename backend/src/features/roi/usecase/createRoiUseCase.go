@@ -2,14 +2,12 @@ package usecase
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"main/common"
+	"main/common/roidoc"
 	_interface "main/features/roi/model/interface"
 	"main/features/roi/model/request"
 	"main/features/roi/model/response"
-	"os"
-	"path/filepath"
+	"strings"
 	"time"
 )
 
@@ -26,87 +24,50 @@ func (d *CreateRoiUseCase) CreateRoi(c context.Context, projectID string, req re
 	_, cancel := context.WithTimeout(c, d.ContextTimeout)
 	defer cancel()
 
-	// 저장 경로 설정
-	uploadPath := common.Env.UploadPath
-	projectPath := filepath.Join(uploadPath, projectID)
-
-	// draft 파일 경로
-	roiFolderPath := filepath.Join(projectPath, "uploads", "roi")
-	draftFileName := req.RoiFile + "_draft.json"
-	draftFilePath := filepath.Join(roiFolderPath, "draft", draftFileName)
-
-	// draft 파일 존재 확인
-	if _, err := os.Stat(draftFilePath); os.IsNotExist(err) {
-		return response.ResCreateRoi{}, fmt.Errorf("draft 파일을 찾을 수 없습니다")
+	if strings.TrimSpace(req.RoiID) == "" {
+		return response.ResCreateRoi{}, fmt.Errorf("roi_id가 필요합니다")
 	}
-
-	// JSON 파일 읽기
-	fileData, err := os.ReadFile(draftFilePath)
+	coords, err := toRoiCoords(req.Coords)
 	if err != nil {
-		return response.ResCreateRoi{}, fmt.Errorf("draft 파일 읽기 실패: %v", err)
+		return response.ResCreateRoi{}, err
 	}
 
-	// JSON 파싱
-	var roiData map[string]interface{}
-	if err := json.Unmarshal(fileData, &roiData); err != nil {
-		return response.ResCreateRoi{}, fmt.Errorf("JSON 파싱 실패: %v", err)
+	// draft 파일 잠금 후 읽기 (작업 폴더 기준, 조회 이후 다른 수정이 있었으면 CONFLICT)
+	draftFilePath, doc, unlock, err := lockDraftRoi(c, projectID, req.RoiFile, req.IfMatch)
+	if err != nil {
+		return response.ResCreateRoi{}, err
 	}
+	defer unlock()
 
 	// CCTV ID에 해당하는 데이터 찾기
-	cctvFound := false
-	roiFound := false
-	for ipAddr, cctvData := range roiData {
-		if cctvMap, ok := cctvData.(map[string]interface{}); ok {
-			if cctvID, ok := cctvMap["cctv_id"].(string); ok && cctvID == req.CctvID {
-				cctvFound = true
-
-				// matches 배열에서 ROI ID에 해당하는 항목 찾기
-				if matches, ok := cctvMap["matches"].([]interface{}); ok {
-					for i, match := range matches {
-						if matchMap, ok := match.(map[string]interface{}); ok {
-							if parkingID, ok := matchMap["parking_id"].(string); ok && parkingID == req.RoiID {
-								// 기존 ROI 수정
-								matchMap["original_roi"] = req.Coords
-								matchMap["img_center_roi"] = req.Coords
-
-								// 수정된 데이터를 다시 배열에 저장
-								matches[i] = matchMap
-								cctvMap["matches"] = matches
-								roiData[ipAddr] = cctvMap
-								roiFound = true
-								break
-							}
-						}
-					}
+	camera := doc.Camera(req.CctvID)
+	if camera == nil {
+		return response.ResCreateRoi{}, fmt.Errorf("CCTV ID를 찾을 수 없습니다: %s", req.CctvID)
+	}
 
-					// ROI를 찾지 못했다면 새로운 ROI 추가
-					if !roiFound {
-						newRoi := map[string]interface{}{
-							"parking_id":     req.RoiID,
-							"original_roi":   req.Coords,
-							"img_center_roi": req.Coords,
-						}
-						matches = append(matches, newRoi)
-						cctvMap["matches"] = matches
-						roiData[ipAddr] = cctvMap
-					}
-				}
-				break
-			}
-		}
+	// 같은 ROI ID가 있으면 수정, 없으면 새로운 ROI 추가
+	roiFound := false
+	if match := camera.Match(req.RoiID); match != nil {
+		match.SetCoords(coords)
+		roiFound = true
+	} else {
+		camera.AddMatch(req.RoiID, coords)
 	}
 
-	if !cctvFound {
-		return response.ResCreateRoi{}, fmt.Errorf("CCTV ID를 찾을 수 없습니다: %s", req.CctvID)
+	// 형상 검사 (오류가 있으면 저장하지 않음)
+	issueErrors, warnings := splitRoiIssues(camera.CctvID, checkCameraGeometry(c, projectID, camera, req.RoiID))
+	if len(issueErrors) > 0 {
+		return response.ResCreateRoi{
+			Success:  false,
+			Message:  "ROI 형상 검사를 통과하지 못했습니다",
+			Errors:   issueErrors,
+			Warnings: warnings,
+		}, nil
 	}
 
 	// 수정된 JSON을 파일에 저장
-	updatedData, err := json.MarshalIndent(roiData, "", "    ")
+	etag, err := roidoc.Save(draftFilePath, doc)
 	if err != nil {
-		return response.ResCreateRoi{}, fmt.Errorf("JSON 마샬링 실패: %v", err)
-	}
-
-	if err := os.WriteFile(draftFilePath, updatedData, 0644); err != nil {
 		return response.ResCreateRoi{}, fmt.Errorf("파일 저장 실패: %v", err)
 	}
 
@@ -116,7 +77,9 @@ func (d *CreateRoiUseCase) CreateRoi(c context.Context, projectID string, req re
 	}
 
 	return response.ResCreateRoi{
-		Success: true,
-		Message: message,
+		Success:  true,
+		Message:  message,
+		Warnings: warnings,
+		Version:  etag,
 	}, nil
 }
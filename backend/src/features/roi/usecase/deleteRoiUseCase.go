@@ -2,14 +2,11 @@ package usecase
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"main/common"
+	"main/common/roidoc"
 	_interface "main/features/roi/model/interface"
 	"main/features/roi/model/request"
 	"main/features/roi/model/response"
-	"os"
-	"path/filepath"
 	"time"
 )
 
@@ -26,84 +23,35 @@ func (d *DeleteRoiUseCase) DeleteRoi(c context.Context, projectID string, req re
 	_, cancel := context.WithTimeout(c, d.ContextTimeout)
 	defer cancel()
 
-	// 저장 경로 설정
-	uploadPath := common.Env.UploadPath
-	projectPath := filepath.Join(uploadPath, projectID)
-
-	// draft 파일 경로
-	roiFolderPath := filepath.Join(projectPath, "uploads", "roi")
-	draftFileName := req.RoiFile + "_draft.json"
-	draftFilePath := filepath.Join(roiFolderPath, "draft", draftFileName)
-
-	// draft 파일 존재 확인
-	if _, err := os.Stat(draftFilePath); os.IsNotExist(err) {
-		return response.ResDeleteRoi{}, fmt.Errorf("draft 파일을 찾을 수 없습니다")
-	}
-
-	// JSON 파일 읽기
-	fileData, err := os.ReadFile(draftFilePath)
+	// draft 파일 잠금 후 읽기 (작업 폴더 기준, 조회 이후 다른 수정이 있었으면 CONFLICT)
+	draftFilePath, doc, unlock, err := lockDraftRoi(c, projectID, req.RoiFile, req.IfMatch)
 	if err != nil {
-		return response.ResDeleteRoi{}, fmt.Errorf("draft 파일 읽기 실패: %v", err)
-	}
-
-	// JSON 파싱
-	var roiData map[string]interface{}
-	if err := json.Unmarshal(fileData, &roiData); err != nil {
-		return response.ResDeleteRoi{}, fmt.Errorf("JSON 파싱 실패: %v", err)
-	}
-
-	// CCTV ID에 해당하는 데이터 찾기
-	cctvFound := false
-	roiFound := false
-	for ipAddr, cctvData := range roiData {
-		if cctvMap, ok := cctvData.(map[string]interface{}); ok {
-			if cctvID, ok := cctvMap["cctv_id"].(string); ok && cctvID == req.CctvID {
-				cctvFound = true
-
-				// matches 배열에서 ROI ID에 해당하는 항목 찾기
-				if matches, ok := cctvMap["matches"].([]interface{}); ok {
-					for i, match := range matches {
-						if matchMap, ok := match.(map[string]interface{}); ok {
-							if parkingID, ok := matchMap["parking_id"].(string); ok && parkingID == req.RoiID {
-								roiFound = true
-								// 좌표를 빈 배열로 설정
-								matchMap["original_roi"] = []interface{}{}
-								matchMap["img_center_roi"] = []interface{}{}
-
-								// 수정된 데이터를 다시 배열에 저장
-								matches[i] = matchMap
-								cctvMap["matches"] = matches
-								roiData[ipAddr] = cctvMap
-								break
-							}
-						}
-					}
-				}
-				break
-			}
-		}
+		return response.ResDeleteRoi{}, err
 	}
+	defer unlock()
 
-	if !cctvFound {
+	// CCTV ID와 ROI ID에 해당하는 데이터 찾기
+	camera := doc.Camera(req.CctvID)
+	if camera == nil {
 		return response.ResDeleteRoi{}, fmt.Errorf("CCTV ID를 찾을 수 없습니다: %s", req.CctvID)
 	}
-
-	if !roiFound {
+	match := camera.Match(req.RoiID)
+	if match == nil {
 		return response.ResDeleteRoi{}, fmt.Errorf("ROI ID를 찾을 수 없습니다: %s", req.RoiID)
 	}
 
+	// 좌표를 빈 배열로 설정 (parking_id는 유지)
+	match.SetCoords([]float64{})
+
 	// 수정된 JSON을 파일에 저장
-	updatedData, err := json.MarshalIndent(roiData, "", "    ")
+	etag, err := roidoc.Save(draftFilePath, doc)
 	if err != nil {
-		return response.ResDeleteRoi{}, fmt.Errorf("JSON 마샬링 실패: %v", err)
-	}
-
-	if err := os.WriteFile(draftFilePath, updatedData, 0644); err != nil {
 		return response.ResDeleteRoi{}, fmt.Errorf("파일 저장 실패: %v", err)
 	}
 
 	return response.ResDeleteRoi{
 		Success: true,
 		Message: "ROI가 성공적으로 삭제되었습니다",
+		Version: etag,
 	}, nil
 }
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	_interface "main/features/roi/model/interface"
+	"main/features/roi/model/response"
+	"time"
+)
+
+type DiffVersionRoiUseCase struct {
+	Repository     _interface.IDiffVersionRoiRepository
+	ContextTimeout time.Duration
+}
+
+func NewDiffVersionRoiUseCase(repo _interface.IDiffVersionRoiRepository, timeout time.Duration) _interface.IDiffVersionRoiUseCase {
+	return &DiffVersionRoiUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// DiffVersions from 버전에서 to 버전으로 바뀐 주차면 (to가 "draft"면 현재 초안과 비교)
+func (d *DiffVersionRoiUseCase) DiffVersions(c context.Context, projectID string, baseName string, from string, to string) (response.ResRoiVersionDiff, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	fromDoc, err := loadRoiVersion(ctx, projectID, baseName, from)
+	if err != nil {
+		return response.ResRoiVersionDiff{}, fmt.Errorf("%s 버전 읽기 실패: %v", from, err)
+	}
+	toDoc, err := loadRoiVersion(ctx, projectID, baseName, to)
+	if err != nil {
+		return response.ResRoiVersionDiff{}, fmt.Errorf("%s 버전 읽기 실패: %v", to, err)
+	}
+
+	cctvs := diffRoiDocuments(fromDoc, toDoc)
+	return response.ResRoiVersionDiff{
+		BaseName: baseName,
+		From:     from,
+		To:       to,
+		Changed:  len(cctvs) > 0,
+		Cctvs:    cctvs,
+	}, nil
+}
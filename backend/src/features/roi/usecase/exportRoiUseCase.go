@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"main/common"
+	"main/common/roidoc"
+	"main/common/roiformat"
+	_interface "main/features/roi/model/interface"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+type ExportRoiUseCase struct {
+	Repository     _interface.IExportRoiRepository
+	ContextTimeout time.Duration
+}
+
+func NewExportRoiUseCase(repo _interface.IExportRoiRepository, timeout time.Duration) _interface.IExportRoiUseCase {
+	return &ExportRoiUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// ExportRoi ROI 파일을 외부 라벨링 도구 형식으로 변환
+// source: draft(초안), original(원본), 비어 있으면 ROI 조회와 같이 초안이 있으면 초안
+func (d *ExportRoiUseCase) ExportRoi(c context.Context, projectID string, roiFile string, source string, format string) (roiformat.File, error) {
+	_, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	if !roiformat.IsFormat(format) {
+		return roiformat.File{}, common.ErrorMsg(c, common.ErrBadParameter, common.Trace(), fmt.Sprintf("지원하지 않는 형식입니다: %s (cvat, labelme, geojson)", format), common.ErrFromClient)
+	}
+
+	doc, err := loadRoiSource(c, projectID, roiFile, source)
+	if err != nil {
+		return roiformat.File{}, err
+	}
+
+	// CVAT, LabelMe는 이미지 단위이므로 CCTV 기준 이미지 이름과 크기를 함께 기록
+	images := make(map[string]roiformat.Image, len(doc.Cameras))
+	for _, camera := range doc.Cameras {
+		if image, ok := referenceImageInfo(c, projectID, camera.CctvID); ok {
+			images[camera.CctvID] = image
+		}
+	}
+	return roiformat.Export(doc, format, roiFile, images)
+}
+
+// loadRoiSource source에 맞는 ROI 문서 (draft: 초안, original: 원본, 비어 있으면 초안이 있을 때 초안)
+func loadRoiSource(c context.Context, projectID string, roiFile string, source string) (*roidoc.Document, error) {
+	draftFilePath, err := resolveRoiPath(c, projectID, "draft", roiFile+"_draft.json")
+	if err != nil {
+		return nil, err
+	}
+	_, statErr := os.Stat(draftFilePath)
+	switch {
+	case source == "draft" || (source == "" && statErr == nil):
+		doc, _, err := readDraftRoi(c, projectID, roiFile)
+		return doc, err
+	case source == "original" || source == "":
+		return loadOriginalRoi(c, projectID, roiFile)
+	default:
+		return nil, common.ErrorMsg(c, common.ErrBadParameter, common.Trace(), fmt.Sprintf("source는 draft 또는 original이어야 합니다: %s", source), common.ErrFromClient)
+	}
+}
+
+// loadOriginalRoi uploads/roi/{roiFile}.json 읽기
+func loadOriginalRoi(c context.Context, projectID string, roiFile string) (*roidoc.Document, error) {
+	originalFileName := roiFile + ".json"
+	originalFilePath, err := resolveRoiPath(c, projectID, originalFileName)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(originalFilePath); os.IsNotExist(err) {
+		return nil, fmt.Errorf("ROI 파일을 찾을 수 없습니다: %s", originalFileName)
+	}
+	doc, err := roidoc.Load(originalFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("ROI 파일 읽기 실패: %v", err)
+	}
+	return doc, nil
+}
+
+// referenceImageInfo CCTV 기준 이미지 이름과 크기
+func referenceImageInfo(c context.Context, projectID string, cctvID string) (roiformat.Image, bool) {
+	path := findReferenceImage(c, projectID, cctvID)
+	if path == "" {
+		return roiformat.Image{}, false
+	}
+	info := roiformat.Image{Name: filepath.Base(path)}
+	if file, err := os.Open(path); err == nil {
+		if config, _, err := image.DecodeConfig(file); err == nil {
+			info.Width, info.Height = config.Width, config.Height
+		}
+		file.Close()
+	}
+	return info, true
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"io/fs"
+	"main/common/roidoc"
+	"main/common/roigeom"
+	"main/features/roi/model/response"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// errReferenceFound 기준 이미지를 찾으면 폴더 탐색 중단
+var errReferenceFound = errors.New("reference image found")
+
+// findReferenceImage CCTV 기준 이미지 경로 (uploads/testImages 아래 {cctvId}.jpg 또는 {cctvId}_Current.jpg, 없으면 빈 값)
+func findReferenceImage(c context.Context, projectID string, cctvID string) string {
+	root, err := resolveTestImagesPath(c, projectID)
+	if err != nil {
+		return ""
+	}
+
+	var found string
+	_ = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return nil
+		}
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if ext != ".jpg" && ext != ".jpeg" && ext != ".png" {
+			return nil
+		}
+		stem := strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))
+		if stem != cctvID && stem != cctvID+"_Current" {
+			return nil
+		}
+		found = path
+		return errReferenceFound
+	})
+	return found
+}
+
+// findReferenceFrame CCTV 기준 이미지 해상도 (기준 이미지가 없거나 읽을 수 없으면 nil)
+func findReferenceFrame(c context.Context, projectID string, cctvID string) *roigeom.Frame {
+	path := findReferenceImage(c, projectID, cctvID)
+	if path == "" {
+		return nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+	config, _, err := image.DecodeConfig(file)
+	if err != nil {
+		return nil
+	}
+	return &roigeom.Frame{Width: config.Width, Height: config.Height}
+}
+
+// checkCameraGeometry 카메라의 ROI 형상 검사 (target이 비어 있으면 전체)
+func checkCameraGeometry(c context.Context, projectID string, camera *roidoc.Camera, target string) []roigeom.Issue {
+	rois := make([]roigeom.Roi, 0, len(camera.Matches))
+	for _, match := range camera.Matches {
+		rois = append(rois, roigeom.Roi{ParkingID: match.ParkingID.Value, Coords: match.Coords()})
+	}
+	return roigeom.Check(rois, findReferenceFrame(c, projectID, camera.CctvID), target)
+}
+
+// splitRoiIssues 검사 결과를 저장 차단 오류와 경고로 나눔
+func splitRoiIssues(cctvID string, issues []roigeom.Issue) (errs []response.RoiIssue, warnings []response.RoiIssue) {
+	for _, issue := range issues {
+		item := response.RoiIssue{
+			CctvID:         cctvID,
+			ParkingID:      issue.ParkingID,
+			OtherParkingID: issue.OtherParkingID,
+			Code:           issue.Code,
+			Ratio:          issue.Ratio,
+			Message:        issue.Message,
+		}
+		if issue.Severity == roigeom.SeverityError {
+			errs = append(errs, item)
+		} else {
+			warnings = append(warnings, item)
+		}
+	}
+	return errs, warnings
+}
@@ -2,13 +2,11 @@ package usecase
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"main/common"
+	"main/common/roidoc"
 	_interface "main/features/roi/model/interface"
 	"main/features/roi/model/response"
 	"os"
-	"path/filepath"
 	"time"
 )
 
@@ -26,66 +24,33 @@ func (d *GetDraftRoiUseCase) GetDraftRoi(c context.Context, projectID string, ro
 	_, cancel := context.WithTimeout(c, d.ContextTimeout)
 	defer cancel()
 
-	// 저장 경로 설정
-	uploadPath := common.Env.UploadPath
-	projectPath := filepath.Join(uploadPath, projectID)
-
-	// draft 파일 경로
-	roiFolderPath := filepath.Join(projectPath, "uploads", "roi")
+	// draft 파일 경로 (작업 폴더 기준)
 	roiFileName += "_draft.json"
-	draftFilePath := filepath.Join(roiFolderPath, "draft", roiFileName)
-	fmt.Println("draftFilePath: ", draftFilePath)
-	fmt.Println("roiFileName: ", roiFileName)
+	draftFilePath, err := resolveRoiPath(c, projectID, "draft", roiFileName)
+	if err != nil {
+		return response.ResDraftRoi{}, err
+	}
+
 	// draft 파일 존재 확인
 	if _, err := os.Stat(draftFilePath); os.IsNotExist(err) {
 		return response.ResDraftRoi{}, fmt.Errorf("draft 파일을 찾을 수 없습니다: %s", roiFileName)
 	}
 
 	// JSON 파일 읽기
-	fileData, err := os.ReadFile(draftFilePath)
+	doc, etag, err := roidoc.ReadFile(draftFilePath)
 	if err != nil {
 		return response.ResDraftRoi{}, fmt.Errorf("draft 파일 읽기 실패: %v", err)
 	}
 
-	// JSON 파싱
-	var roiData map[string]interface{}
-	if err := json.Unmarshal(fileData, &roiData); err != nil {
-		return response.ResDraftRoi{}, fmt.Errorf("JSON 파싱 실패: %v", err)
-	}
-
-	// 응답 데이터 구성
-	var result response.ResDraftRoi
-
-	// 각 IP 주소(키)를 순회
-	for _, cctvData := range roiData {
-		if cctvMap, ok := cctvData.(map[string]interface{}); ok {
-			// cctv_id 추출
-			cctvID, _ := cctvMap["cctv_id"].(string)
-
-			// matches 배열 처리
-			if matches, ok := cctvMap["matches"].([]interface{}); ok {
-				for _, match := range matches {
-					if matchMap, ok := match.(map[string]interface{}); ok {
-						cctvInfo := response.CctvRoiInfo{
-							CctvID: cctvID,
-						}
-
-						// parking_id 추출
-						if parkingID, ok := matchMap["parking_id"].(string); ok {
-							cctvInfo.ParkingID = parkingID
-						}
-
-						// ROI 좌표 추출 (original_roi 또는 img_center_roi)
-						if originalRoi, ok := matchMap["original_roi"].([]interface{}); ok {
-							cctvInfo.RoiCoords = originalRoi
-						} else if imgCenterRoi, ok := matchMap["img_center_roi"].([]interface{}); ok {
-							cctvInfo.RoiCoords = imgCenterRoi
-						}
-
-						result.CctvList = append(result.CctvList, cctvInfo)
-					}
-				}
-			}
+	// 응답 데이터 구성 (각 IP 주소의 주차면 목록)
+	result := response.ResDraftRoi{Version: etag}
+	for _, camera := range doc.Cameras {
+		for _, match := range camera.Matches {
+			result.CctvList = append(result.CctvList, response.CctvRoiInfo{
+				CctvID:    camera.CctvID,
+				ParkingID: match.ParkingID.Value,
+				RoiCoords: match.Coords(),
+			})
 		}
 	}
 
@@ -26,11 +26,14 @@ func (d *GetImageRoiUseCase) GetImageRoi(c context.Context, projectID string, fo
 	_, cancel := context.WithTimeout(c, d.ContextTimeout)
 	defer cancel()
 
-	// 저장 경로 설정
-	uploadPath := common.Env.UploadPath
-	projectPath := filepath.Join(uploadPath, projectID)
-	targetPath := filepath.Join(projectPath, "uploads", "testImages", folderPath)
-	imagePath := filepath.Join(targetPath, fileName)
+	// 이미지 파일 경로 (작업 폴더 기준)
+	if err := common.ValidatePathSegment(fileName); err != nil {
+		return response.ResGetImageRoi{}, err
+	}
+	imagePath, err := resolveTestImagesPath(c, projectID, folderPath, fileName)
+	if err != nil {
+		return response.ResGetImageRoi{}, err
+	}
 
 	// 이미지 파일이 존재하는지 확인
 	if _, err := os.Stat(imagePath); os.IsNotExist(err) {
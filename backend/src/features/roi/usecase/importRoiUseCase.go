@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"main/common"
+	"main/common/roidoc"
+	"main/common/roiformat"
+	_interface "main/features/roi/model/interface"
+	"main/features/roi/model/request"
+	"main/features/roi/model/response"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// maxImportSize 가져오기 파일 최대 크기
+const maxImportSize = 32 << 20
+
+type ImportRoiUseCase struct {
+	Repository     _interface.IImportRoiRepository
+	ContextTimeout time.Duration
+}
+
+func NewImportRoiUseCase(repo _interface.IImportRoiRepository, timeout time.Duration) _interface.IImportRoiUseCase {
+	return &ImportRoiUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// ImportRoi 외부 라벨링 도구 파일을 {roi_file}_draft.json 초안에 반영
+// 기존 초안(없으면 원본)에서 가져온 파일에 있는 CCTV만 교체하고 나머지 CCTV는 유지
+// 초안이 이미 있으면 If-Match가 맞아야 저장, 카메라 키는 기존 문서의 IP 키를 cctv_id로 찾아 사용
+func (d *ImportRoiUseCase) ImportRoi(c context.Context, projectID string, req request.ImportRoiRequest, file *multipart.FileHeader) (response.ResImportRoi, error) {
+	_, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	if !roiformat.IsFormat(req.Format) {
+		return response.ResImportRoi{}, common.ErrorMsg(c, common.ErrBadParameter, common.Trace(), fmt.Sprintf("지원하지 않는 형식입니다: %s (cvat, labelme, geojson)", req.Format), common.ErrFromClient)
+	}
+	data, err := readImportFile(file)
+	if err != nil {
+		return response.ResImportRoi{}, common.ErrorMsg(c, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+	doc, err := roiformat.Import(req.Format, file.Filename, data)
+	if err != nil {
+		return response.ResImportRoi{}, common.ErrorMsg(c, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+
+	draftFilePath, err := resolveRoiPath(c, projectID, "draft", req.RoiFile+"_draft.json")
+	if err != nil {
+		return response.ResImportRoi{}, err
+	}
+
+	// 기존 초안이 있으면 조회 이후 다른 수정이 없었는지 확인
+	var current *roidoc.Document
+	if _, err := os.Stat(draftFilePath); err == nil {
+		var unlock func()
+		_, current, unlock, err = lockDraftRoi(c, projectID, req.RoiFile, req.IfMatch)
+		if err != nil {
+			return response.ResImportRoi{}, err
+		}
+		defer unlock()
+	} else {
+		unlock := roidoc.Lock(draftFilePath)
+		defer unlock()
+		if err := os.MkdirAll(filepath.Dir(draftFilePath), 0755); err != nil {
+			return response.ResImportRoi{}, fmt.Errorf("draft 폴더 생성 실패: %v", err)
+		}
+		if original, err := loadOriginalRoi(c, projectID, req.RoiFile); err == nil {
+			current = original
+		}
+	}
+	merged := doc
+	if current != nil {
+		if err := adoptCameraKeys(doc, current); err != nil {
+			return response.ResImportRoi{}, common.ErrorMsg(c, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+		}
+		if merged, err = mergeImportedCameras(current, doc); err != nil {
+			return response.ResImportRoi{}, common.ErrorMsg(c, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+		}
+	}
+
+	// 형상 문제는 초안에 그대로 두고 알려줌 (초안 저장 시 오류가 있으면 차단됨)
+	res := response.ResImportRoi{
+		Success:   true,
+		DraftFile: filepath.Base(draftFilePath),
+		Cameras:   len(doc.Cameras),
+	}
+	for _, imported := range doc.Cameras {
+		camera := merged.Camera(imported.CctvID)
+		res.Rois += len(camera.Matches)
+		cameraErrors, cameraWarnings := splitRoiIssues(camera.CctvID, checkCameraGeometry(c, projectID, camera, ""))
+		res.Errors = append(res.Errors, cameraErrors...)
+		res.Warnings = append(res.Warnings, cameraWarnings...)
+	}
+
+	res.Version, err = roidoc.Save(draftFilePath, merged)
+	if err != nil {
+		return response.ResImportRoi{}, fmt.Errorf("파일 저장 실패: %v", err)
+	}
+	res.Message = fmt.Sprintf("CCTV %d개, ROI %d개를 초안으로 가져왔습니다", res.Cameras, res.Rois)
+	if kept := len(merged.Cameras) - len(doc.Cameras); kept > 0 {
+		res.Message += fmt.Sprintf(" (가져오지 않은 CCTV %d개는 그대로 유지)", kept)
+	}
+	if len(res.Errors) > 0 {
+		res.Message += fmt.Sprintf(" (형상 오류 %d건은 초안 저장 전에 수정해야 합니다)", len(res.Errors))
+	}
+	return res, nil
+}
+
+// readImportFile 업로드 파일 읽기 (크기 제한)
+func readImportFile(file *multipart.FileHeader) ([]byte, error) {
+	if file.Size > maxImportSize {
+		return nil, fmt.Errorf("파일이 너무 큽니다 (%d bytes, 최대 %d bytes)", file.Size, maxImportSize)
+	}
+	src, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("파일 열기 실패: %v", err)
+	}
+	defer src.Close()
+	return io.ReadAll(io.LimitReader(src, maxImportSize+1))
+}
+
+// adoptCameraKeys 가져온 카메라의 키를 기존 문서에서 같은 cctv_id의 IP 키로 교체
+func adoptCameraKeys(doc *roidoc.Document, current *roidoc.Document) error {
+	keys := make(map[string]string, len(doc.Cameras))
+	for _, camera := range doc.Cameras {
+		if existing := current.Camera(camera.CctvID); existing != nil {
+			camera.Key = existing.Key
+		}
+		if other, ok := keys[camera.Key]; ok {
+			return fmt.Errorf("camera_key %q가 %s와 %s에 함께 쓰였습니다", camera.Key, other, camera.CctvID)
+		}
+		keys[camera.Key] = camera.CctvID
+	}
+	return nil
+}
+
+// mergeImportedCameras 가져온 CCTV만 current에서 교체하고 나머지 CCTV는 그대로 유지
+// 같은 parking_id의 주차면은 기존 항목의 좌표만 바꿔 roi_id 등 모르는 필드를 유지 (카메라의 모르는 필드도 유지)
+func mergeImportedCameras(current *roidoc.Document, imported *roidoc.Document) (*roidoc.Document, error) {
+	keys := make(map[string]string, len(current.Cameras))
+	for _, camera := range current.Cameras {
+		keys[camera.Key] = camera.CctvID
+	}
+	for _, camera := range imported.Cameras {
+		existing := current.Camera(camera.CctvID)
+		if existing == nil {
+			if other, ok := keys[camera.Key]; ok {
+				return nil, fmt.Errorf("camera_key %q가 %s와 %s에 함께 쓰였습니다", camera.Key, other, camera.CctvID)
+			}
+			keys[camera.Key] = camera.CctvID
+			current.Cameras = append(current.Cameras, camera)
+			continue
+		}
+		matches := make([]*roidoc.Match, 0, len(camera.Matches))
+		for _, match := range camera.Matches {
+			if old := existing.Match(match.ParkingID.Value); old != nil {
+				old.SetCoords(match.Coords())
+				match = old
+			}
+			matches = append(matches, match)
+		}
+		existing.Matches = matches
+	}
+	sort.Slice(current.Cameras, func(i, j int) bool { return current.Cameras[i].Key < current.Cameras[j].Key })
+	return current, nil
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"main/common/db/mysql"
+	_interface "main/features/roi/model/interface"
+	"main/features/roi/model/response"
+	"time"
+)
+
+type ListVersionRoiUseCase struct {
+	Repository     _interface.IListVersionRoiRepository
+	ContextTimeout time.Duration
+}
+
+func NewListVersionRoiUseCase(repo _interface.IListVersionRoiRepository, timeout time.Duration) _interface.IListVersionRoiUseCase {
+	return &ListVersionRoiUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// ListVersions ROI 이름 하나의 원본과 저장된 버전 목록 (최신순, 작성자는 DB 기록이 있을 때만)
+func (d *ListVersionRoiUseCase) ListVersions(c context.Context, projectID string, baseName string) (response.ResRoiVersions, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	files, err := listRoiVersionFiles(ctx, projectID, baseName)
+	if err != nil {
+		return response.ResRoiVersions{}, err
+	}
+
+	records, err := d.Repository.FindRoiVersions(ctx, projectID, baseName)
+	if err != nil {
+		return response.ResRoiVersions{}, fmt.Errorf("버전 기록 조회 실패: %v", err)
+	}
+	authors := make(map[string]mysql.RoiVersions, len(records))
+	for _, record := range records {
+		authors[record.FileName] = record
+	}
+
+	versions := make([]response.RoiVersion, 0, len(files))
+	for _, file := range files {
+		version := response.RoiVersion{
+			Version:   file.Version,
+			FileName:  file.FileName,
+			CreatedAt: file.CreatedAt.Format(time.RFC3339),
+			Original:  file.Original,
+		}
+		if record, ok := authors[file.FileName]; ok {
+			version.AuthorID = record.AuthorId
+			version.AuthorEmail = record.AuthorEmail
+		}
+		versions = append(versions, version)
+	}
+
+	return response.ResRoiVersions{
+		BaseName: baseName,
+		Versions: versions,
+	}, nil
+}
@@ -2,14 +2,12 @@ package usecase
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"main/common"
+	"main/common/roidoc"
 	_interface "main/features/roi/model/interface"
 	"main/features/roi/model/request"
 	"main/features/roi/model/response"
 	"os"
-	"path/filepath"
 	"time"
 )
 
@@ -26,83 +24,53 @@ func (d *ReadRoiUseCase) ReadRoi(c context.Context, projectID string, req reques
 	_, cancel := context.WithTimeout(c, d.ContextTimeout)
 	defer cancel()
 
-	// 저장 경로 설정
-	uploadPath := common.Env.UploadPath
-	projectPath := filepath.Join(uploadPath, projectID)
-
-	// ROI 파일 경로 설정
-	roiFolderPath := filepath.Join(projectPath, "uploads", "roi")
-
-	// 먼저 draft 파일 확인
+	// 먼저 draft 파일 확인 (작업 폴더 기준)
 	draftFileName := req.RoiFile + "_draft.json"
-	draftFilePath := filepath.Join(roiFolderPath, "draft", draftFileName)
+	draftFilePath, err := resolveRoiPath(c, projectID, "draft", draftFileName)
+	if err != nil {
+		return response.ResReadRoi{}, err
+	}
 
-	var fileData []byte
-	var err error
+	var doc *roidoc.Document
+	var etag string
 
 	// draft 파일 존재 확인
-	if _, err := os.Stat(draftFilePath); err == nil {
+	if _, statErr := os.Stat(draftFilePath); statErr == nil {
 		// draft 파일이 있으면 draft 파일 사용
-		fileData, err = os.ReadFile(draftFilePath)
+		doc, etag, err = roidoc.ReadFile(draftFilePath)
 	} else {
 		// draft 파일이 없으면 원본 파일 사용
 		originalFileName := req.RoiFile + ".json"
-		originalFilePath := filepath.Join(roiFolderPath, originalFileName)
+		originalFilePath, resolveErr := resolveRoiPath(c, projectID, originalFileName)
+		if resolveErr != nil {
+			return response.ResReadRoi{}, resolveErr
+		}
 
 		// 원본 파일 존재 확인
 		if _, err := os.Stat(originalFilePath); os.IsNotExist(err) {
 			return response.ResReadRoi{}, fmt.Errorf("ROI 파일을 찾을 수 없습니다: %s", originalFileName)
 		}
 
-		fileData, err = os.ReadFile(originalFilePath)
+		doc, err = roidoc.Load(originalFilePath)
 	}
 	if err != nil {
 		return response.ResReadRoi{}, fmt.Errorf("ROI 파일 읽기 실패: %v", err)
 	}
 
-	// JSON 파싱
-	var roiData map[string]interface{}
-	if err := json.Unmarshal(fileData, &roiData); err != nil {
-		return response.ResReadRoi{}, fmt.Errorf("JSON 파싱 실패: %v", err)
+	// CCTV ID에 해당하는 데이터 찾기
+	camera := doc.Camera(req.CctvID)
+	if camera == nil {
+		return response.ResReadRoi{}, fmt.Errorf("CCTV ID를 찾을 수 없습니다: %s", req.CctvID)
 	}
 
+	// 각 ROI의 좌표 추출 (original_roi 또는 img_center_roi)
 	result := response.ResReadRoi{
-		CctvID: req.CctvID,
-		Rois:   make(map[string][]interface{}),
+		CctvID:  req.CctvID,
+		Rois:    make(map[string][]float64),
+		Version: etag,
 	}
-
-	// CCTV ID에 해당하는 데이터 찾기
-	cctvFound := false
-
-	for _, cctvData := range roiData {
-		if cctvMap, ok := cctvData.(map[string]interface{}); ok {
-			if cctvID, ok := cctvMap["cctv_id"].(string); ok {
-				if cctvID == req.CctvID {
-					cctvFound = true
-
-					// matches 배열에서 각 ROI의 좌표 추출
-					if matches, ok := cctvMap["matches"].([]interface{}); ok {
-						for _, match := range matches {
-							if matchMap, ok := match.(map[string]interface{}); ok {
-								if parkingID, ok := matchMap["parking_id"].(string); ok {
-									// ROI 좌표 추출 (original_roi 또는 img_center_roi)
-									if originalRoi, ok := matchMap["original_roi"].([]interface{}); ok {
-										result.Rois[parkingID] = originalRoi
-									} else if imgCenterRoi, ok := matchMap["img_center_roi"].([]interface{}); ok {
-										result.Rois[parkingID] = imgCenterRoi
-									}
-								}
-							}
-						}
-					}
-					break
-				}
-			}
-		}
-	}
-
-	if !cctvFound {
-		return response.ResReadRoi{}, fmt.Errorf("CCTV ID를 찾을 수 없습니다: %s", req.CctvID)
+	for _, match := range camera.Matches {
+		result.Rois[match.ParkingID.Value] = match.Coords()
 	}
 
 	return result, nil
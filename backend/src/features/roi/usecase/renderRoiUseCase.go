@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"main/common"
+	"main/common/occupancy"
+	"main/common/roirender"
+	"main/common/spaces"
+	_interface "main/features/roi/model/interface"
+	"main/features/roi/model/request"
+	"main/features/roi/model/response"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type RenderRoiUseCase struct {
+	Repository     _interface.IRenderRoiRepository
+	ContextTimeout time.Duration
+}
+
+func NewRenderRoiUseCase(repo _interface.IRenderRoiRepository, timeout time.Duration) _interface.IRenderRoiUseCase {
+	return &RenderRoiUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// RenderRoi 이미지 위에 CCTV의 ROI를 그려서 PNG/JPEG로 반환 (experiment_id가 있으면 점유 색상)
+func (d *RenderRoiUseCase) RenderRoi(c context.Context, projectID string, req request.RenderRoiRequest) (response.ResRenderRoi, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	if req.Format != "" && req.Format != "png" && req.Format != "jpeg" && req.Format != "jpg" {
+		return response.ResRenderRoi{}, common.ErrorMsg(c, common.ErrBadParameter, common.Trace(), fmt.Sprintf("format은 png 또는 jpeg이어야 합니다: %s", req.Format), common.ErrFromClient)
+	}
+
+	imagePath, err := resolveRenderImagePath(c, projectID, req)
+	if err != nil {
+		return response.ResRenderRoi{}, err
+	}
+	file, err := os.Open(imagePath)
+	if os.IsNotExist(err) {
+		return response.ResRenderRoi{}, common.ErrorMsg(c, common.ErrNotFound, common.Trace(), fmt.Sprintf("이미지 파일을 찾을 수 없습니다: %s", req.File), common.ErrFromClient)
+	}
+	if err != nil {
+		return response.ResRenderRoi{}, err
+	}
+	defer file.Close()
+	img, _, err := image.Decode(file)
+	if err != nil {
+		return response.ResRenderRoi{}, common.ErrorMsg(c, common.ErrBadParameter, common.Trace(), fmt.Sprintf("이미지 파일 읽기 실패: %v", err), common.ErrFromClient)
+	}
+
+	cctvID := req.CctvID
+	if cctvID == "" {
+		cctvID = strings.TrimSuffix(strings.TrimSuffix(req.File, filepath.Ext(req.File)), "_Current")
+	}
+	doc, err := loadRoiSource(c, projectID, req.RoiFile, req.Source)
+	if err != nil {
+		return response.ResRenderRoi{}, err
+	}
+	camera := doc.Camera(cctvID)
+	if camera == nil {
+		return response.ResRenderRoi{}, common.ErrorMsg(c, common.ErrNotFound, common.Trace(), fmt.Sprintf("CCTV ID를 찾을 수 없습니다: %s", cctvID), common.ErrFromClient)
+	}
+
+	var rates map[int]float64
+	var thresholds occupancy.Thresholds
+	var index spaces.Index
+	if req.ExperimentID != 0 {
+		rates, thresholds, err = d.experimentRates(ctx, projectID, req.ExperimentID, cctvID)
+		if err != nil {
+			return response.ResRenderRoi{}, err
+		}
+		// 결과의 ROI 번호는 주차면 번호 대응으로 parking_id와 맞춤
+		rows, err := d.Repository.FindParkingSpaces(ctx, projectID)
+		if err != nil {
+			return response.ResRenderRoi{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("주차면 번호 조회 실패: %v", err), common.ErrFromMysqlDB)
+		}
+		index = spaces.NewIndex(rows)
+	}
+
+	rois := make([]roirender.Roi, 0, len(camera.Matches))
+	for _, match := range camera.Matches {
+		roi := roirender.Roi{ParkingID: match.ParkingID.Value, Coords: match.Coords()}
+		if roiID, ok := index.RoiID(cctvID, match.ParkingID.Value); ok && rates != nil {
+			if rate, ok := rates[roiID]; ok {
+				roi.Rate = &rate
+				roi.State = roirender.StateFree
+				if occupied, _ := thresholds.Occupied(cctvID, roiID, rate); occupied {
+					roi.State = roirender.StateOccupied
+				}
+			}
+		}
+		rois = append(rois, roi)
+	}
+
+	data, contentType, err := roirender.Encode(roirender.Render(img, rois), req.Format)
+	if err != nil {
+		return response.ResRenderRoi{}, fmt.Errorf("이미지 인코딩 실패: %v", err)
+	}
+	return response.ResRenderRoi{Data: data, ContentType: contentType}, nil
+}
+
+// experimentRates 실험의 CCTV ROI 번호별 전경 비율과 프로젝트 점유 판정 기준
+func (d *RenderRoiUseCase) experimentRates(ctx context.Context, projectID string, experimentID uint, cctvID string) (map[int]float64, occupancy.Thresholds, error) {
+	session, err := d.Repository.FindExperimentSessionByID(ctx, experimentID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && session.ProjectId != projectID) {
+		return nil, occupancy.Thresholds{}, common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), fmt.Sprintf("실험을 찾을 수 없습니다: %d", experimentID), common.ErrFromClient)
+	}
+	if err != nil {
+		return nil, occupancy.Thresholds{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("실험 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	rates := map[int]float64{}
+	cctvResult, err := d.Repository.FindCctvResult(ctx, int(session.ID), cctvID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, occupancy.Thresholds{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("CCTV 결과 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	// 실험에 없는 CCTV는 결과 없이 ROI만 그림
+	if err == nil {
+		roiResults, err := d.Repository.FindRoiResults(ctx, int(cctvResult.ID))
+		if err != nil {
+			return nil, occupancy.Thresholds{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("ROI 결과 조회 실패: %v", err), common.ErrFromMysqlDB)
+		}
+		for _, roiResult := range roiResults {
+			rates[roiResult.RoiId] = roiResult.Rate
+		}
+	}
+
+	rows, err := d.Repository.FindOccupancyThresholds(ctx, projectID)
+	if err != nil {
+		return nil, occupancy.Thresholds{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("점유 판정 기준 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	return rates, occupancy.NewThresholds(rows), nil
+}
+
+// resolveRenderImagePath base(test: uploads/testImages, current: currentImages) 아래 folder/file 경로
+func resolveRenderImagePath(c context.Context, projectID string, req request.RenderRoiRequest) (string, error) {
+	if err := common.ValidatePathSegment(req.File); err != nil {
+		return "", common.ErrorMsg(c, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+	ext := strings.ToLower(filepath.Ext(req.File))
+	if ext != ".jpg" && ext != ".jpeg" && ext != ".png" {
+		return "", common.ErrorMsg(c, common.ErrBadParameter, common.Trace(), "지원하지 않는 이미지 형식입니다", common.ErrFromClient)
+	}
+
+	elem := []string{}
+	switch req.Base {
+	case "", "test":
+		elem = append(elem, "uploads", "testImages")
+	case "current":
+		elem = append(elem, "currentImages")
+	default:
+		return "", common.ErrorMsg(c, common.ErrBadParameter, common.Trace(), fmt.Sprintf("base는 test 또는 current여야 합니다: %s", req.Base), common.ErrFromClient)
+	}
+	if req.Folder != "" {
+		elem = append(elem, req.Folder)
+	}
+	elem = append(elem, req.File)
+
+	ws, err := common.ResolveWorkspace(c, projectID)
+	if err != nil {
+		return "", err
+	}
+	path, err := ws.Resolve(elem...)
+	if err != nil {
+		return "", common.ErrorMsg(c, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+	return path, nil
+}
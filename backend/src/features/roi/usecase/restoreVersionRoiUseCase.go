@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	_interface "main/features/roi/model/interface"
+	"main/features/roi/model/request"
+	"main/features/roi/model/response"
+	"time"
+)
+
+type RestoreVersionRoiUseCase struct {
+	Repository     _interface.IRestoreVersionRoiRepository
+	CreateDraft    _interface.ICreateDraftRoiUseCase
+	ContextTimeout time.Duration
+}
+
+func NewRestoreVersionRoiUseCase(repo _interface.IRestoreVersionRoiRepository, createDraft _interface.ICreateDraftRoiUseCase, timeout time.Duration) _interface.IRestoreVersionRoiUseCase {
+	return &RestoreVersionRoiUseCase{Repository: repo, CreateDraft: createDraft, ContextTimeout: timeout}
+}
+
+// RestoreVersion 저장된 버전을 원본 이름의 초안({base_name}_draft.json)으로 복사
+// 초안이 이미 있으면 If-Match 필요, 복원 후에는 기존 초안 편집/저장 흐름을 그대로 사용
+func (d *RestoreVersionRoiUseCase) RestoreVersion(c context.Context, projectID string, req request.RestoreRoiVersionRequest) (response.ResRestoreRoiVersion, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	file, err := findRoiVersionFile(ctx, projectID, req.BaseName, req.Version)
+	if err != nil {
+		return response.ResRestoreRoiVersion{}, err
+	}
+
+	// 구조가 잘못된 버전은 초안으로 만들지 않음
+	if _, err := loadRoiVersion(ctx, projectID, req.BaseName, file.Version); err != nil {
+		return response.ResRestoreRoiVersion{}, fmt.Errorf("%s 버전 읽기 실패: %v", file.Version, err)
+	}
+
+	etag, err := d.CreateDraft.CreateDraftRoiFrom(ctx, projectID, file.Version, req.BaseName, req.IfMatch)
+	if err != nil {
+		return response.ResRestoreRoiVersion{}, err
+	}
+
+	return response.ResRestoreRoiVersion{
+		Success:   true,
+		Message:   "버전이 초안으로 복원되었습니다",
+		Version:   file.Version,
+		DraftFile: req.BaseName + "_draft.json",
+		DraftETag: etag,
+	}, nil
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"main/common"
+	"main/common/camshift"
+	"main/common/db/mysql"
+	"main/common/roidoc"
 	_interface "main/features/roi/model/interface"
 	"main/features/roi/model/response"
 	"os"
@@ -21,27 +24,47 @@ func NewSaveDraftRoiUseCase(repo _interface.ISaveDraftRoiRepository, timeout tim
 }
 
 // SaveDraftRoi 초안 JSON 파일을 현재 날짜를 붙여서 roi 폴더에 저장
-func (d *SaveDraftRoiUseCase) SaveDraftRoi(c context.Context, projectID string, roiFileName string) (response.ResSaveDraft, error) {
+func (d *SaveDraftRoiUseCase) SaveDraftRoi(c context.Context, projectID string, roiFileName string, userID uint, email string) (response.ResSaveDraft, error) {
 	_, cancel := context.WithTimeout(c, d.ContextTimeout)
 	defer cancel()
 
-	// 저장 경로 설정
-	uploadPath := common.Env.UploadPath
-	projectPath := filepath.Join(uploadPath, projectID)
-
-	// draft 파일 경로
-	roiFolderPath := filepath.Join(projectPath, "uploads", "roi")
+	// draft 파일 경로 (작업 폴더 기준)
 	roiFileName += "_draft.json"
-	draftFilePath := filepath.Join(roiFolderPath, "draft", roiFileName)
+	draftFilePath, err := resolveRoiPath(c, projectID, "draft", roiFileName)
+	if err != nil {
+		return response.ResSaveDraft{}, err
+	}
+
+	// 검사와 복사 사이에 draft가 바뀌지 않도록 잠금
+	unlock := roidoc.Lock(draftFilePath)
+	defer unlock()
 
 	// draft 파일 존재 확인
 	if _, err := os.Stat(draftFilePath); os.IsNotExist(err) {
 		return response.ResSaveDraft{}, fmt.Errorf("draft 파일을 찾을 수 없습니다: %s", roiFileName)
 	}
 
+	// 전체 카메라 형상 검사 (오류가 있으면 저장하지 않음)
+	doc, err := roidoc.Load(draftFilePath)
+	if err != nil {
+		return response.ResSaveDraft{}, fmt.Errorf("draft 파일 읽기 실패: %v", err)
+	}
+	var issueErrors, warnings []response.RoiIssue
+	for _, camera := range doc.Cameras {
+		cameraErrors, cameraWarnings := splitRoiIssues(camera.CctvID, checkCameraGeometry(c, projectID, camera, ""))
+		issueErrors = append(issueErrors, cameraErrors...)
+		warnings = append(warnings, cameraWarnings...)
+	}
+	if len(issueErrors) > 0 {
+		return response.ResSaveDraft{
+			Success:  false,
+			Message:  "ROI 형상 검사를 통과하지 못했습니다",
+			Errors:   issueErrors,
+			Warnings: warnings,
+		}, nil
+	}
+
 	// 현재 날짜로 파일명 생성
-	now := time.Now()
-	dateStr := now.Format("20060102_150405")
 	ext := filepath.Ext(roiFileName)
 	nameWithoutExt := roiFileName[:len(roiFileName)-len(ext)]
 
@@ -50,17 +73,81 @@ func (d *SaveDraftRoiUseCase) SaveDraftRoi(c context.Context, projectID string,
 		nameWithoutExt = nameWithoutExt[:len(nameWithoutExt)-6]
 	}
 
-	savedFileName := fmt.Sprintf("%s_%s%s", nameWithoutExt, dateStr, ext)
-	savedFilePath := filepath.Join(roiFolderPath, savedFileName)
+	// 버전 파일에서 만든 초안이면 시각을 떼고 원본 이름 기준으로 저장 (name_날짜_날짜 방지)
+	nameWithoutExt = roiBaseName(nameWithoutExt)
 
-	// 파일 복사
-	if err := copyFile(draftFilePath, savedFilePath); err != nil {
+	savedFileName, savedFilePath, err := reserveRoiVersionFile(c, projectID, nameWithoutExt, ext, time.Now())
+	if err != nil {
+		return response.ResSaveDraft{}, err
+	}
+
+	// 파일 복사 (이 호출에서 만든 파일이므로 실패하면 삭제)
+	if _, err := copyFile(draftFilePath, savedFilePath); err != nil {
+		os.Remove(savedFilePath)
 		return response.ResSaveDraft{}, fmt.Errorf("파일 저장 실패: %v", err)
 	}
 
+	// 버전 작성자 기록 (실패하면 기록 없는 버전이 남지 않도록 이 호출에서 만든 파일도 삭제)
+	version := mysql.RoiVersions{
+		ProjectId:   projectID,
+		BaseName:    nameWithoutExt,
+		FileName:    savedFileName,
+		AuthorEmail: email,
+	}
+	if userID != 0 {
+		version.AuthorId = &userID
+	}
+	if err := d.Repository.CreateRoiVersion(c, version); err != nil {
+		os.Remove(savedFilePath)
+		return response.ResSaveDraft{}, fmt.Errorf("버전 기록 실패: %v", err)
+	}
+
+	// ROI를 그린 이미지를 카메라 흔들림 검사의 기준 프레임으로 저장 (실패해도 저장은 유지)
+	references := []string{}
+	if ws, err := common.ResolveWorkspace(c, projectID); err == nil {
+		for _, camera := range doc.Cameras {
+			imagePath := findReferenceImage(c, projectID, camera.CctvID)
+			if imagePath == "" {
+				continue
+			}
+			if _, err := camshift.SaveReference(ws, camera.CctvID, imagePath, savedFileName); err != nil {
+				fmt.Printf("기준 프레임 저장 실패 (%s): %v\n", camera.CctvID, err)
+				continue
+			}
+			references = append(references, camera.CctvID)
+		}
+	}
+
 	return response.ResSaveDraft{
-		Success:  true,
-		Message:  "초안이 성공적으로 저장되었습니다",
-		FileName: savedFileName,
+		Success:    true,
+		Message:    "초안이 성공적으로 저장되었습니다",
+		FileName:   savedFileName,
+		References: references,
+		Warnings:   warnings,
 	}, nil
 }
+
+// maxVersionStampAttempts 같은 초에 저장된 버전이 있을 때 다음 초로 넘겨 보는 최대 횟수
+const maxVersionStampAttempts = 60
+
+// reserveRoiVersionFile {base}_{시각}{ext} 버전 파일을 빈 파일로 먼저 만들어 이름을 확보
+// 같은 시각의 버전이 이미 있으면 덮어쓰지 않고 1초씩 늦춘 시각을 씀 (파일명 형식과 정렬 순서 유지)
+func reserveRoiVersionFile(c context.Context, projectID string, base string, ext string, now time.Time) (string, string, error) {
+	for i := 0; i < maxVersionStampAttempts; i++ {
+		fileName := fmt.Sprintf("%s_%s%s", base, now.Add(time.Duration(i)*time.Second).Format(versionStampLayout), ext)
+		filePath, err := resolveRoiPath(c, projectID, fileName)
+		if err != nil {
+			return "", "", err
+		}
+		file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if os.IsExist(err) {
+			continue
+		}
+		if err != nil {
+			return "", "", fmt.Errorf("파일 저장 실패: %v", err)
+		}
+		file.Close()
+		return fileName, filePath, nil
+	}
+	return "", "", fmt.Errorf("파일 저장 실패: %s의 버전 파일 이름을 정할 수 없습니다 (같은 시각의 버전이 너무 많음)", base)
+}
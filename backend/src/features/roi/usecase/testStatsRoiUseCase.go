@@ -2,8 +2,6 @@ package usecase
 
 import (
 	"context"
-	"fmt"
-	"main/common"
 	_interface "main/features/roi/model/interface"
 	"main/features/roi/model/response"
 	"os"
@@ -24,11 +22,11 @@ func (d *TestStatsRoiUseCase) GetTestStats(c context.Context, projectID string,
 	_, cancel := context.WithTimeout(c, d.ContextTimeout)
 	defer cancel()
 
-	// 저장 경로 설정
-	uploadPath := common.Env.UploadPath
-	projectPath := filepath.Join(uploadPath, projectID)
-	targetPath := filepath.Join(projectPath, "uploads", "testImages", folderPath)
-	fmt.Println(targetPath)
+	// 조회 경로 설정 (작업 폴더 기준)
+	targetPath, err := resolveTestImagesPath(c, projectID, folderPath)
+	if err != nil {
+		return response.ResTestStatsRoi{}, err
+	}
 
 	var images []response.ImageInfo
 	total := 0
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"main/common"
+	"main/common/roidoc"
+	"main/common/roigeom"
+	_interface "main/features/roi/model/interface"
+	"main/features/roi/model/request"
+	"main/features/roi/model/response"
+	"os"
+	"time"
+)
+
+type TransformRoiUseCase struct {
+	Repository     _interface.ITransformRoiRepository
+	ContextTimeout time.Duration
+}
+
+func NewTransformRoiUseCase(repo _interface.ITransformRoiRepository, timeout time.Duration) _interface.ITransformRoiUseCase {
+	return &TransformRoiUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+func (d *TransformRoiUseCase) TransformRoi(c context.Context, projectID string, req request.TransformRoiRequest) (response.ResTransformRoi, error) {
+	_, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	transform, residual, err := roiTransform(req)
+	if err != nil {
+		return response.ResTransformRoi{}, common.ErrorMsg(c, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+
+	// 미리보기는 저장하지 않으므로 If-Match 없이 현재 draft 기준으로 계산
+	var (
+		draftFilePath string
+		doc           *roidoc.Document
+		etag          string
+	)
+	if req.Preview {
+		doc, etag, err = readDraftRoi(c, projectID, req.RoiFile)
+		if err != nil {
+			return response.ResTransformRoi{}, err
+		}
+	} else {
+		var unlock func()
+		draftFilePath, doc, unlock, err = lockDraftRoi(c, projectID, req.RoiFile, req.IfMatch)
+		if err != nil {
+			return response.ResTransformRoi{}, err
+		}
+		defer unlock()
+	}
+
+	camera := doc.Camera(req.CctvID)
+	if camera == nil {
+		return response.ResTransformRoi{}, fmt.Errorf("CCTV ID를 찾을 수 없습니다: %s", req.CctvID)
+	}
+
+	// 삭제된 ROI(빈 좌표)는 그대로 두고 나머지 주차면에 같은 변환 적용
+	rois := make([]response.CctvRoiInfo, 0, len(camera.Matches))
+	for _, match := range camera.Matches {
+		coords := match.Coords()
+		if len(coords) == 0 {
+			continue
+		}
+		coords = transform.ApplyCoords(coords)
+		match.SetCoords(coords)
+		rois = append(rois, response.CctvRoiInfo{CctvID: camera.CctvID, ParkingID: match.ParkingID.Value, RoiCoords: coords})
+	}
+
+	res := response.ResTransformRoi{
+		Success:  true,
+		Preview:  req.Preview,
+		CctvID:   camera.CctvID,
+		Matrix:   transform[:],
+		Residual: residual,
+		Rois:     rois,
+	}
+
+	// 변환 후 전체 주차면 형상 검사 (미리보기에서도 알려주고, 오류가 있으면 저장하지 않음)
+	res.Errors, res.Warnings = splitRoiIssues(camera.CctvID, checkCameraGeometry(c, projectID, camera, ""))
+
+	if req.Preview {
+		res.Message = fmt.Sprintf("%d개 ROI 변환 미리보기입니다 (저장하지 않음)", len(rois))
+		res.Version = etag
+		return res, nil
+	}
+	if len(res.Errors) > 0 {
+		res.Success = false
+		res.Message = "변환한 ROI가 형상 검사를 통과하지 못했습니다"
+		return res, nil
+	}
+
+	res.Version, err = roidoc.Save(draftFilePath, doc)
+	if err != nil {
+		return response.ResTransformRoi{}, fmt.Errorf("파일 저장 실패: %v", err)
+	}
+	res.Message = fmt.Sprintf("%d개 ROI가 변환되었습니다", len(rois))
+	return res, nil
+}
+
+// roiTransform 요청의 translate, matrix, points 중 하나로 변환 행렬 생성
+// points로 추정했으면 대응점 잔차(RMS)도 반환
+func roiTransform(req request.TransformRoiRequest) (roigeom.Affine, *float64, error) {
+	given := 0
+	if req.Translate != nil {
+		given++
+	}
+	if req.Matrix != nil {
+		given++
+	}
+	if req.Points != nil {
+		given++
+	}
+	if given != 1 {
+		return roigeom.Affine{}, nil, fmt.Errorf("translate, matrix, points 중 하나만 지정해야 합니다")
+	}
+
+	switch {
+	case req.Translate != nil:
+		return roigeom.Translation(req.Translate.Dx, req.Translate.Dy), nil, nil
+	case req.Matrix != nil:
+		transform, err := roigeom.AffineFromMatrix(req.Matrix)
+		return transform, nil, err
+	default:
+		src := make([]roigeom.Point, 0, len(req.Points))
+		dst := make([]roigeom.Point, 0, len(req.Points))
+		for _, pair := range req.Points {
+			src = append(src, roigeom.Point{X: pair.From[0], Y: pair.From[1]})
+			dst = append(dst, roigeom.Point{X: pair.To[0], Y: pair.To[1]})
+		}
+		transform, rms, err := roigeom.EstimateAffine(src, dst)
+		if err != nil {
+			return roigeom.Affine{}, nil, err
+		}
+		return transform, &rms, nil
+	}
+}
+
+// readDraftRoi 잠그지 않고 draft ROI 문서와 ETag 읽기 (조회/미리보기용)
+func readDraftRoi(c context.Context, projectID string, roiFile string) (*roidoc.Document, string, error) {
+	draftFilePath, err := resolveRoiPath(c, projectID, "draft", roiFile+"_draft.json")
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := os.Stat(draftFilePath); os.IsNotExist(err) {
+		return nil, "", fmt.Errorf("draft 파일을 찾을 수 없습니다")
+	}
+	doc, etag, err := roidoc.ReadFile(draftFilePath)
+	if err != nil {
+		return nil, "", fmt.Errorf("draft 파일 읽기 실패: %v", err)
+	}
+	return doc, etag, nil
+}
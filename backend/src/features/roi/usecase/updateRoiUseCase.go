@@ -2,14 +2,11 @@ package usecase
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"main/common"
+	"main/common/roidoc"
 	_interface "main/features/roi/model/interface"
 	"main/features/roi/model/request"
 	"main/features/roi/model/response"
-	"os"
-	"path/filepath"
 	"time"
 )
 
@@ -26,84 +23,52 @@ func (d *UpdateRoiUseCase) UpdateRoi(c context.Context, projectID string, req re
 	_, cancel := context.WithTimeout(c, d.ContextTimeout)
 	defer cancel()
 
-	// 저장 경로 설정
-	uploadPath := common.Env.UploadPath
-	projectPath := filepath.Join(uploadPath, projectID)
-
-	// draft 파일 경로
-	roiFolderPath := filepath.Join(projectPath, "uploads", "roi")
-	draftFileName := req.RoiFile + "_draft.json"
-	draftFilePath := filepath.Join(roiFolderPath, "draft", draftFileName)
-
-	// draft 파일 존재 확인
-	if _, err := os.Stat(draftFilePath); os.IsNotExist(err) {
-		return response.ResUpdateRoi{}, fmt.Errorf("draft 파일을 찾을 수 없습니다")
-	}
-
-	// JSON 파일 읽기
-	fileData, err := os.ReadFile(draftFilePath)
+	coords, err := toRoiCoords(req.Coords)
 	if err != nil {
-		return response.ResUpdateRoi{}, fmt.Errorf("draft 파일 읽기 실패: %v", err)
+		return response.ResUpdateRoi{}, err
 	}
 
-	// JSON 파싱
-	var roiData map[string]interface{}
-	if err := json.Unmarshal(fileData, &roiData); err != nil {
-		return response.ResUpdateRoi{}, fmt.Errorf("JSON 파싱 실패: %v", err)
-	}
-
-	// CCTV ID에 해당하는 데이터 찾기
-	cctvFound := false
-	roiFound := false
-	for ipAddr, cctvData := range roiData {
-		if cctvMap, ok := cctvData.(map[string]interface{}); ok {
-			if cctvID, ok := cctvMap["cctv_id"].(string); ok && cctvID == req.CctvID {
-				cctvFound = true
-
-				// matches 배열에서 ROI ID에 해당하는 항목 찾기
-				if matches, ok := cctvMap["matches"].([]interface{}); ok {
-					for i, match := range matches {
-						if matchMap, ok := match.(map[string]interface{}); ok {
-							if parkingID, ok := matchMap["parking_id"].(string); ok && parkingID == req.RoiID {
-								roiFound = true
-								// 좌표 업데이트
-								matchMap["original_roi"] = req.Coords
-								matchMap["img_center_roi"] = req.Coords
-
-								// 수정된 데이터를 다시 배열에 저장
-								matches[i] = matchMap
-								cctvMap["matches"] = matches
-								roiData[ipAddr] = cctvMap
-								break
-							}
-						}
-					}
-				}
-				break
-			}
-		}
+	// draft 파일 잠금 후 읽기 (작업 폴더 기준, 조회 이후 다른 수정이 있었으면 CONFLICT)
+	draftFilePath, doc, unlock, err := lockDraftRoi(c, projectID, req.RoiFile, req.IfMatch)
+	if err != nil {
+		return response.ResUpdateRoi{}, err
 	}
+	defer unlock()
 
-	if !cctvFound {
+	// CCTV ID와 ROI ID에 해당하는 데이터 찾기
+	camera := doc.Camera(req.CctvID)
+	if camera == nil {
 		return response.ResUpdateRoi{}, fmt.Errorf("CCTV ID를 찾을 수 없습니다: %s", req.CctvID)
 	}
-
-	if !roiFound {
+	match := camera.Match(req.RoiID)
+	if match == nil {
 		return response.ResUpdateRoi{}, fmt.Errorf("ROI ID를 찾을 수 없습니다: %s", req.RoiID)
 	}
 
-	// 수정된 JSON을 파일에 저장
-	updatedData, err := json.MarshalIndent(roiData, "", "    ")
-	if err != nil {
-		return response.ResUpdateRoi{}, fmt.Errorf("JSON 마샬링 실패: %v", err)
+	// 좌표 업데이트
+	match.SetCoords(coords)
+
+	// 형상 검사 (오류가 있으면 저장하지 않음)
+	issueErrors, warnings := splitRoiIssues(camera.CctvID, checkCameraGeometry(c, projectID, camera, req.RoiID))
+	if len(issueErrors) > 0 {
+		return response.ResUpdateRoi{
+			Success:  false,
+			Message:  "ROI 형상 검사를 통과하지 못했습니다",
+			Errors:   issueErrors,
+			Warnings: warnings,
+		}, nil
 	}
 
-	if err := os.WriteFile(draftFilePath, updatedData, 0644); err != nil {
+	// 수정된 JSON을 파일에 저장
+	etag, err := roidoc.Save(draftFilePath, doc)
+	if err != nil {
 		return response.ResUpdateRoi{}, fmt.Errorf("파일 저장 실패: %v", err)
 	}
 
 	return response.ResUpdateRoi{
-		Success: true,
-		Message: "ROI가 성공적으로 수정되었습니다",
+		Success:  true,
+		Message:  "ROI가 성공적으로 수정되었습니다",
+		Warnings: warnings,
+		Version:  etag,
 	}, nil
 }
@@ -25,10 +25,11 @@ func (d *UploadRoiUseCase) UploadRoi(c context.Context, projectID string, files
 	_, cancel := context.WithTimeout(c, d.ContextTimeout)
 	defer cancel()
 
-	// 저장 경로 설정
-	uploadPath := common.Env.UploadPath
-	projectPath := filepath.Join(uploadPath, projectID)
-	targetPath := filepath.Join(projectPath, "uploads", "testImages")
+	// 저장 경로 설정 (작업 폴더 기준)
+	targetPath, err := resolveTestImagesPath(c, projectID)
+	if err != nil {
+		return response.ResUpload{}, err
+	}
 
 	// 폴더 생성
 	if err := os.MkdirAll(targetPath, 0755); err != nil {
@@ -42,6 +43,12 @@ func (d *UploadRoiUseCase) UploadRoi(c context.Context, projectID string, files
 		// 파일명 그대로 사용 (원본 파일명 유지)
 		fileName := file.Filename
 
+		// 파일명에 경로가 섞여 있으면 저장하지 않음
+		if err := common.ValidatePathSegment(fileName); err != nil {
+			errors = append(errors, fmt.Sprintf("잘못된 파일명: %s", fileName))
+			continue
+		}
+
 		// 최종 저장 경로 (파일명 그대로)
 		finalPath := filepath.Join(targetPath, fileName)
 
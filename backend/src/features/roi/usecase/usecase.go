@@ -1,11 +1,38 @@
 package usecase
 
 import (
+	"context"
+	"fmt"
 	"io"
+	"main/common"
+	"main/common/roidoc"
 	"mime/multipart"
 	"os"
 )
 
+// resolveRoiPath 작업 폴더의 uploads/roi 기준 경로 구성 (파일명은 한 칸만 허용)
+func resolveRoiPath(c context.Context, projectID string, elem ...string) (string, error) {
+	if len(elem) > 0 {
+		if err := common.ValidatePathSegment(elem[len(elem)-1]); err != nil {
+			return "", err
+		}
+	}
+	ws, err := common.ResolveWorkspace(c, projectID)
+	if err != nil {
+		return "", err
+	}
+	return ws.Resolve(append([]string{"uploads", "roi"}, elem...)...)
+}
+
+// resolveTestImagesPath 작업 폴더의 uploads/testImages 기준 경로 구성
+func resolveTestImagesPath(c context.Context, projectID string, elem ...string) (string, error) {
+	ws, err := common.ResolveWorkspace(c, projectID)
+	if err != nil {
+		return "", err
+	}
+	return ws.Resolve(append([]string{"uploads", "testImages"}, elem...)...)
+}
+
 // saveUploadedFile 파일 저장 헬퍼 함수
 func saveUploadedFile(file *multipart.FileHeader, filePath string) error {
 	src, err := file.Open()
@@ -24,20 +51,60 @@ func saveUploadedFile(file *multipart.FileHeader, filePath string) error {
 	return err
 }
 
-// copyFile 파일 복사 헬퍼 함수
-func copyFile(src, dst string) error {
-	sourceFile, err := os.Open(src)
+// copyFile 파일 복사 헬퍼 함수 (dst를 잠근 상태에서 원자적으로 교체, 복사한 내용의 ETag 반환)
+func copyFile(src, dst string) (string, error) {
+	data, err := os.ReadFile(src)
 	if err != nil {
-		return err
+		return "", err
+	}
+
+	unlock := roidoc.Lock(dst)
+	defer unlock()
+	if err := roidoc.WriteFileAtomic(dst, data, 0644); err != nil {
+		return "", err
+	}
+	return roidoc.ETag(data), nil
+}
+
+// lockDraftRoi draft ROI 파일을 잠그고 검증된 문서를 읽음 (If-Match가 현재 ETag와 다르면 CONFLICT)
+// 반환된 unlock은 저장까지 마친 뒤 호출
+func lockDraftRoi(c context.Context, projectID string, roiFile string, ifMatch string) (string, *roidoc.Document, func(), error) {
+	if ifMatch == "" {
+		return "", nil, nil, common.ErrorMsg(c, common.ErrPreconditionRequired, common.Trace(), "If-Match 헤더가 필요합니다 (draft 조회 시 받은 ETag)", common.ErrFromClient)
+	}
+	draftFilePath, err := resolveRoiPath(c, projectID, "draft", roiFile+"_draft.json")
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	unlock := roidoc.Lock(draftFilePath)
+
+	// draft 파일 존재 확인
+	if _, err := os.Stat(draftFilePath); os.IsNotExist(err) {
+		unlock()
+		return "", nil, nil, fmt.Errorf("draft 파일을 찾을 수 없습니다")
 	}
-	defer sourceFile.Close()
 
-	destFile, err := os.Create(dst)
+	doc, etag, err := roidoc.ReadFile(draftFilePath)
 	if err != nil {
-		return err
+		unlock()
+		return "", nil, nil, fmt.Errorf("draft 파일 읽기 실패: %v", err)
+	}
+	if !roidoc.MatchETag(ifMatch, etag) {
+		unlock()
+		return "", nil, nil, common.ErrorMsg(c, common.ErrConflict, common.Trace(), fmt.Sprintf("다른 사용자가 draft를 먼저 수정했습니다. 다시 조회한 뒤 수정하세요 (현재 ETag %s)", etag), common.ErrFromClient)
 	}
-	defer destFile.Close()
+	return draftFilePath, doc, unlock, nil
+}
 
-	_, err = destFile.ReadFrom(sourceFile)
-	return err
+// toRoiCoords 요청 좌표를 ROI 문서 좌표로 변환 (x/y 쌍이 맞지 않으면 오류)
+func toRoiCoords(coords []int) ([]float64, error) {
+	if len(coords)%2 != 0 {
+		return nil, fmt.Errorf("좌표 개수가 홀수입니다 (%d개, x/y 쌍이어야 함)", len(coords))
+	}
+	out := make([]float64, 0, len(coords))
+	for _, v := range coords {
+		out = append(out, float64(v))
+	}
+	return out, nil
 }
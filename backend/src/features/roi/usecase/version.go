@@ -0,0 +1,238 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"main/common"
+	"main/common/roidoc"
+	"main/common/roigeom"
+	"main/features/roi/model/response"
+	"math"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// draftVersion 버전 비교 시 현재 초안을 가리키는 이름
+const draftVersion = "draft"
+
+// versionStampLayout 초안 저장 시 파일명에 붙는 시각
+const versionStampLayout = "20060102_150405"
+
+var versionStampPattern = regexp.MustCompile(`^(.+)_(\d{8}_\d{6})$`)
+
+// roiVersionFile uploads/roi 폴더의 버전 파일
+type roiVersionFile struct {
+	Version   string
+	FileName  string
+	CreatedAt time.Time
+	Original  bool
+}
+
+// roiBaseName 버전 시각을 뗀 ROI 이름 (name_20250101_120000 -> name)
+func roiBaseName(name string) string {
+	for {
+		match := versionStampPattern.FindStringSubmatch(name)
+		if match == nil {
+			return name
+		}
+		name = match[1]
+	}
+}
+
+// listRoiVersionFiles baseName의 원본과 저장된 버전 목록 (최신순)
+func listRoiVersionFiles(c context.Context, projectID string, baseName string) ([]roiVersionFile, error) {
+	if err := common.ValidatePathSegment(baseName); err != nil {
+		return nil, err
+	}
+	roiDir, err := resolveRoiPath(c, projectID)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(roiDir)
+	if os.IsNotExist(err) {
+		return []roiVersionFile{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	versions := []roiVersionFile{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		stem := strings.TrimSuffix(entry.Name(), ".json")
+		if stem == baseName {
+			info, err := entry.Info()
+			if err != nil {
+				continue
+			}
+			versions = append(versions, roiVersionFile{Version: stem, FileName: entry.Name(), CreatedAt: info.ModTime(), Original: true})
+			continue
+		}
+		match := versionStampPattern.FindStringSubmatch(stem)
+		if match == nil || roiBaseName(match[1]) != baseName {
+			continue
+		}
+		createdAt, err := time.ParseInLocation(versionStampLayout, match[2], time.Local)
+		if err != nil {
+			continue
+		}
+		versions = append(versions, roiVersionFile{Version: stem, FileName: entry.Name(), CreatedAt: createdAt})
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		if !versions[i].CreatedAt.Equal(versions[j].CreatedAt) {
+			return versions[i].CreatedAt.After(versions[j].CreatedAt)
+		}
+		return versions[i].Version > versions[j].Version
+	})
+	return versions, nil
+}
+
+// findRoiVersionFile baseName에 속한 버전인지 확인
+func findRoiVersionFile(c context.Context, projectID string, baseName string, version string) (roiVersionFile, error) {
+	versions, err := listRoiVersionFiles(c, projectID, baseName)
+	if err != nil {
+		return roiVersionFile{}, err
+	}
+	for _, v := range versions {
+		if v.Version == version {
+			return v, nil
+		}
+	}
+	return roiVersionFile{}, fmt.Errorf("%s의 버전을 찾을 수 없습니다: %s", baseName, version)
+}
+
+// loadRoiVersion 버전(또는 draft) 문서 읽기
+func loadRoiVersion(c context.Context, projectID string, baseName string, version string) (*roidoc.Document, error) {
+	if version == draftVersion {
+		draftFilePath, err := resolveRoiPath(c, projectID, "draft", baseName+"_draft.json")
+		if err != nil {
+			return nil, err
+		}
+		if _, err := os.Stat(draftFilePath); os.IsNotExist(err) {
+			return nil, fmt.Errorf("draft 파일을 찾을 수 없습니다: %s_draft.json", baseName)
+		}
+		return roidoc.Load(draftFilePath)
+	}
+
+	file, err := findRoiVersionFile(c, projectID, baseName, version)
+	if err != nil {
+		return nil, err
+	}
+	filePath, err := resolveRoiPath(c, projectID, file.FileName)
+	if err != nil {
+		return nil, err
+	}
+	return roidoc.Load(filePath)
+}
+
+// diffRoiDocuments CCTV별 추가/삭제/이동한 주차면 (좌표가 빈 주차면은 삭제된 것으로 봄)
+func diffRoiDocuments(from *roidoc.Document, to *roidoc.Document) []response.CctvRoiDiff {
+	fromRois := roiCoordsByCctv(from)
+	toRois := roiCoordsByCctv(to)
+
+	cctvIDs := make([]string, 0, len(fromRois)+len(toRois))
+	for cctvID := range fromRois {
+		cctvIDs = append(cctvIDs, cctvID)
+	}
+	for cctvID := range toRois {
+		if _, ok := fromRois[cctvID]; !ok {
+			cctvIDs = append(cctvIDs, cctvID)
+		}
+	}
+	sort.Strings(cctvIDs)
+
+	diffs := []response.CctvRoiDiff{}
+	for _, cctvID := range cctvIDs {
+		before, inFrom := fromRois[cctvID]
+		after, inTo := toRois[cctvID]
+		diff := response.CctvRoiDiff{
+			CctvID:  cctvID,
+			Status:  "modified",
+			Added:   []string{},
+			Removed: []string{},
+			Moved:   []response.MovedRoi{},
+		}
+		switch {
+		case !inFrom:
+			diff.Status = "added"
+		case !inTo:
+			diff.Status = "removed"
+		}
+
+		for parkingID, coords := range after {
+			old, ok := before[parkingID]
+			if !ok {
+				diff.Added = append(diff.Added, parkingID)
+				continue
+			}
+			if moved, ok := roiMovement(parkingID, old, coords); ok {
+				diff.Moved = append(diff.Moved, moved)
+			}
+		}
+		for parkingID := range before {
+			if _, ok := after[parkingID]; !ok {
+				diff.Removed = append(diff.Removed, parkingID)
+			}
+		}
+		if len(diff.Added) == 0 && len(diff.Removed) == 0 && len(diff.Moved) == 0 && inFrom && inTo {
+			continue
+		}
+
+		sort.Strings(diff.Added)
+		sort.Strings(diff.Removed)
+		sort.Slice(diff.Moved, func(i, j int) bool { return diff.Moved[i].ParkingID < diff.Moved[j].ParkingID })
+		diffs = append(diffs, diff)
+	}
+	return diffs
+}
+
+// roiCoordsByCctv CCTV ID -> parking_id -> 좌표 (좌표가 빈 주차면 제외)
+func roiCoordsByCctv(doc *roidoc.Document) map[string]map[string][]float64 {
+	rois := make(map[string]map[string][]float64, len(doc.Cameras))
+	for _, camera := range doc.Cameras {
+		coords := make(map[string][]float64, len(camera.Matches))
+		for _, match := range camera.Matches {
+			if c := match.Coords(); len(c) > 0 {
+				coords[match.ParkingID.Value] = c
+			}
+		}
+		rois[camera.CctvID] = coords
+	}
+	return rois
+}
+
+// roiMovement 좌표가 바뀌었으면 꼭짓점이 움직인 최대 거리
+// 꼭짓점 수가 같으면 같은 순서끼리, 다르면 가장 가까운 꼭짓점끼리 비교 (Hausdorff 거리)
+func roiMovement(parkingID string, before []float64, after []float64) (response.MovedRoi, bool) {
+	a, b := roigeom.Points(before), roigeom.Points(after)
+	moved := response.MovedRoi{ParkingID: parkingID, VertexCountChanged: len(a) != len(b)}
+	if moved.VertexCountChanged {
+		moved.MaxDisplacement = math.Max(farthestVertex(a, b), farthestVertex(b, a))
+		return moved, true
+	}
+	for i := range a {
+		moved.MaxDisplacement = math.Max(moved.MaxDisplacement, math.Hypot(a[i].X-b[i].X, a[i].Y-b[i].Y))
+	}
+	return moved, moved.MaxDisplacement > 0
+}
+
+// farthestVertex a의 각 꼭짓점에서 b의 가장 가까운 꼭짓점까지 거리 중 최댓값
+func farthestVertex(a []roigeom.Point, b []roigeom.Point) float64 {
+	farthest := 0.0
+	for _, p := range a {
+		nearest := math.Inf(1)
+		for _, q := range b {
+			nearest = math.Min(nearest, math.Hypot(p.X-q.X, p.Y-q.Y))
+		}
+		if !math.IsInf(nearest, 1) {
+			farthest = math.Max(farthest, nearest)
+		}
+	}
+	return farthest
+}
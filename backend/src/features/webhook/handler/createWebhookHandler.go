@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/webhook/model/interface"
+	"main/features/webhook/model/request"
+	"main/features/webhook/usecase"
+	_middleware "main/middleware"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CreateWebhookHandler struct {
+	UseCase _interface.ICreateWebhookUseCase
+}
+
+func NewCreateWebhookHandler(c *echo.Echo, useCase _interface.ICreateWebhookUseCase) _interface.ICreateWebhookHandler {
+	handler := &CreateWebhookHandler{
+		UseCase: useCase,
+	}
+	c.POST("/v0.1/projects/:projectId/webhooks", handler.CreateWebhook, _middleware.ProjectScope)
+	return handler
+}
+
+// CreateWebhook 웹훅 구독 등록
+// @Router /v0.1/projects/{projectId}/webhooks [post]
+// @Summary 웹훅 구독 등록
+// @Description
+// @Description 프로젝트 이벤트를 url로 POST하는 구독을 등록합니다. secret을 생략하면 서버에서 생성하며 이 응답에서만 확인할 수 있습니다.
+// @Description
+// @Description ■ event_types
+// @Description space.changed : 주차면 점유 여부 변경 (빈 자리가 생기면 occupied=false, 처음 관측된 주차면은 제외)
+// @Description zone.threshold : zone_id 구역(생략 시 프로젝트 전체)의 점유율이 threshold_percent(기본 100 = 만차)를 넘거나 내려감 (구독이 걸린 구역은 구독을 지우기 전까지 구역 저장으로 없앨 수 없음)
+// @Description camera.stale : CCTV의 마지막 관측 이미지가 stale_minutes(기본 10분)보다 오래됨 (같은 이미지로는 한 번만)
+// @Description learning_job.finished : 학습 작업 종료 (succeeded/failed/cancelled)
+// @Description
+// @Description ■ 전송 형식
+// @Description 본문 {"id", "type", "project_id", "occurred_at", "data"}, 재시도해도 id는 같습니다.
+// @Description X-Parking-Signature: sha256=HMAC-SHA256(secret, "{X-Parking-Timestamp}.{본문}")의 hex
+// @Description 2xx가 아니면 30초부터 두 배씩(최대 1시간) 기다려 WEBHOOK_MAX_ATTEMPTS회까지 재시도합니다. 전송 순서는 보장하지 않습니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 파라미터 오류
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 프로젝트 또는 구역 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_SERVER : 서명 키 생성 실패
+// @Description INTERNAL_DB : DB 처리 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param        projectId   path      string  true  "Project ID"
+// @Param        request     body      request.ReqCreateWebhook  true  "Create Webhook Request"
+// @Success 200 {object} response.ResWebhook
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags webhook
+func (d *CreateWebhookHandler) CreateWebhook(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	projectID := c.Param("projectId")
+
+	var req request.ReqCreateWebhook
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "요청 데이터 파싱 실패: " + err.Error(),
+		})
+	}
+
+	if err := usecase.ValidateCreateWebhookRequest(req); err != nil {
+		return err
+	}
+
+	res, err := d.UseCase.CreateWebhook(ctx, projectID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
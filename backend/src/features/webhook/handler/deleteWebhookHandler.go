@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/webhook/model/interface"
+	_middleware "main/middleware"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type DeleteWebhookHandler struct {
+	UseCase _interface.IDeleteWebhookUseCase
+}
+
+func NewDeleteWebhookHandler(c *echo.Echo, useCase _interface.IDeleteWebhookUseCase) _interface.IDeleteWebhookHandler {
+	handler := &DeleteWebhookHandler{
+		UseCase: useCase,
+	}
+	c.DELETE("/v0.1/projects/:projectId/webhooks/:webhookId", handler.DeleteWebhook, _middleware.ProjectScope)
+	return handler
+}
+
+// DeleteWebhook 웹훅 구독 삭제
+// @Router /v0.1/projects/{projectId}/webhooks/{webhookId} [delete]
+// @Summary 웹훅 구독 삭제
+// @Description
+// @Description 구독과 전송 기록을 삭제합니다. 대기 중인 전송도 더 이상 보내지 않습니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 잘못된 웹훅 ID
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 프로젝트 또는 웹훅 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : DB 처리 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param        projectId   path      string  true  "Project ID"
+// @Param        webhookId   path      int     true  "Webhook ID"
+// @Success 200 {object} response.ResWebhook
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags webhook
+func (d *DeleteWebhookHandler) DeleteWebhook(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	projectID := c.Param("projectId")
+	webhookID := c.Param("webhookId")
+
+	res, err := d.UseCase.DeleteWebhook(ctx, projectID, webhookID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
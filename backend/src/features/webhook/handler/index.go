@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"main/common"
+	"main/common/db/mysql"
+	"main/features/webhook/repository"
+	"main/features/webhook/usecase"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+func NewWebhookHandler(e *echo.Echo) error {
+	// 전송용 HTTP 클라이언트 (응답 대기 시간은 WEBHOOK_TIMEOUT_SECONDS)
+	client := &http.Client{Timeout: time.Duration(common.Env.WebhookTimeoutSeconds) * time.Second}
+
+	// Repository 초기화
+	createWebhookRepo := repository.NewCreateWebhookRepository(mysql.GormMysqlDB)
+	listWebhookRepo := repository.NewListWebhookRepository(mysql.GormMysqlDB)
+	updateWebhookRepo := repository.NewUpdateWebhookRepository(mysql.GormMysqlDB)
+	deleteWebhookRepo := repository.NewDeleteWebhookRepository(mysql.GormMysqlDB)
+	testWebhookRepo := repository.NewTestWebhookRepository(mysql.GormMysqlDB)
+	listWebhookDeliveryRepo := repository.NewListWebhookDeliveryRepository(mysql.GormMysqlDB)
+	dispatchWebhookRepo := repository.NewDispatchWebhookRepository(mysql.GormMysqlDB)
+
+	// UseCase 초기화
+	createWebhookUseCase := usecase.NewCreateWebhookUseCase(createWebhookRepo, 30*time.Second)
+	listWebhookUseCase := usecase.NewListWebhookUseCase(listWebhookRepo, 30*time.Second)
+	updateWebhookUseCase := usecase.NewUpdateWebhookUseCase(updateWebhookRepo, 30*time.Second)
+	deleteWebhookUseCase := usecase.NewDeleteWebhookUseCase(deleteWebhookRepo, 30*time.Second)
+	testWebhookUseCase := usecase.NewTestWebhookUseCase(testWebhookRepo, 30*time.Second, client)
+	listWebhookDeliveryUseCase := usecase.NewListWebhookDeliveryUseCase(listWebhookDeliveryRepo, 30*time.Second)
+	dispatchWebhookUseCase := usecase.NewDispatchWebhookUseCase(dispatchWebhookRepo, 30*time.Second, client, common.Env.WebhookMaxAttempts)
+
+	// 이벤트 전송 시작 (서버 재시작 전 대기 중이던 전송도 이어서 처리)
+	dispatchWebhookUseCase.StartWebhookDispatcher()
+
+	// Handler 초기화
+	NewCreateWebhookHandler(e, createWebhookUseCase)
+	NewListWebhookHandler(e, listWebhookUseCase)
+	NewUpdateWebhookHandler(e, updateWebhookUseCase)
+	NewDeleteWebhookHandler(e, deleteWebhookUseCase)
+	NewTestWebhookHandler(e, testWebhookUseCase)
+	NewListWebhookDeliveryHandler(e, listWebhookDeliveryUseCase)
+
+	return nil
+}
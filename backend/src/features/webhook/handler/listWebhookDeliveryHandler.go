@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/webhook/model/interface"
+	"main/features/webhook/model/request"
+	"main/features/webhook/usecase"
+	_middleware "main/middleware"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ListWebhookDeliveryHandler struct {
+	UseCase _interface.IListWebhookDeliveryUseCase
+}
+
+func NewListWebhookDeliveryHandler(c *echo.Echo, useCase _interface.IListWebhookDeliveryUseCase) _interface.IListWebhookDeliveryHandler {
+	handler := &ListWebhookDeliveryHandler{
+		UseCase: useCase,
+	}
+	c.GET("/v0.1/projects/:projectId/webhooks/:webhookId/deliveries", handler.ListWebhookDelivery, _middleware.ProjectScope)
+	return handler
+}
+
+// ListWebhookDelivery 웹훅 전송 기록 조회
+// @Router /v0.1/projects/{projectId}/webhooks/{webhookId}/deliveries [get]
+// @Summary 웹훅 전송 기록 조회
+// @Description
+// @Description 구독의 전송 기록을 최신 순으로 조회합니다. pending은 next_attempt_at에 다시 전송됩니다.
+// @Description 끝난(succeeded/failed) 기록은 30일 동안 보관합니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 잘못된 파라미터
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 프로젝트 또는 웹훅 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : DB 처리 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param        projectId   path      string  true   "Project ID"
+// @Param        webhookId   path      int     true   "Webhook ID"
+// @Param        status      query     string  false  "pending | succeeded | failed"
+// @Param        limit       query     int     false  "최대 개수 (기본 50, 최대 200)"
+// @Success 200 {object} response.ResListWebhookDelivery
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags webhook
+func (d *ListWebhookDeliveryHandler) ListWebhookDelivery(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	projectID := c.Param("projectId")
+	webhookID := c.Param("webhookId")
+
+	var req request.ReqListWebhookDelivery
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "요청 파라미터 파싱 실패",
+		})
+	}
+	if err := usecase.ValidateListWebhookDeliveryRequest(req); err != nil {
+		return err
+	}
+
+	res, err := d.UseCase.ListWebhookDelivery(ctx, projectID, webhookID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/webhook/model/interface"
+	_middleware "main/middleware"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ListWebhookHandler struct {
+	UseCase _interface.IListWebhookUseCase
+}
+
+func NewListWebhookHandler(c *echo.Echo, useCase _interface.IListWebhookUseCase) _interface.IListWebhookHandler {
+	handler := &ListWebhookHandler{
+		UseCase: useCase,
+	}
+	c.GET("/v0.1/projects/:projectId/webhooks", handler.ListWebhook, _middleware.ProjectScope)
+	return handler
+}
+
+// ListWebhook 웹훅 구독 목록 조회
+// @Router /v0.1/projects/{projectId}/webhooks [get]
+// @Summary 웹훅 구독 목록 조회
+// @Description
+// @Description 프로젝트의 웹훅 구독을 등록 순으로 조회합니다. secret은 포함하지 않습니다.
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 프로젝트 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : DB 처리 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param        projectId   path      string  true  "Project ID"
+// @Success 200 {object} response.ResListWebhook
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags webhook
+func (d *ListWebhookHandler) ListWebhook(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	projectID := c.Param("projectId")
+
+	res, err := d.UseCase.ListWebhook(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/webhook/model/interface"
+	_middleware "main/middleware"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TestWebhookHandler struct {
+	UseCase _interface.ITestWebhookUseCase
+}
+
+func NewTestWebhookHandler(c *echo.Echo, useCase _interface.ITestWebhookUseCase) _interface.ITestWebhookHandler {
+	handler := &TestWebhookHandler{
+		UseCase: useCase,
+	}
+	c.POST("/v0.1/projects/:projectId/webhooks/:webhookId/test", handler.TestWebhook, _middleware.ProjectScope)
+	return handler
+}
+
+// TestWebhook 웹훅 테스트 전송
+// @Router /v0.1/projects/{projectId}/webhooks/{webhookId}/test [post]
+// @Summary 웹훅 테스트 전송
+// @Description
+// @Description webhook.test 이벤트를 바로 한 번 전송하고 전송 기록을 반환합니다. 비활성 구독에도 전송하며 재시도하지 않습니다.
+// @Description 수신 측이 실패해도 200으로 응답하고 status=failed, response_status, error_message로 결과를 알려줍니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 잘못된 웹훅 ID
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 프로젝트 또는 웹훅 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : DB 처리 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param        projectId   path      string  true  "Project ID"
+// @Param        webhookId   path      int     true  "Webhook ID"
+// @Success 200 {object} response.ResWebhookDelivery
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags webhook
+func (d *TestWebhookHandler) TestWebhook(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	projectID := c.Param("projectId")
+	webhookID := c.Param("webhookId")
+
+	res, err := d.UseCase.TestWebhook(ctx, projectID, webhookID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
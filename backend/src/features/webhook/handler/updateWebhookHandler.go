@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/webhook/model/interface"
+	"main/features/webhook/model/request"
+	"main/features/webhook/usecase"
+	_middleware "main/middleware"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type UpdateWebhookHandler struct {
+	UseCase _interface.IUpdateWebhookUseCase
+}
+
+func NewUpdateWebhookHandler(c *echo.Echo, useCase _interface.IUpdateWebhookUseCase) _interface.IUpdateWebhookHandler {
+	handler := &UpdateWebhookHandler{
+		UseCase: useCase,
+	}
+	c.PUT("/v0.1/projects/:projectId/webhooks/:webhookId", handler.UpdateWebhook, _middleware.ProjectScope)
+	return handler
+}
+
+// UpdateWebhook 웹훅 구독 수정
+// @Router /v0.1/projects/{projectId}/webhooks/{webhookId} [put]
+// @Summary 웹훅 구독 수정
+// @Description
+// @Description 요청에 포함된 필드만 수정합니다. zone_id를 0으로 보내면 프로젝트 전체 점유율 기준으로 돌아갑니다.
+// @Description secret을 바꾸면 응답에 새 secret이 포함됩니다. active=false인 동안 대기 중인 전송은 보류됩니다.
+// @Description
+// @Description ■ errCode with 400
+// @Description PARAM_BAD : 파라미터 오류
+// @Description
+// @Description ■ errCode with 404
+// @Description NOT_FOUND : 프로젝트, 웹훅 또는 구역 없음
+// @Description
+// @Description ■ errCode with 500
+// @Description INTERNAL_DB : DB 처리 실패
+// @Description
+// @Accept json
+// @Produce json
+// @Param        projectId   path      string  true  "Project ID"
+// @Param        webhookId   path      int     true  "Webhook ID"
+// @Param        request     body      request.ReqUpdateWebhook  true  "Update Webhook Request"
+// @Success 200 {object} response.ResWebhook
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags webhook
+func (d *UpdateWebhookHandler) UpdateWebhook(c echo.Context) error {
+	ctx, _, _ := common.CtxGenerate(c)
+
+	projectID := c.Param("projectId")
+	webhookID := c.Param("webhookId")
+
+	var req request.ReqUpdateWebhook
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"success": false,
+			"message": "요청 데이터 파싱 실패: " + err.Error(),
+		})
+	}
+
+	if err := usecase.ValidateUpdateWebhookRequest(req); err != nil {
+		return err
+	}
+
+	res, err := d.UseCase.UpdateWebhook(ctx, projectID, webhookID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,27 @@
+package _interface
+
+import "github.com/labstack/echo/v4"
+
+type ICreateWebhookHandler interface {
+	CreateWebhook(c echo.Context) error
+}
+
+type IListWebhookHandler interface {
+	ListWebhook(c echo.Context) error
+}
+
+type IUpdateWebhookHandler interface {
+	UpdateWebhook(c echo.Context) error
+}
+
+type IDeleteWebhookHandler interface {
+	DeleteWebhook(c echo.Context) error
+}
+
+type ITestWebhookHandler interface {
+	TestWebhook(c echo.Context) error
+}
+
+type IListWebhookDeliveryHandler interface {
+	ListWebhookDelivery(c echo.Context) error
+}
@@ -0,0 +1,48 @@
+package _interface
+
+import (
+	"context"
+	"main/common/db/mysql"
+	"time"
+)
+
+type ICreateWebhookRepository interface {
+	FindParkingZone(ctx context.Context, projectID string, zoneID uint) (mysql.ParkingZones, error)
+	CreateWebhookSubscription(ctx context.Context, subscription mysql.WebhookSubscriptions) (uint, error)
+}
+
+type IListWebhookRepository interface {
+	FindWebhookSubscriptions(ctx context.Context, projectID string) ([]mysql.WebhookSubscriptions, error)
+}
+
+type IUpdateWebhookRepository interface {
+	FindWebhookSubscription(ctx context.Context, projectID string, subscriptionID uint) (mysql.WebhookSubscriptions, error)
+	FindParkingZone(ctx context.Context, projectID string, zoneID uint) (mysql.ParkingZones, error)
+	UpdateWebhookSubscription(ctx context.Context, subscription mysql.WebhookSubscriptions) error
+}
+
+type IDeleteWebhookRepository interface {
+	FindWebhookSubscription(ctx context.Context, projectID string, subscriptionID uint) (mysql.WebhookSubscriptions, error)
+	DeleteWebhookSubscription(ctx context.Context, subscriptionID uint) error
+}
+
+type ITestWebhookRepository interface {
+	FindWebhookSubscription(ctx context.Context, projectID string, subscriptionID uint) (mysql.WebhookSubscriptions, error)
+	CreateWebhookDelivery(ctx context.Context, delivery mysql.WebhookDeliveries) (uint64, error)
+	UpdateWebhookDelivery(ctx context.Context, deliveryID uint64, fields map[string]interface{}) error
+}
+
+type IListWebhookDeliveryRepository interface {
+	FindWebhookSubscription(ctx context.Context, projectID string, subscriptionID uint) (mysql.WebhookSubscriptions, error)
+	FindWebhookDeliveries(ctx context.Context, subscriptionID uint, status string, limit int) ([]mysql.WebhookDeliveries, error)
+}
+
+type IDispatchWebhookRepository interface {
+	FindActiveWebhookSubscriptions(ctx context.Context, projectID string) ([]mysql.WebhookSubscriptions, error)
+	FindWebhookSubscriptionsByIDs(ctx context.Context, subscriptionIDs []uint) ([]mysql.WebhookSubscriptions, error)
+	InsertWebhookDeliveries(ctx context.Context, deliveries []mysql.WebhookDeliveries) (int64, error)
+	FindDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]mysql.WebhookDeliveries, error)
+	UpdateWebhookDelivery(ctx context.Context, deliveryID uint64, fields map[string]interface{}) error
+	DeleteWebhookDeliveries(ctx context.Context, before time.Time) (int64, error)
+	FindCameraLastObserved(ctx context.Context, projectID string) ([]mysql.SpaceState, error)
+}
@@ -0,0 +1,35 @@
+package _interface
+
+import (
+	"context"
+	"main/features/webhook/model/request"
+	"main/features/webhook/model/response"
+)
+
+type ICreateWebhookUseCase interface {
+	CreateWebhook(ctx context.Context, projectID string, req request.ReqCreateWebhook) (response.ResWebhook, error)
+}
+
+type IListWebhookUseCase interface {
+	ListWebhook(ctx context.Context, projectID string) (response.ResListWebhook, error)
+}
+
+type IUpdateWebhookUseCase interface {
+	UpdateWebhook(ctx context.Context, projectID string, webhookID string, req request.ReqUpdateWebhook) (response.ResWebhook, error)
+}
+
+type IDeleteWebhookUseCase interface {
+	DeleteWebhook(ctx context.Context, projectID string, webhookID string) (response.ResWebhook, error)
+}
+
+type ITestWebhookUseCase interface {
+	TestWebhook(ctx context.Context, projectID string, webhookID string) (response.ResWebhookDelivery, error)
+}
+
+type IListWebhookDeliveryUseCase interface {
+	ListWebhookDelivery(ctx context.Context, projectID string, webhookID string, req request.ReqListWebhookDelivery) (response.ResListWebhookDelivery, error)
+}
+
+type IDispatchWebhookUseCase interface {
+	StartWebhookDispatcher()
+}
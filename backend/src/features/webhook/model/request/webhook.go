@@ -0,0 +1,30 @@
+package request
+
+// ReqCreateWebhook 웹훅 구독 등록 (secret을 생략하면 서버에서 생성)
+type ReqCreateWebhook struct {
+	URL              string   `json:"url"`
+	Secret           string   `json:"secret"`
+	EventTypes       []string `json:"event_types"`
+	Description      string   `json:"description"`
+	ZoneID           *uint    `json:"zone_id"`
+	ThresholdPercent *float64 `json:"threshold_percent"`
+	StaleMinutes     int      `json:"stale_minutes"`
+	Active           *bool    `json:"active"`
+}
+
+// ReqUpdateWebhook 요청에 포함된 필드만 수정 (zone_id가 0이면 프로젝트 전체 기준으로 되돌림)
+type ReqUpdateWebhook struct {
+	URL              string   `json:"url"`
+	Secret           string   `json:"secret"`
+	EventTypes       []string `json:"event_types"`
+	Description      *string  `json:"description"`
+	ZoneID           *uint    `json:"zone_id"`
+	ThresholdPercent *float64 `json:"threshold_percent"`
+	StaleMinutes     int      `json:"stale_minutes"`
+	Active           *bool    `json:"active"`
+}
+
+type ReqListWebhookDelivery struct {
+	Status string `query:"status"`
+	Limit  int    `query:"limit"`
+}
@@ -0,0 +1,85 @@
+package response
+
+import "encoding/json"
+
+type ResListWebhook struct {
+	Success bool          `json:"success"`
+	Data    []WebhookItem `json:"data"`
+}
+
+type ResWebhook struct {
+	Success bool        `json:"success"`
+	Data    WebhookItem `json:"data"`
+}
+
+// WebhookItem 웹훅 구독 (secret은 등록하거나 바꿀 때만 응답에 포함)
+type WebhookItem struct {
+	ID               uint     `json:"id"`
+	ProjectID        string   `json:"project_id"`
+	URL              string   `json:"url"`
+	Secret           string   `json:"secret,omitempty"`
+	EventTypes       []string `json:"event_types"`
+	Description      string   `json:"description"`
+	ZoneID           *uint    `json:"zone_id"`
+	ThresholdPercent float64  `json:"threshold_percent"`
+	StaleMinutes     int      `json:"stale_minutes"`
+	Active           bool     `json:"active"`
+	CreatedAt        string   `json:"created_at"`
+	UpdatedAt        string   `json:"updated_at"`
+}
+
+type ResListWebhookDelivery struct {
+	Success bool                  `json:"success"`
+	Data    []WebhookDeliveryItem `json:"data"`
+}
+
+type ResWebhookDelivery struct {
+	Success bool                `json:"success"`
+	Data    WebhookDeliveryItem `json:"data"`
+}
+
+// WebhookDeliveryItem 전송 기록 (payload는 서명한 본문 그대로)
+type WebhookDeliveryItem struct {
+	ID             uint64          `json:"id"`
+	SubscriptionID uint            `json:"subscription_id"`
+	EventID        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	ResponseStatus int             `json:"response_status"`
+	ResponseBody   string          `json:"response_body"`
+	ErrorMessage   string          `json:"error_message"`
+	Payload        json.RawMessage `json:"payload"`
+	NextAttemptAt  string          `json:"next_attempt_at"`
+	DeliveredAt    string          `json:"delivered_at"`
+	CreatedAt      string          `json:"created_at"`
+}
+
+// ZoneThresholdEvent zone.threshold 이벤트 (direction은 above: 기준 이상으로 올라감, below: 기준 아래로 내려감)
+type ZoneThresholdEvent struct {
+	ZoneID           uint    `json:"zone_id"`
+	Lot              string  `json:"lot"`
+	Floor            string  `json:"floor"`
+	Zone             string  `json:"zone"`
+	Capacity         int     `json:"capacity"`
+	Occupied         int     `json:"occupied"`
+	Free             int     `json:"free"`
+	Unknown          int     `json:"unknown"`
+	OccupancyRate    float64 `json:"occupancy_rate"`
+	PreviousRate     float64 `json:"previous_rate"`
+	ThresholdPercent float64 `json:"threshold_percent"`
+	Direction        string  `json:"direction"`
+}
+
+// CameraStaleEvent camera.stale 이벤트 (같은 마지막 관측 시각으로는 한 번만 전송)
+type CameraStaleEvent struct {
+	CctvID         string `json:"cctv_id"`
+	LastObservedAt string `json:"last_observed_at"`
+	StaleMinutes   int    `json:"stale_minutes"`
+}
+
+// TestEvent webhook.test 이벤트
+type TestEvent struct {
+	SubscriptionID uint   `json:"subscription_id"`
+	Message        string `json:"message"`
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/webhook/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewCreateWebhookRepository(gormDB *gorm.DB) _interface.ICreateWebhookRepository {
+	return &CreateWebhookRepository{GormDB: gormDB}
+}
+
+func (r *CreateWebhookRepository) FindParkingZone(ctx context.Context, projectID string, zoneID uint) (mysql.ParkingZones, error) {
+	return findParkingZone(ctx, r.GormDB, projectID, zoneID)
+}
+
+func (r *CreateWebhookRepository) CreateWebhookSubscription(ctx context.Context, subscription mysql.WebhookSubscriptions) (uint, error) {
+	result := r.GormDB.WithContext(ctx).Create(&subscription)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return subscription.ID, nil
+}
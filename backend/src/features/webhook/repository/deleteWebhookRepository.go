@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/webhook/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewDeleteWebhookRepository(gormDB *gorm.DB) _interface.IDeleteWebhookRepository {
+	return &DeleteWebhookRepository{GormDB: gormDB}
+}
+
+func (r *DeleteWebhookRepository) FindWebhookSubscription(ctx context.Context, projectID string, subscriptionID uint) (mysql.WebhookSubscriptions, error) {
+	return findWebhookSubscription(ctx, r.GormDB, projectID, subscriptionID)
+}
+
+// DeleteWebhookSubscription 구독 삭제 (전송 기록은 FK ON DELETE CASCADE로 함께 삭제)
+func (r *DeleteWebhookRepository) DeleteWebhookSubscription(ctx context.Context, subscriptionID uint) error {
+	result := r.GormDB.WithContext(ctx).Where("id = ?", subscriptionID).Delete(&mysql.WebhookSubscriptions{})
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/webhook/model/interface"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func NewDispatchWebhookRepository(gormDB *gorm.DB) _interface.IDispatchWebhookRepository {
+	return &DispatchWebhookRepository{GormDB: gormDB}
+}
+
+// FindActiveWebhookSubscriptions 활성 구독 조회 (projectID가 비어 있으면 모든 프로젝트)
+func (r *DispatchWebhookRepository) FindActiveWebhookSubscriptions(ctx context.Context, projectID string) ([]mysql.WebhookSubscriptions, error) {
+	var subscriptions []mysql.WebhookSubscriptions
+	query := r.GormDB.WithContext(ctx).Where("active = ?", true)
+	if projectID != "" {
+		query = query.Where("project_id = ?", projectID)
+	}
+	result := query.Order("id").Find(&subscriptions)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return subscriptions, nil
+}
+
+func (r *DispatchWebhookRepository) FindWebhookSubscriptionsByIDs(ctx context.Context, subscriptionIDs []uint) ([]mysql.WebhookSubscriptions, error) {
+	var subscriptions []mysql.WebhookSubscriptions
+	if len(subscriptionIDs) == 0 {
+		return subscriptions, nil
+	}
+	result := r.GormDB.WithContext(ctx).Where("id IN ?", subscriptionIDs).Find(&subscriptions)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return subscriptions, nil
+}
+
+// InsertWebhookDeliveries 전송 기록 추가 (구독마다 같은 이벤트 ID는 한 번만, 실제로 추가된 수 반환)
+func (r *DispatchWebhookRepository) InsertWebhookDeliveries(ctx context.Context, deliveries []mysql.WebhookDeliveries) (int64, error) {
+	if len(deliveries) == 0 {
+		return 0, nil
+	}
+	result := r.GormDB.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&deliveries)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// FindDueWebhookDeliveries 전송할 때가 된 대기 중 기록 (비활성 구독의 기록은 다시 활성화될 때까지 보류)
+func (r *DispatchWebhookRepository) FindDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]mysql.WebhookDeliveries, error) {
+	var deliveries []mysql.WebhookDeliveries
+	result := r.GormDB.WithContext(ctx).
+		Table("webhook_deliveries AS d").
+		Select("d.*").
+		Joins("JOIN webhook_subscriptions AS s ON s.id = d.subscription_id AND s.active = ?", true).
+		Where("d.status = ? AND d.next_attempt_at <= ?", mysql.WebhookDeliveryStatusPending, now).
+		Order("d.next_attempt_at, d.id").
+		Limit(limit).
+		Find(&deliveries)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return deliveries, nil
+}
+
+func (r *DispatchWebhookRepository) UpdateWebhookDelivery(ctx context.Context, deliveryID uint64, fields map[string]interface{}) error {
+	return updateWebhookDelivery(ctx, r.GormDB, deliveryID, fields)
+}
+
+// DeleteWebhookDeliveries before 이전에 끝난(성공/실패) 전송 기록 삭제
+func (r *DispatchWebhookRepository) DeleteWebhookDeliveries(ctx context.Context, before time.Time) (int64, error) {
+	result := r.GormDB.WithContext(ctx).
+		Where("status IN ? AND created_at < ?", []string{mysql.WebhookDeliveryStatusSucceeded, mysql.WebhookDeliveryStatusFailed}, before).
+		Delete(&mysql.WebhookDeliveries{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// FindCameraLastObserved CCTV별 마지막 관측 시각 (cctv_id, observed_at만 채움)
+func (r *DispatchWebhookRepository) FindCameraLastObserved(ctx context.Context, projectID string) ([]mysql.SpaceState, error) {
+	var rows []mysql.SpaceState
+	result := r.GormDB.WithContext(ctx).
+		Model(&mysql.SpaceState{}).
+		Select("cctv_id, MAX(observed_at) AS observed_at").
+		Where("project_id = ?", projectID).
+		Group("cctv_id").
+		Order("cctv_id").
+		Find(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return rows, nil
+}
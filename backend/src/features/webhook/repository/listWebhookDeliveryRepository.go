@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/webhook/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewListWebhookDeliveryRepository(gormDB *gorm.DB) _interface.IListWebhookDeliveryRepository {
+	return &ListWebhookDeliveryRepository{GormDB: gormDB}
+}
+
+func (r *ListWebhookDeliveryRepository) FindWebhookSubscription(ctx context.Context, projectID string, subscriptionID uint) (mysql.WebhookSubscriptions, error) {
+	return findWebhookSubscription(ctx, r.GormDB, projectID, subscriptionID)
+}
+
+func (r *ListWebhookDeliveryRepository) FindWebhookDeliveries(ctx context.Context, subscriptionID uint, status string, limit int) ([]mysql.WebhookDeliveries, error) {
+	var deliveries []mysql.WebhookDeliveries
+	query := r.GormDB.WithContext(ctx).Where("subscription_id = ?", subscriptionID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	result := query.Order("id DESC").Limit(limit).Find(&deliveries)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return deliveries, nil
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/webhook/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewListWebhookRepository(gormDB *gorm.DB) _interface.IListWebhookRepository {
+	return &ListWebhookRepository{GormDB: gormDB}
+}
+
+func (r *ListWebhookRepository) FindWebhookSubscriptions(ctx context.Context, projectID string) ([]mysql.WebhookSubscriptions, error) {
+	var subscriptions []mysql.WebhookSubscriptions
+	result := r.GormDB.WithContext(ctx).Where("project_id = ?", projectID).Order("id").Find(&subscriptions)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return subscriptions, nil
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+
+	"gorm.io/gorm"
+)
+
+type CreateWebhookRepository struct {
+	GormDB *gorm.DB
+}
+
+type ListWebhookRepository struct {
+	GormDB *gorm.DB
+}
+
+type UpdateWebhookRepository struct {
+	GormDB *gorm.DB
+}
+
+type DeleteWebhookRepository struct {
+	GormDB *gorm.DB
+}
+
+type TestWebhookRepository struct {
+	GormDB *gorm.DB
+}
+
+type ListWebhookDeliveryRepository struct {
+	GormDB *gorm.DB
+}
+
+type DispatchWebhookRepository struct {
+	GormDB *gorm.DB
+}
+
+// findWebhookSubscription 프로젝트의 구독 단건 조회 (없으면 gorm.ErrRecordNotFound)
+func findWebhookSubscription(ctx context.Context, db *gorm.DB, projectID string, subscriptionID uint) (mysql.WebhookSubscriptions, error) {
+	var subscription mysql.WebhookSubscriptions
+	result := db.WithContext(ctx).Where("project_id = ? AND id = ?", projectID, subscriptionID).First(&subscription)
+	if result.Error != nil {
+		return mysql.WebhookSubscriptions{}, result.Error
+	}
+	return subscription, nil
+}
+
+// findParkingZone 프로젝트의 구역 단건 조회 (없으면 gorm.ErrRecordNotFound)
+func findParkingZone(ctx context.Context, db *gorm.DB, projectID string, zoneID uint) (mysql.ParkingZones, error) {
+	var zone mysql.ParkingZones
+	result := db.WithContext(ctx).Where("project_id = ? AND id = ?", projectID, zoneID).First(&zone)
+	if result.Error != nil {
+		return mysql.ParkingZones{}, result.Error
+	}
+	return zone, nil
+}
+
+func updateWebhookDelivery(ctx context.Context, db *gorm.DB, deliveryID uint64, fields map[string]interface{}) error {
+	result := db.WithContext(ctx).Model(&mysql.WebhookDeliveries{}).Where("id = ?", deliveryID).Updates(fields)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/webhook/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewTestWebhookRepository(gormDB *gorm.DB) _interface.ITestWebhookRepository {
+	return &TestWebhookRepository{GormDB: gormDB}
+}
+
+func (r *TestWebhookRepository) FindWebhookSubscription(ctx context.Context, projectID string, subscriptionID uint) (mysql.WebhookSubscriptions, error) {
+	return findWebhookSubscription(ctx, r.GormDB, projectID, subscriptionID)
+}
+
+func (r *TestWebhookRepository) CreateWebhookDelivery(ctx context.Context, delivery mysql.WebhookDeliveries) (uint64, error) {
+	result := r.GormDB.WithContext(ctx).Create(&delivery)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return delivery.ID, nil
+}
+
+func (r *TestWebhookRepository) UpdateWebhookDelivery(ctx context.Context, deliveryID uint64, fields map[string]interface{}) error {
+	return updateWebhookDelivery(ctx, r.GormDB, deliveryID, fields)
+}
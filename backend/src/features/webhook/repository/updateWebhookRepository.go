@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/webhook/model/interface"
+
+	"gorm.io/gorm"
+)
+
+func NewUpdateWebhookRepository(gormDB *gorm.DB) _interface.IUpdateWebhookRepository {
+	return &UpdateWebhookRepository{GormDB: gormDB}
+}
+
+func (r *UpdateWebhookRepository) FindWebhookSubscription(ctx context.Context, projectID string, subscriptionID uint) (mysql.WebhookSubscriptions, error) {
+	return findWebhookSubscription(ctx, r.GormDB, projectID, subscriptionID)
+}
+
+func (r *UpdateWebhookRepository) FindParkingZone(ctx context.Context, projectID string, zoneID uint) (mysql.ParkingZones, error) {
+	return findParkingZone(ctx, r.GormDB, projectID, zoneID)
+}
+
+func (r *UpdateWebhookRepository) UpdateWebhookSubscription(ctx context.Context, subscription mysql.WebhookSubscriptions) error {
+	result := r.GormDB.WithContext(ctx).Save(&subscription)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
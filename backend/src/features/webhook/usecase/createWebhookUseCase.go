@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"main/common"
+	"main/common/db/mysql"
+	"main/common/webhook"
+	_interface "main/features/webhook/model/interface"
+	"main/features/webhook/model/request"
+	"main/features/webhook/model/response"
+	"time"
+)
+
+type CreateWebhookUseCase struct {
+	Repository     _interface.ICreateWebhookRepository
+	ContextTimeout time.Duration
+}
+
+func NewCreateWebhookUseCase(repo _interface.ICreateWebhookRepository, timeout time.Duration) _interface.ICreateWebhookUseCase {
+	return &CreateWebhookUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// CreateWebhook 구독 등록 (secret은 이 응답에서만 확인 가능)
+func (d *CreateWebhookUseCase) CreateWebhook(c context.Context, projectID string, req request.ReqCreateWebhook) (response.ResWebhook, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	var zoneID *uint
+	if req.ZoneID != nil && *req.ZoneID != 0 {
+		if err := checkWebhookZone(ctx, d.Repository.FindParkingZone, projectID, *req.ZoneID); err != nil {
+			return response.ResWebhook{}, err
+		}
+		zoneID = req.ZoneID
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		generated, err := webhook.NewSecret()
+		if err != nil {
+			return response.ResWebhook{}, common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), fmt.Sprintf("서명 키 생성 실패: %v", err), common.ErrFromInternal)
+		}
+		secret = generated
+	}
+	threshold := float64(defaultThresholdPercent)
+	if req.ThresholdPercent != nil {
+		threshold = *req.ThresholdPercent
+	}
+	staleMinutes := req.StaleMinutes
+	if staleMinutes == 0 {
+		staleMinutes = defaultStaleMinutes
+	}
+	active := true
+	if req.Active != nil {
+		active = *req.Active
+	}
+
+	now := time.Now()
+	subscription := mysql.WebhookSubscriptions{
+		ProjectId:        projectID,
+		Url:              req.URL,
+		Secret:           secret,
+		EventTypes:       joinEventTypes(req.EventTypes),
+		Description:      req.Description,
+		ZoneId:           zoneID,
+		ThresholdPercent: threshold,
+		StaleMinutes:     staleMinutes,
+		Active:           active,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	id, err := d.Repository.CreateWebhookSubscription(ctx, subscription)
+	if err != nil {
+		return response.ResWebhook{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("웹훅 등록 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	subscription.ID = id
+
+	item := toWebhookItem(subscription)
+	item.Secret = secret
+	return response.ResWebhook{
+		Success: true,
+		Data:    item,
+	}, nil
+}
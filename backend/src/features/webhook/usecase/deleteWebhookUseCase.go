@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"main/common"
+	_interface "main/features/webhook/model/interface"
+	"main/features/webhook/model/response"
+	"time"
+)
+
+type DeleteWebhookUseCase struct {
+	Repository     _interface.IDeleteWebhookRepository
+	ContextTimeout time.Duration
+}
+
+func NewDeleteWebhookUseCase(repo _interface.IDeleteWebhookRepository, timeout time.Duration) _interface.IDeleteWebhookUseCase {
+	return &DeleteWebhookUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// DeleteWebhook 구독과 전송 기록 삭제 (대기 중인 전송도 함께 취소됨)
+func (d *DeleteWebhookUseCase) DeleteWebhook(c context.Context, projectID string, webhookID string) (response.ResWebhook, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	subscription, err := findWebhook(ctx, d.Repository.FindWebhookSubscription, projectID, webhookID)
+	if err != nil {
+		return response.ResWebhook{}, err
+	}
+
+	if err := d.Repository.DeleteWebhookSubscription(ctx, subscription.ID); err != nil {
+		return response.ResWebhook{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("웹훅 삭제 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	return response.ResWebhook{
+		Success: true,
+		Data:    toWebhookItem(subscription),
+	}, nil
+}
@@ -0,0 +1,292 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"main/common/db/mysql"
+	"main/common/webhook"
+	_interface "main/features/webhook/model/interface"
+	"main/features/webhook/model/response"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// 대기 중 전송 확인 주기 (새 이벤트가 들어오면 바로 확인)
+	deliveryPollInterval = 5 * time.Second
+	// 한 번에 집어가는 대기 중 전송 수와 동시에 처리하는 구독 수
+	deliveryBatchSize   = 50
+	deliveryConcurrency = 4
+	// 오래된 CCTV 확인 주기
+	staleCheckInterval = time.Minute
+	// 끝난 전송 기록 보관 기간과 정리 주기
+	deliveryRetention       = 30 * 24 * time.Hour
+	deliveryCleanupInterval = time.Hour
+)
+
+type DispatchWebhookUseCase struct {
+	Repository     _interface.IDispatchWebhookRepository
+	ContextTimeout time.Duration
+	Client         *http.Client
+	MaxAttempts    int
+	wake           chan struct{}
+}
+
+func NewDispatchWebhookUseCase(repo _interface.IDispatchWebhookRepository, timeout time.Duration, client *http.Client, maxAttempts int) _interface.IDispatchWebhookUseCase {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	return &DispatchWebhookUseCase{
+		Repository:     repo,
+		ContextTimeout: timeout,
+		Client:         client,
+		MaxAttempts:    maxAttempts,
+		wake:           make(chan struct{}, 1),
+	}
+}
+
+// StartWebhookDispatcher 이벤트 구독 매칭, 대기 중 전송 처리, 오래된 CCTV 확인을 각각 고루틴으로 시작
+// 대기 중 전송은 DB에 남아 있어 서버가 재시작되어도 이어서 전송됨
+func (d *DispatchWebhookUseCase) StartWebhookDispatcher() {
+	go func() {
+		for event := range webhook.Events() {
+			d.handleEvent(event)
+		}
+	}()
+
+	go func() {
+		ticker := time.NewTicker(deliveryPollInterval)
+		defer ticker.Stop()
+		for {
+			d.deliverDue()
+			select {
+			case <-ticker.C:
+			case <-d.wake:
+			}
+		}
+	}()
+
+	go func() {
+		ticker := time.NewTicker(staleCheckInterval)
+		defer ticker.Stop()
+		var cleanedAt time.Time
+		for now := range ticker.C {
+			d.checkStaleCameras(now)
+			if now.Sub(cleanedAt) >= deliveryCleanupInterval {
+				d.cleanupDeliveries(now)
+				cleanedAt = now
+			}
+		}
+	}()
+}
+
+// notify 대기 중 전송 처리를 바로 깨움 (이미 깨어 있으면 무시)
+func (d *DispatchWebhookUseCase) notify() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+// handleEvent 이벤트를 받는 활성 구독마다 전송 기록 생성
+func (d *DispatchWebhookUseCase) handleEvent(event webhook.Event) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.ContextTimeout)
+	defer cancel()
+
+	subscriptions, err := d.Repository.FindActiveWebhookSubscriptions(ctx, event.ProjectID)
+	if err != nil {
+		fmt.Printf("웹훅 구독 조회 실패 (%s %s): %v\n", event.ProjectID, event.Type, err)
+		return
+	}
+
+	// 같은 이벤트는 구독이 달라도 같은 ID
+	eventID := uuid.NewString()
+	now := time.Now()
+	var deliveries []mysql.WebhookDeliveries
+	for _, subscription := range subscriptions {
+		if !subscribes(subscription, event.Type) {
+			continue
+		}
+		data, ok := eventData(subscription, event)
+		if !ok {
+			continue
+		}
+		delivery, err := buildDelivery(subscription, eventID, event.Type, data, event.Time, &now)
+		if err != nil {
+			fmt.Printf("웹훅 전송 본문 생성 실패 (%s): %v\n", event.Type, err)
+			continue
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	d.insertDeliveries(ctx, deliveries)
+}
+
+// eventData 구독에 보낼 이벤트 데이터 (zone.threshold는 구독의 구역과 기준을 넘나든 경우만)
+func eventData(subscription mysql.WebhookSubscriptions, event webhook.Event) (interface{}, bool) {
+	if event.Type != webhook.EventZoneThreshold {
+		return event.Data, true
+	}
+	zone, ok := event.Data.(webhook.ZoneOccupancy)
+	if !ok {
+		return nil, false
+	}
+	var zoneID uint
+	if subscription.ZoneId != nil {
+		zoneID = *subscription.ZoneId
+	}
+	if zone.ZoneID != zoneID {
+		return nil, false
+	}
+	direction, crossed := zone.Crossed(subscription.ThresholdPercent)
+	if !crossed {
+		return nil, false
+	}
+	return response.ZoneThresholdEvent{
+		ZoneID:           zone.ZoneID,
+		Lot:              zone.Lot,
+		Floor:            zone.Floor,
+		Zone:             zone.Zone,
+		Capacity:         zone.Capacity,
+		Occupied:         zone.Occupied,
+		Free:             zone.Free,
+		Unknown:          zone.Unknown,
+		OccupancyRate:    zone.OccupancyRate,
+		PreviousRate:     zone.PreviousRate,
+		ThresholdPercent: subscription.ThresholdPercent,
+		Direction:        direction,
+	}, true
+}
+
+func (d *DispatchWebhookUseCase) insertDeliveries(ctx context.Context, deliveries []mysql.WebhookDeliveries) {
+	if len(deliveries) == 0 {
+		return
+	}
+	count, err := d.Repository.InsertWebhookDeliveries(ctx, deliveries)
+	if err != nil {
+		fmt.Printf("웹훅 전송 기록 생성 실패: %v\n", err)
+		return
+	}
+	if count > 0 {
+		d.notify()
+	}
+}
+
+// deliverDue 전송할 때가 된 기록을 모두 처리 (구독 하나의 기록은 순서대로, 구독끼리는 동시에)
+func (d *DispatchWebhookUseCase) deliverDue() {
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), d.ContextTimeout)
+		deliveries, err := d.Repository.FindDueWebhookDeliveries(ctx, time.Now(), deliveryBatchSize)
+		if err != nil {
+			cancel()
+			fmt.Printf("대기 중 웹훅 전송 조회 실패: %v\n", err)
+			return
+		}
+		var ids []uint
+		bySubscription := make(map[uint][]mysql.WebhookDeliveries)
+		for _, delivery := range deliveries {
+			if _, ok := bySubscription[delivery.SubscriptionId]; !ok {
+				ids = append(ids, delivery.SubscriptionId)
+			}
+			bySubscription[delivery.SubscriptionId] = append(bySubscription[delivery.SubscriptionId], delivery)
+		}
+		subscriptions, err := d.Repository.FindWebhookSubscriptionsByIDs(ctx, ids)
+		cancel()
+		if err != nil {
+			fmt.Printf("웹훅 구독 조회 실패: %v\n", err)
+			return
+		}
+
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, deliveryConcurrency)
+		for _, subscription := range subscriptions {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(subscription mysql.WebhookSubscriptions, deliveries []mysql.WebhookDeliveries) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				for i := range deliveries {
+					d.deliver(subscription, &deliveries[i])
+				}
+			}(subscription, bySubscription[subscription.ID])
+		}
+		wg.Wait()
+
+		if len(deliveries) < deliveryBatchSize {
+			return
+		}
+	}
+}
+
+// deliver 한 번 전송하고 결과 저장
+func (d *DispatchWebhookUseCase) deliver(subscription mysql.WebhookSubscriptions, delivery *mysql.WebhookDeliveries) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.ContextTimeout)
+	defer cancel()
+
+	fields := attemptDelivery(ctx, d.Client, subscription, delivery, d.MaxAttempts)
+	if err := d.Repository.UpdateWebhookDelivery(context.WithoutCancel(ctx), delivery.ID, fields); err != nil {
+		fmt.Printf("웹훅 전송 결과 저장 실패 (%d): %v\n", delivery.ID, err)
+	}
+}
+
+// checkStaleCameras 마지막 관측 이미지가 구독의 stale_minutes보다 오래된 CCTV 알림
+// 이벤트 ID에 마지막 관측 시각을 넣어 같은 정지 상태로는 한 번만 전송
+func (d *DispatchWebhookUseCase) checkStaleCameras(now time.Time) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.ContextTimeout)
+	defer cancel()
+
+	subscriptions, err := d.Repository.FindActiveWebhookSubscriptions(ctx, "")
+	if err != nil {
+		fmt.Printf("웹훅 구독 조회 실패: %v\n", err)
+		return
+	}
+	byProject := make(map[string][]mysql.WebhookSubscriptions)
+	for _, subscription := range subscriptions {
+		if subscribes(subscription, webhook.EventCameraStale) {
+			byProject[subscription.ProjectId] = append(byProject[subscription.ProjectId], subscription)
+		}
+	}
+
+	var deliveries []mysql.WebhookDeliveries
+	for projectID, projectSubscriptions := range byProject {
+		cameras, err := d.Repository.FindCameraLastObserved(ctx, projectID)
+		if err != nil {
+			fmt.Printf("CCTV 관측 시각 조회 실패 (%s): %v\n", projectID, err)
+			continue
+		}
+		for _, subscription := range projectSubscriptions {
+			for _, camera := range cameras {
+				if now.Sub(camera.ObservedAt) < time.Duration(subscription.StaleMinutes)*time.Minute {
+					continue
+				}
+				eventID := fmt.Sprintf("%s:%s:%d", webhook.EventCameraStale, camera.CctvId, camera.ObservedAt.Unix())
+				delivery, err := buildDelivery(subscription, eventID, webhook.EventCameraStale, response.CameraStaleEvent{
+					CctvID:         camera.CctvId,
+					LastObservedAt: camera.ObservedAt.Format(time.RFC3339),
+					StaleMinutes:   subscription.StaleMinutes,
+				}, now, &now)
+				if err != nil {
+					fmt.Printf("웹훅 전송 본문 생성 실패 (%s): %v\n", webhook.EventCameraStale, err)
+					continue
+				}
+				deliveries = append(deliveries, delivery)
+			}
+		}
+	}
+	d.insertDeliveries(ctx, deliveries)
+}
+
+// cleanupDeliveries 보관 기간이 지난 끝난 전송 기록 삭제
+func (d *DispatchWebhookUseCase) cleanupDeliveries(now time.Time) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.ContextTimeout)
+	defer cancel()
+
+	count, err := d.Repository.DeleteWebhookDeliveries(ctx, now.Add(-deliveryRetention))
+	if err != nil {
+		fmt.Printf("웹훅 전송 기록 정리 실패: %v\n", err)
+	} else if count > 0 {
+		fmt.Printf("웹훅 전송 기록 %d개 삭제\n", count)
+	}
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"main/common"
+	_interface "main/features/webhook/model/interface"
+	"main/features/webhook/model/request"
+	"main/features/webhook/model/response"
+	"time"
+)
+
+type ListWebhookDeliveryUseCase struct {
+	Repository     _interface.IListWebhookDeliveryRepository
+	ContextTimeout time.Duration
+}
+
+func NewListWebhookDeliveryUseCase(repo _interface.IListWebhookDeliveryRepository, timeout time.Duration) _interface.IListWebhookDeliveryUseCase {
+	return &ListWebhookDeliveryUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// ListWebhookDelivery 구독의 전송 기록을 최신 순으로 조회
+func (d *ListWebhookDeliveryUseCase) ListWebhookDelivery(c context.Context, projectID string, webhookID string, req request.ReqListWebhookDelivery) (response.ResListWebhookDelivery, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	subscription, err := findWebhook(ctx, d.Repository.FindWebhookSubscription, projectID, webhookID)
+	if err != nil {
+		return response.ResListWebhookDelivery{}, err
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultDeliveryListLimit
+	}
+	deliveries, err := d.Repository.FindWebhookDeliveries(ctx, subscription.ID, req.Status, limit)
+	if err != nil {
+		return response.ResListWebhookDelivery{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("전송 기록 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	items := []response.WebhookDeliveryItem{}
+	for _, delivery := range deliveries {
+		items = append(items, toWebhookDeliveryItem(delivery))
+	}
+
+	return response.ResListWebhookDelivery{
+		Success: true,
+		Data:    items,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"main/common"
+	_interface "main/features/webhook/model/interface"
+	"main/features/webhook/model/response"
+	"time"
+)
+
+type ListWebhookUseCase struct {
+	Repository     _interface.IListWebhookRepository
+	ContextTimeout time.Duration
+}
+
+func NewListWebhookUseCase(repo _interface.IListWebhookRepository, timeout time.Duration) _interface.IListWebhookUseCase {
+	return &ListWebhookUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+func (d *ListWebhookUseCase) ListWebhook(c context.Context, projectID string) (response.ResListWebhook, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	subscriptions, err := d.Repository.FindWebhookSubscriptions(ctx, projectID)
+	if err != nil {
+		return response.ResListWebhook{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("웹훅 목록 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	items := []response.WebhookItem{}
+	for _, subscription := range subscriptions {
+		items = append(items, toWebhookItem(subscription))
+	}
+
+	return response.ResListWebhook{
+		Success: true,
+		Data:    items,
+	}, nil
+}
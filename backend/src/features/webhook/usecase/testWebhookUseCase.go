@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"main/common"
+	"main/common/webhook"
+	_interface "main/features/webhook/model/interface"
+	"main/features/webhook/model/response"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TestWebhookUseCase struct {
+	Repository     _interface.ITestWebhookRepository
+	ContextTimeout time.Duration
+	Client         *http.Client
+}
+
+func NewTestWebhookUseCase(repo _interface.ITestWebhookRepository, timeout time.Duration, client *http.Client) _interface.ITestWebhookUseCase {
+	return &TestWebhookUseCase{Repository: repo, ContextTimeout: timeout, Client: client}
+}
+
+// TestWebhook webhook.test 이벤트를 바로 한 번 전송하고 결과 반환 (비활성 구독도 전송, 재시도하지 않음)
+func (d *TestWebhookUseCase) TestWebhook(c context.Context, projectID string, webhookID string) (response.ResWebhookDelivery, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	subscription, err := findWebhook(ctx, d.Repository.FindWebhookSubscription, projectID, webhookID)
+	if err != nil {
+		return response.ResWebhookDelivery{}, err
+	}
+
+	now := time.Now()
+	delivery, err := buildDelivery(subscription, uuid.NewString(), webhook.EventTest, response.TestEvent{
+		SubscriptionID: subscription.ID,
+		Message:        "웹훅 테스트 전송입니다",
+	}, now, nil)
+	if err != nil {
+		return response.ResWebhookDelivery{}, common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), fmt.Sprintf("전송 본문 생성 실패: %v", err), common.ErrFromInternal)
+	}
+	delivery.CreatedAt = now
+	delivery.UpdatedAt = now
+	delivery.ID, err = d.Repository.CreateWebhookDelivery(ctx, delivery)
+	if err != nil {
+		return response.ResWebhookDelivery{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("전송 기록 생성 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	// 수신 측 실패도 전송 기록으로 돌려주므로 에러로 바꾸지 않음
+	fields := attemptDelivery(ctx, d.Client, subscription, &delivery, 1)
+	if err := d.Repository.UpdateWebhookDelivery(context.WithoutCancel(ctx), delivery.ID, fields); err != nil {
+		return response.ResWebhookDelivery{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("전송 기록 저장 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	return response.ResWebhookDelivery{
+		Success: true,
+		Data:    toWebhookDeliveryItem(delivery),
+	}, nil
+}
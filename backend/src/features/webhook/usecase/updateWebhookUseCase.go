@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"main/common"
+	_interface "main/features/webhook/model/interface"
+	"main/features/webhook/model/request"
+	"main/features/webhook/model/response"
+	"time"
+)
+
+type UpdateWebhookUseCase struct {
+	Repository     _interface.IUpdateWebhookRepository
+	ContextTimeout time.Duration
+}
+
+func NewUpdateWebhookUseCase(repo _interface.IUpdateWebhookRepository, timeout time.Duration) _interface.IUpdateWebhookUseCase {
+	return &UpdateWebhookUseCase{Repository: repo, ContextTimeout: timeout}
+}
+
+// UpdateWebhook 요청에 포함된 필드만 수정 (secret을 바꾸면 응답에 새 secret 포함)
+func (d *UpdateWebhookUseCase) UpdateWebhook(c context.Context, projectID string, webhookID string, req request.ReqUpdateWebhook) (response.ResWebhook, error) {
+	ctx, cancel := context.WithTimeout(c, d.ContextTimeout)
+	defer cancel()
+
+	subscription, err := findWebhook(ctx, d.Repository.FindWebhookSubscription, projectID, webhookID)
+	if err != nil {
+		return response.ResWebhook{}, err
+	}
+
+	if req.URL != "" {
+		subscription.Url = req.URL
+	}
+	if req.Secret != "" {
+		subscription.Secret = req.Secret
+	}
+	if len(req.EventTypes) > 0 {
+		subscription.EventTypes = joinEventTypes(req.EventTypes)
+	}
+	if req.Description != nil {
+		subscription.Description = *req.Description
+	}
+	if req.ZoneID != nil {
+		if err := checkWebhookZone(ctx, d.Repository.FindParkingZone, projectID, *req.ZoneID); err != nil {
+			return response.ResWebhook{}, err
+		}
+		subscription.ZoneId = nil
+		if *req.ZoneID != 0 {
+			subscription.ZoneId = req.ZoneID
+		}
+	}
+	if req.ThresholdPercent != nil {
+		subscription.ThresholdPercent = *req.ThresholdPercent
+	}
+	if req.StaleMinutes != 0 {
+		subscription.StaleMinutes = req.StaleMinutes
+	}
+	if req.Active != nil {
+		subscription.Active = *req.Active
+	}
+	subscription.UpdatedAt = time.Now()
+
+	if err := d.Repository.UpdateWebhookSubscription(ctx, subscription); err != nil {
+		return response.ResWebhook{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("웹훅 수정 실패: %v", err), common.ErrFromMysqlDB)
+	}
+
+	item := toWebhookItem(subscription)
+	item.Secret = req.Secret
+	return response.ResWebhook{
+		Success: true,
+		Data:    item,
+	}, nil
+}
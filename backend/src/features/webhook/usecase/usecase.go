@@ -0,0 +1,281 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"main/common"
+	"main/common/db/mysql"
+	"main/common/webhook"
+	"main/features/webhook/model/request"
+	"main/features/webhook/model/response"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+const (
+	// 구독 기본값 (threshold_percent 100은 만차, stale_minutes는 마지막 관측 이미지 기준)
+	defaultThresholdPercent = 100
+	defaultStaleMinutes     = 10
+	maxStaleMinutes         = 1440
+
+	defaultDeliveryListLimit = 50
+	maxDeliveryListLimit     = 200
+
+	// 기록용으로 잘라 저장하는 오류 메시지 길이
+	maxDeliveryErrorLength = 1000
+)
+
+// 파라미터 검증 함수
+func ValidateCreateWebhookRequest(req request.ReqCreateWebhook) error {
+	if err := validateWebhookURL(req.URL); err != nil {
+		return err
+	}
+	if len(req.EventTypes) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("event_types는 %s 중 하나 이상이어야 합니다", strings.Join(webhook.EventTypes, ", ")))
+	}
+	return validateWebhookFields(req.Secret, req.EventTypes, req.Description, req.ThresholdPercent, req.StaleMinutes)
+}
+
+// 파라미터 검증 함수
+func ValidateUpdateWebhookRequest(req request.ReqUpdateWebhook) error {
+	if req.URL != "" {
+		if err := validateWebhookURL(req.URL); err != nil {
+			return err
+		}
+	}
+	description := ""
+	if req.Description != nil {
+		description = *req.Description
+	}
+	return validateWebhookFields(req.Secret, req.EventTypes, description, req.ThresholdPercent, req.StaleMinutes)
+}
+
+// 파라미터 검증 함수
+func ValidateListWebhookDeliveryRequest(req request.ReqListWebhookDelivery) error {
+	switch req.Status {
+	case "", mysql.WebhookDeliveryStatusPending, mysql.WebhookDeliveryStatusSucceeded, mysql.WebhookDeliveryStatusFailed:
+	default:
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("status는 pending, succeeded, failed 중 하나여야 합니다. %s", req.Status))
+	}
+	if req.Limit < 0 || req.Limit > maxDeliveryListLimit {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("limit는 0 이상 %d 이하여야 합니다. %d", maxDeliveryListLimit, req.Limit))
+	}
+	return nil
+}
+
+// validateWebhookURL http/https 절대 URL만 허용 (로컬 수신기 테스트를 위해 localhost도 허용)
+func validateWebhookURL(rawURL string) error {
+	if len(rawURL) > 500 {
+		return echo.NewHTTPError(http.StatusBadRequest, "url은 500자 이하여야 합니다")
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("url은 http 또는 https 주소여야 합니다. %s", rawURL))
+	}
+	return nil
+}
+
+func validateWebhookFields(secret string, eventTypes []string, description string, thresholdPercent *float64, staleMinutes int) error {
+	if secret != "" && (len(secret) < 16 || len(secret) > 100) {
+		return echo.NewHTTPError(http.StatusBadRequest, "secret은 16자 이상 100자 이하여야 합니다")
+	}
+	for _, eventType := range eventTypes {
+		if !webhook.IsEventType(eventType) {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("event_types는 %s 중에서 골라야 합니다. %s", strings.Join(webhook.EventTypes, ", "), eventType))
+		}
+	}
+	if len(description) > 200 {
+		return echo.NewHTTPError(http.StatusBadRequest, "description은 200자 이하여야 합니다")
+	}
+	if thresholdPercent != nil && (*thresholdPercent <= 0 || *thresholdPercent > 100) {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("threshold_percent는 0 초과 100 이하여야 합니다. %v", *thresholdPercent))
+	}
+	if staleMinutes < 0 || staleMinutes > maxStaleMinutes {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("stale_minutes는 0(기본 %d분) 이상 %d 이하여야 합니다. %d", defaultStaleMinutes, maxStaleMinutes, staleMinutes))
+	}
+	return nil
+}
+
+// joinEventTypes 중복을 없애고 쉼표로 연결 (요청 순서 유지)
+func joinEventTypes(eventTypes []string) string {
+	seen := make(map[string]bool, len(eventTypes))
+	var unique []string
+	for _, eventType := range eventTypes {
+		if !seen[eventType] {
+			seen[eventType] = true
+			unique = append(unique, eventType)
+		}
+	}
+	return strings.Join(unique, ",")
+}
+
+// subscribes 구독이 이벤트 종류를 받는지 확인
+func subscribes(subscription mysql.WebhookSubscriptions, eventType string) bool {
+	for _, t := range strings.Split(subscription.EventTypes, ",") {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// parseWebhookID path 파라미터의 구독 ID 변환
+func parseWebhookID(webhookID string) (uint, error) {
+	id, err := strconv.ParseUint(webhookID, 10, 32)
+	if err != nil || id == 0 {
+		return 0, fmt.Errorf("잘못된 웹훅 ID입니다: %s", webhookID)
+	}
+	return uint(id), nil
+}
+
+// findWebhook 구독 ID 검사 후 조회 (없으면 NOT_FOUND 에러로 변환)
+func findWebhook(ctx context.Context, find func(context.Context, string, uint) (mysql.WebhookSubscriptions, error), projectID string, webhookID string) (mysql.WebhookSubscriptions, error) {
+	id, err := parseWebhookID(webhookID)
+	if err != nil {
+		return mysql.WebhookSubscriptions{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+	subscription, err := find(ctx, projectID, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return mysql.WebhookSubscriptions{}, common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), fmt.Sprintf("웹훅을 찾을 수 없습니다: %s", webhookID), common.ErrFromClient)
+	}
+	if err != nil {
+		return mysql.WebhookSubscriptions{}, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("웹훅 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	return subscription, nil
+}
+
+// checkWebhookZone zone_id가 프로젝트의 구역인지 확인 (0이면 프로젝트 전체라 확인하지 않음)
+func checkWebhookZone(ctx context.Context, find func(context.Context, string, uint) (mysql.ParkingZones, error), projectID string, zoneID uint) error {
+	if zoneID == 0 {
+		return nil
+	}
+	_, err := find(ctx, projectID, zoneID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), fmt.Sprintf("구역을 찾을 수 없습니다: %d", zoneID), common.ErrFromClient)
+	}
+	if err != nil {
+		return common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("구역 조회 실패: %v", err), common.ErrFromMysqlDB)
+	}
+	return nil
+}
+
+// buildDelivery 이벤트를 서명할 본문으로 만들어 대기 중 전송 기록 생성 (nextAttemptAt이 nil이면 전송 처리에서 집어가지 않음)
+func buildDelivery(subscription mysql.WebhookSubscriptions, eventID string, eventType string, data interface{}, occurredAt time.Time, nextAttemptAt *time.Time) (mysql.WebhookDeliveries, error) {
+	payload, err := json.Marshal(webhook.Envelope{
+		ID:         eventID,
+		Type:       eventType,
+		ProjectID:  subscription.ProjectId,
+		OccurredAt: occurredAt.Format(time.RFC3339),
+		Data:       data,
+	})
+	if err != nil {
+		return mysql.WebhookDeliveries{}, err
+	}
+	return mysql.WebhookDeliveries{
+		SubscriptionId: subscription.ID,
+		ProjectId:      subscription.ProjectId,
+		EventId:        eventID,
+		EventType:      eventType,
+		Payload:        string(payload),
+		Status:         mysql.WebhookDeliveryStatusPending,
+		NextAttemptAt:  nextAttemptAt,
+	}, nil
+}
+
+// attemptDelivery 한 번 전송하고 결과를 delivery에 반영, DB에 저장할 필드 반환
+// 실패하면 maxAttempts까지 백오프 후 재시도하도록 다음 시각을 잡고, 다 쓰면 failed
+func attemptDelivery(ctx context.Context, client *http.Client, subscription mysql.WebhookSubscriptions, delivery *mysql.WebhookDeliveries, maxAttempts int) map[string]interface{} {
+	result := webhook.Send(ctx, client, subscription.Url, subscription.Secret, delivery.EventType, strconv.FormatUint(delivery.ID, 10), []byte(delivery.Payload))
+	now := time.Now()
+
+	delivery.Attempts++
+	delivery.ResponseStatus = result.StatusCode
+	delivery.ResponseBody = result.Body
+	delivery.ErrorMessage = ""
+	delivery.NextAttemptAt = nil
+	switch {
+	case result.Err == nil:
+		delivery.Status = mysql.WebhookDeliveryStatusSucceeded
+		delivery.DeliveredAt = &now
+	case delivery.Attempts >= maxAttempts:
+		delivery.Status = mysql.WebhookDeliveryStatusFailed
+		delivery.ErrorMessage = truncateMessage(result.Err.Error())
+	default:
+		next := now.Add(webhook.Backoff(delivery.Attempts))
+		delivery.Status = mysql.WebhookDeliveryStatusPending
+		delivery.ErrorMessage = truncateMessage(result.Err.Error())
+		delivery.NextAttemptAt = &next
+	}
+
+	return map[string]interface{}{
+		"status":          delivery.Status,
+		"attempts":        delivery.Attempts,
+		"response_status": delivery.ResponseStatus,
+		"response_body":   delivery.ResponseBody,
+		"error_message":   delivery.ErrorMessage,
+		"next_attempt_at": delivery.NextAttemptAt,
+		"delivered_at":    delivery.DeliveredAt,
+	}
+}
+
+func truncateMessage(message string) string {
+	if len(message) <= maxDeliveryErrorLength {
+		return message
+	}
+	return strings.ToValidUTF8(message[:maxDeliveryErrorLength], "")
+}
+
+// toWebhookItem 구독 응답 변환 (secret은 포함하지 않음)
+func toWebhookItem(subscription mysql.WebhookSubscriptions) response.WebhookItem {
+	eventTypes := []string{}
+	if subscription.EventTypes != "" {
+		eventTypes = strings.Split(subscription.EventTypes, ",")
+	}
+	return response.WebhookItem{
+		ID:               subscription.ID,
+		ProjectID:        subscription.ProjectId,
+		URL:              subscription.Url,
+		EventTypes:       eventTypes,
+		Description:      subscription.Description,
+		ZoneID:           subscription.ZoneId,
+		ThresholdPercent: subscription.ThresholdPercent,
+		StaleMinutes:     subscription.StaleMinutes,
+		Active:           subscription.Active,
+		CreatedAt:        subscription.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:        subscription.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// toWebhookDeliveryItem 전송 기록 응답 변환
+func toWebhookDeliveryItem(delivery mysql.WebhookDeliveries) response.WebhookDeliveryItem {
+	return response.WebhookDeliveryItem{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionId,
+		EventID:        delivery.EventId,
+		EventType:      delivery.EventType,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		ResponseBody:   delivery.ResponseBody,
+		ErrorMessage:   delivery.ErrorMessage,
+		Payload:        json.RawMessage(delivery.Payload),
+		NextAttemptAt:  formatTime(delivery.NextAttemptAt),
+		DeliveredAt:    formatTime(delivery.DeliveredAt),
+		CreatedAt:      delivery.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -19,6 +19,8 @@ func InitMiddleware(e *echo.Echo) error {
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: []string{"*"},
 		AllowMethods: []string{echo.GET, echo.HEAD, echo.PUT, echo.PATCH, echo.POST, echo.DELETE},
+		// ROI draft 낙관적 잠금용 ETag를 브라우저에서 읽을 수 있도록 노출
+		ExposeHeaders: []string{"ETag"},
 	}))
 
 	// multipart 메시지 크기 제한 설정 (기본값: 32MB -> 2GB)
@@ -0,0 +1,53 @@
+package _middleware
+
+import (
+	"errors"
+	"fmt"
+	"main/common"
+	"main/common/db/mysql"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// ProjectScope : :projectId 프로젝트를 DB에서 확인하고 작업 폴더를 context에 저장
+func ProjectScope(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		// 모든 path 파라미터는 경로 한 칸으로만 사용 가능
+		for _, name := range c.ParamNames() {
+			if err := common.ValidatePathSegment(c.Param(name)); err != nil {
+				return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), fmt.Sprintf("%s: %v", name, err), common.ErrFromClient)
+			}
+		}
+
+		projectID := c.Param("projectId")
+		if !common.IsValidProjectID(projectID) {
+			return common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), fmt.Sprintf("프로젝트를 찾을 수 없습니다: %s", projectID), common.ErrFromClient)
+		}
+
+		var project mysql.Projects
+		result := mysql.GormMysqlDB.WithContext(ctx).Where("id = ?", projectID).First(&project)
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), fmt.Sprintf("프로젝트를 찾을 수 없습니다: %s", projectID), common.ErrFromClient)
+		}
+		if result.Error != nil {
+			return common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), fmt.Sprintf("프로젝트 조회 실패: %v", result.Error), common.ErrFromMysqlDB)
+		}
+		if project.Status != mysql.ProjectStatusActive {
+			return common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), fmt.Sprintf("비활성화된 프로젝트입니다: %s", projectID), common.ErrFromClient)
+		}
+
+		ws, err := common.NewWorkspace(project.ID)
+		if err != nil {
+			return common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), err.Error(), common.ErrFromInternal)
+		}
+
+		// set workspace to Context
+		c.Set("workspace", ws)
+		c.SetRequest(c.Request().WithContext(common.WithWorkspace(ctx, ws)))
+
+		return next(c)
+	}
+}
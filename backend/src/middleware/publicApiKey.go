@@ -0,0 +1,59 @@
+package _middleware
+
+import (
+	"errors"
+	"fmt"
+	"main/common"
+	"main/common/db/mysql"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// 마지막 사용 시각은 폴링마다 쓰지 않도록 이 간격보다 오래됐을 때만 갱신
+const apiKeyTouchInterval = time.Minute
+
+// PublicAPIKey : X-API-Key 헤더의 키가 :projectId 프로젝트의 유효한 공개 API 키인지 확인
+// 프로젝트가 없거나 비활성인 경우도 키 오류와 같은 응답으로 처리 (프로젝트 존재 여부를 드러내지 않음)
+func PublicAPIKey(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		key := c.Request().Header.Get(common.PublicAPIKeyHeader)
+		if key == "" {
+			return common.ErrorMsg(ctx, common.ErrInvalidAPIKey, common.Trace(), fmt.Sprintf("%s 헤더가 필요합니다", common.PublicAPIKeyHeader), common.ErrFromClient)
+		}
+		projectID := c.Param("projectId")
+		if !common.IsValidProjectID(projectID) {
+			return common.ErrorMsg(ctx, common.ErrInvalidAPIKey, common.Trace(), "유효하지 않은 API 키입니다", common.ErrFromClient)
+		}
+
+		var apiKey mysql.PublicApiKeys
+		result := mysql.GormMysqlDB.WithContext(ctx).
+			Joins("JOIN projects ON projects.id = public_api_keys.project_id").
+			Where("public_api_keys.key_hash = ? AND public_api_keys.project_id = ?", common.HashPublicAPIKey(key), projectID).
+			Where("public_api_keys.revoked_at IS NULL AND projects.status = ?", mysql.ProjectStatusActive).
+			First(&apiKey)
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return common.ErrorMsg(ctx, common.ErrInvalidAPIKey, common.Trace(), "유효하지 않은 API 키입니다", common.ErrFromClient)
+		}
+		if result.Error != nil {
+			// 공개 API 응답에는 내부 오류 내용을 넣지 않음
+			fmt.Printf("공개 API 키 조회 실패 (%s): %v\n", projectID, result.Error)
+			return common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), "API 키 확인 실패", common.ErrFromMysqlDB)
+		}
+
+		now := time.Now()
+		if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= apiKeyTouchInterval {
+			if err := mysql.GormMysqlDB.WithContext(ctx).Model(&mysql.PublicApiKeys{}).Where("id = ?", apiKey.ID).Update("last_used_at", now).Error; err != nil {
+				fmt.Printf("공개 API 키 사용 시각 갱신 실패 (%d): %v\n", apiKey.ID, err)
+			}
+		}
+
+		// set api key to Context
+		c.Set("apiKeyId", apiKey.ID)
+
+		return next(c)
+	}
+}
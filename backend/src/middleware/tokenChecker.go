@@ -34,3 +34,28 @@ func TokenChecker(next echo.HandlerFunc) echo.HandlerFunc {
 
 	}
 }
+
+// OptionalTokenChecker : "tkn" 헤더가 있을 때만 검증해 사용자 정보를 Context에 저장 (없으면 익명으로 통과)
+func OptionalTokenChecker(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		accessToken := c.Request().Header.Get("tkn")
+		if accessToken == "" {
+			return next(c)
+		}
+
+		// verify & get Data
+		if err := common.VerifyToken(accessToken); err != nil {
+			return err
+		}
+		uID, email, err := common.ParseToken(accessToken)
+		if err != nil {
+			return err
+		}
+
+		// set token data to Context
+		c.Set("uID", uID)
+		c.Set("email", email)
+
+		return next(c)
+	}
+}
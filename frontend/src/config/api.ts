@@ -26,7 +26,7 @@ export const apiConfig = getCurrentConfig();
 // API 엔드포인트
 export const API_ENDPOINTS = {
   // 프로젝트 관련
-  PROJECTS: '/api/projects',
+  PROJECTS: '/v0.1/projects',
   
   // 파일 업로드 관련
   UPLOAD_LEARNING: (projectId: string) => `/v0.1/parking/${projectId}/train-images`,
@@ -46,6 +46,12 @@ export const API_ENDPOINTS = {
   // 학습 실행 관련
   LEARNING: (projectId: string) => `/v0.1/parking/${projectId}/learning`,
   LEARNING_LIVE: (projectId: string) => `/v0.1/parking/${projectId}/learning/live`,
+  LIVE_STATE: (projectId: string) => `/v0.1/parking/${projectId}/live/state`,
+  LIVE_EVENTS: (projectId: string) => `/v0.1/parking/${projectId}/live/events`,
+  
+  // 학습 작업 관련
+  JOB: (jobId: number) => `/v0.1/jobs/${jobId}`,
+  PROJECT_JOBS: (projectId: string) => `/v0.1/projects/${projectId}/jobs`,
   
   // 배치 이미지 다운로드
   BATCH_IMAGES: (projectId: string) => `/v0.1/parking/${projectId}/images/batch`,
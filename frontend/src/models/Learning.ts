@@ -12,6 +12,26 @@ export interface LearningResponse {
   folder_path: string;
 }
 
+export type LearningJobStatus = 'queued' | 'running' | 'succeeded' | 'failed' | 'cancelled';
+
+export interface LearningJobCreated {
+  job_id: number;
+  status: LearningJobStatus;
+}
+
+export interface LearningJob {
+  id: number;
+  project_id: string;
+  status: LearningJobStatus;
+  result_folder: string;
+  exit_code: number | null;
+  error_message: string;
+  duration_ms: number;
+  created_at: string;
+  started_at: string;
+  finished_at: string;
+}
+
 export interface LearningResult {
   test_image_name: string;
   cctv_id: string;
@@ -0,0 +1,62 @@
+export interface LiveSpaceState {
+  parking_id: string;
+  roi_id: number;
+  occupied: boolean;
+  rate: number;
+  threshold: number;
+  source_image: string;
+  observed_at: string;
+}
+
+export interface LiveCctvState {
+  cctv_id: string;
+  source_image: string;
+  observed_at: string;
+  occupied: number;
+  free: number;
+  spaces: LiveSpaceState[];
+}
+
+export interface LiveStateResponse {
+  project_id: string;
+  updated_at: string | null;
+  total: number;
+  occupied: number;
+  free: number;
+  cctvs: LiveCctvState[];
+}
+
+// GET /live/events (SSE) 이벤트
+export interface LiveSpaceChange {
+  cctv_id: string;
+  parking_id: string;
+  roi_id: number;
+  occupied: boolean;
+  previous: boolean | null;
+  rate: number;
+  observed_at: string;
+}
+
+export interface LiveImageUpdate {
+  cctv_id: string;
+  image_types: string[];
+  source_image: string;
+  updated_at: string;
+}
+
+export interface LiveRunFinished {
+  success: boolean;
+  cctvs: string[];
+  occupied: number;
+  free: number;
+  changed: number;
+  misaligned: number;
+  finished_at: string;
+}
+
+export interface LiveEventHandlers {
+  onSnapshot: (state: LiveStateResponse) => void;
+  onSpaceChanged: (change: LiveSpaceChange) => void;
+  onImageUpdated: (update: LiveImageUpdate) => void;
+  onRunFinished: (finished: LiveRunFinished) => void;
+}
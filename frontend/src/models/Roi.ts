@@ -84,3 +84,26 @@ export interface SaveDraftResponse {
   message: string;
   file_name: string;
 }
+
+// 카메라 단위 ROI 일괄 변환 (translate, matrix, points 중 하나)
+export interface TransformRoiRequest {
+  roi_file: string;
+  cctv_id: string;
+  translate?: { dx: number; dy: number };
+  matrix?: number[];
+  points?: { from: [number, number]; to: [number, number] }[];
+  preview: boolean;
+}
+
+export interface TransformRoiResponse {
+  success: boolean;
+  message: string;
+  preview: boolean;
+  cctv_id: string;
+  matrix: number[];
+  residual?: number;
+  rois: CctvRoiInfo[];
+  version?: string;
+  errors?: any[];
+  warnings?: any[];
+}
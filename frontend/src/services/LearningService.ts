@@ -1,5 +1,5 @@
 import axios from 'axios';
-import { LearningRequest, LearningResponse, LearningResultsData, LearningResultsResponse } from '../models/Learning';
+import { LearningJob, LearningJobCreated, LearningRequest, LearningResponse, LearningResultsData, LearningResultsResponse } from '../models/Learning';
 import { apiConfig, API_ENDPOINTS } from '../config/api';
 
 const axiosConfig = {
@@ -10,16 +10,40 @@ const axiosConfig = {
 class LearningService {
   private api = axios.create(axiosConfig);
 
+  // 학습 작업을 등록한 뒤 끝날 때까지 상태를 조회
   async executeLearning(request: LearningRequest): Promise<LearningResponse> {
     try {
-      const response = await this.api.post(API_ENDPOINTS.LEARNING(request.projectId), request);
-      return response.data;
+      const response = await this.api.post<LearningJobCreated>(API_ENDPOINTS.LEARNING(request.projectId), request);
+      const job = await this.waitForJob(response.data.job_id);
+      if (job.status === 'failed') {
+        throw new Error(job.error_message || '학습 작업이 실패했습니다.');
+      }
+      if (job.status === 'cancelled') {
+        throw new Error('학습 작업이 취소되었습니다.');
+      }
+      return { folder_path: job.result_folder };
     } catch (error) {
       console.error('학습 실행 실패:', error);
       throw error;
     }
   }
 
+  async getJob(jobId: number): Promise<LearningJob> {
+    const response = await this.api.get(API_ENDPOINTS.JOB(jobId));
+    return response.data.data;
+  }
+
+  // 끝난 상태(succeeded/failed/cancelled)가 될 때까지 조회
+  private async waitForJob(jobId: number, intervalMs = 2000): Promise<LearningJob> {
+    for (;;) {
+      const job = await this.getJob(jobId);
+      if (job.status === 'succeeded' || job.status === 'failed' || job.status === 'cancelled') {
+        return job;
+      }
+      await new Promise(resolve => setTimeout(resolve, intervalMs));
+    }
+  }
+
   async getLearningResults(projectId: string, folderPath: string): Promise<LearningResultsResponse> {
     try {
       const url = API_ENDPOINTS.LEARNING_RESULTS(projectId, folderPath);
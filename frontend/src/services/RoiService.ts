@@ -9,11 +9,25 @@ import {
   ReadRoiResponse,
   TestStatsRoiResponse,
   DraftRoiResponse,
-  SaveDraftResponse
+  SaveDraftResponse,
+  TransformRoiRequest,
+  TransformRoiResponse
 } from '../models/Roi';
 
 const api = axios.create(axiosConfig);
 
+// ROI 파일별 draft ETag (생성/수정/삭제 요청의 If-Match로 사용)
+const draftVersions: Record<string, string> = {};
+
+const rememberVersion = (roiFile: string, response: { headers: any; data: any }) => {
+  const version = response.headers?.etag || response.data?.version;
+  if (version) {
+    draftVersions[roiFile] = version;
+  }
+};
+
+const ifMatch = (roiFile: string) => ({ 'If-Match': draftVersions[roiFile] || '' });
+
 export class RoiService {
   // 테스트 폴더 목록 조회
   static async getTestFolders(projectId: string): Promise<any[]> {
@@ -41,15 +55,26 @@ export class RoiService {
     return response.data.folders || response.data || [];
   }
 
-  // ROI Draft 생성
+  // ROI Draft 생성 (이미 있는 초안은 덮어쓰지 않고 이어서 편집)
   static async createDraftRoi(projectId: string, roiFileName: string): Promise<RoiResponse> {
-    const response = await api.post(API_ENDPOINTS.CREATE_DRAFT_ROI(projectId, roiFileName));
-    return response.data;
+    try {
+      const response = await api.post(API_ENDPOINTS.CREATE_DRAFT_ROI(projectId, roiFileName), null, { headers: ifMatch(roiFileName) });
+      rememberVersion(roiFileName, response);
+      return response.data;
+    } catch (err: any) {
+      // 428: 초안이 이미 있음, 409: 그 사이 다른 사용자가 초안을 수정함
+      if (err?.response?.status === 428 || err?.response?.status === 409) {
+        await RoiService.getDraftRoi(projectId, roiFileName);
+        return { success: true, message: '기존 초안을 이어서 편집합니다' };
+      }
+      throw err;
+    }
   }
 
   // ROI Draft 조회
   static async getDraftRoi(projectId: string, roiFileName: string): Promise<DraftRoiResponse> {
     const response = await api.get(API_ENDPOINTS.GET_DRAFT_ROI(projectId, roiFileName));
+    rememberVersion(roiFileName, response);
     return response.data;
   }
 
@@ -65,13 +90,15 @@ export class RoiService {
   static async createRoi(projectId: string, request: { roi_id: string; cctv_id: string; roi_file: string; coords: number[] }): Promise<RoiResponse> {
     const url = `/v0.1/roi/${projectId}/create`;
     console.log('🔧 ROI Create Request:', { url, request });
-    const response = await api.post(url, request);
+    const response = await api.post(url, request, { headers: ifMatch(request.roi_file) });
+    rememberVersion(request.roi_file, response);
     return response.data;
   }
 
   // ROI 읽기
   static async readRoi(projectId: string, request: ReadRoiRequest): Promise<ReadRoiResponse> {
     const response = await api.post(API_ENDPOINTS.READ_ROI(projectId), request);
+    rememberVersion(request.roi_file, response);
     return response.data;
   }
 
@@ -79,7 +106,8 @@ export class RoiService {
   static async updateRoi(projectId: string, request: { roi_id: string; cctv_id: string; roi_file: string; coords: number[] }): Promise<RoiResponse> {
     const url = `/v0.1/roi/${projectId}/update`;
     console.log('🔧 ROI Update Request:', { url, request });
-    const response = await api.put(url, request);
+    const response = await api.put(url, request, { headers: ifMatch(request.roi_file) });
+    rememberVersion(request.roi_file, response);
     return response.data;
   }
 
@@ -87,7 +115,57 @@ export class RoiService {
   static async deleteRoi(projectId: string, request: { roi_id: string; cctv_id: string; roi_file: string }): Promise<RoiResponse> {
     const url = `/v0.1/roi/${projectId}/delete`;
     console.log('🔧 ROI Delete Request:', { url, request });
-    const response = await api.delete(url, { data: request });
+    const response = await api.delete(url, { data: request, headers: ifMatch(request.roi_file) });
+    rememberVersion(request.roi_file, response);
+    return response.data;
+  }
+
+  // CCTV 단위 ROI 일괄 변환 (preview면 저장하지 않음)
+  static async transformRoi(projectId: string, request: TransformRoiRequest): Promise<TransformRoiResponse> {
+    const url = `/v0.1/roi/${projectId}/transform`;
+    const response = await api.post(url, request, { headers: request.preview ? {} : ifMatch(request.roi_file) });
+    if (!request.preview) {
+      rememberVersion(request.roi_file, response);
+    }
+    return response.data;
+  }
+
+  // ROI 내보내기 (cvat, labelme, geojson)
+  static async exportRoi(projectId: string, roiFile: string, format: 'cvat' | 'labelme' | 'geojson', source?: 'draft' | 'original'): Promise<Blob> {
+    const response = await api.get(`/v0.1/roi/${projectId}/export`, {
+      params: { roi_file: roiFile, format, source },
+      responseType: 'blob'
+    });
+    return response.data;
+  }
+
+  // 이미지 위에 ROI를 그린 오버레이 (experimentId가 있으면 점유 색상)
+  static async renderRoi(projectId: string, params: {
+    file: string;
+    roi_file: string;
+    base?: 'test' | 'current';
+    folder?: string;
+    source?: 'draft' | 'original';
+    cctv_id?: string;
+    experiment_id?: number;
+    format?: 'png' | 'jpeg';
+  }): Promise<Blob> {
+    const response = await api.get(`/v0.1/roi/${projectId}/render`, {
+      params,
+      responseType: 'blob'
+    });
+    return response.data;
+  }
+
+  // 외부 라벨링 도구 파일을 초안으로 가져오기
+  static async importRoi(projectId: string, roiFile: string, format: 'cvat' | 'labelme' | 'geojson', file: File): Promise<any> {
+    const formData = new FormData();
+    formData.append('roi_file', roiFile);
+    formData.append('format', format);
+    formData.append('file', file);
+    const headers = draftVersions[roiFile] ? ifMatch(roiFile) : {};
+    const response = await api.post(`/v0.1/roi/${projectId}/import`, formData, { headers });
+    rememberVersion(roiFile, response);
     return response.data;
   }
 }
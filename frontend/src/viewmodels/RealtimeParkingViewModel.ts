@@ -1,6 +1,7 @@
 import { FileUploadService } from '../services/FileUploadService';
 import LearningService from '../services/LearningService';
 import { API_ENDPOINTS, apiConfig } from '../config/api';
+import { LiveEventHandlers, LiveSpaceChange, LiveStateResponse } from '../models/LiveState';
 
 export interface RealtimeSettings {
   learningImageFolder: string;
@@ -96,6 +97,96 @@ export class RealtimeParkingViewModel {
     }
   }
 
+  // 실시간 학습마다 저장되는 주차면별 최신 점유 상태
+  static async getLiveState(projectId: string): Promise<LiveStateResponse> {
+    try {
+      const response = await fetch(`${apiConfig.BASE_URL}${API_ENDPOINTS.LIVE_STATE(projectId)}`, {
+        method: 'GET',
+        cache: 'no-cache',
+        headers: {
+          'Content-Type': 'application/json',
+        }
+      });
+
+      if (!response.ok) {
+        throw new Error('주차면 상태 조회 실패');
+      }
+
+      return await response.json();
+    } catch (error) {
+      console.error('주차면 상태 조회 실패:', error);
+      throw error;
+    }
+  }
+
+  // 실시간 점유 변경 구독 (EventSource가 끊기면 Last-Event-ID로 자동 재접속), 반환 함수로 구독 해제
+  static subscribeLiveEvents(projectId: string, handlers: LiveEventHandlers): () => void {
+    const source = new EventSource(`${apiConfig.BASE_URL}${API_ENDPOINTS.LIVE_EVENTS(projectId)}`);
+    const listen = <T,>(type: string, handler: (data: T) => void) => {
+      source.addEventListener(type, (event) => {
+        try {
+          handler(JSON.parse((event as MessageEvent).data));
+        } catch (error) {
+          console.error(`실시간 이벤트 처리 실패 (${type}):`, error);
+        }
+      });
+    };
+    listen('snapshot', handlers.onSnapshot);
+    listen('space_changed', handlers.onSpaceChanged);
+    listen('image_updated', handlers.onImageUpdated);
+    listen('live_run_finished', handlers.onRunFinished);
+    source.onerror = () => console.warn('실시간 이벤트 연결이 끊겨 재접속합니다');
+    return () => source.close();
+  }
+
+  // space_changed 이벤트를 현재 상태에 반영 (CCTV/전체 점유 수 다시 계산)
+  static applySpaceChange(state: LiveStateResponse | null, change: LiveSpaceChange): LiveStateResponse | null {
+    if (!state) {
+      return state;
+    }
+    let cctvs = state.cctvs.map((cctv) => {
+      if (cctv.cctv_id !== change.cctv_id) {
+        return cctv;
+      }
+      const space = {
+        parking_id: change.parking_id,
+        roi_id: change.roi_id,
+        occupied: change.occupied,
+        rate: change.rate,
+        threshold: cctv.spaces.find((item) => item.parking_id === change.parking_id)?.threshold ?? 0,
+        source_image: cctv.source_image,
+        observed_at: change.observed_at,
+      };
+      const exists = cctv.spaces.some((item) => item.parking_id === change.parking_id);
+      const spaces = exists
+        ? cctv.spaces.map((item) => (item.parking_id === change.parking_id ? { ...item, ...space, source_image: item.source_image } : item))
+        : [...cctv.spaces, space];
+      const occupied = spaces.filter((item) => item.occupied).length;
+      return { ...cctv, spaces, occupied, free: spaces.length - occupied };
+    });
+    if (!cctvs.some((cctv) => cctv.cctv_id === change.cctv_id)) {
+      cctvs = [...cctvs, {
+        cctv_id: change.cctv_id,
+        source_image: '',
+        observed_at: change.observed_at,
+        occupied: change.occupied ? 1 : 0,
+        free: change.occupied ? 0 : 1,
+        spaces: [{
+          parking_id: change.parking_id,
+          roi_id: change.roi_id,
+          occupied: change.occupied,
+          rate: change.rate,
+          threshold: 0,
+          source_image: '',
+          observed_at: change.observed_at,
+        }],
+      }];
+    }
+    const occupied = cctvs.reduce((sum, cctv) => sum + cctv.occupied, 0);
+    const total = cctvs.reduce((sum, cctv) => sum + cctv.spaces.length, 0);
+    return { ...state, cctvs, total, occupied, free: total - occupied, updated_at: change.observed_at };
+  }
+
   static async getRealtimeCctvImage(projectId: string, cctvId: string, imageType: string): Promise<string> {
     try {
       // 캐시 방지를 위한 타임스탬프 추가
@@ -16,12 +16,14 @@ import {
   Switch,
   FormControlLabel,
   Modal,
-  IconButton
+  IconButton,
+  Chip
 } from '@mui/material';
 import { PlayArrow, Stop, ArrowBack as BackIcon, ZoomIn as ZoomInIcon, Close as CloseIcon } from '@mui/icons-material';
 import { RealtimeParkingViewModel } from '../viewmodels/RealtimeParkingViewModel';
 import LearningResultsView from './LearningResultsView';
 import { Project } from '../models/Project';
+import { LiveStateResponse } from '../models/LiveState';
 
 interface RealtimeParkingViewProps {
   project: Project;
@@ -54,6 +56,7 @@ const RealtimeParkingView: React.FC<RealtimeParkingViewProps> = ({ project, onBa
   
   // 실시간 결과
   const [realtimeResults, setRealtimeResults] = useState<any>(null);
+  const [liveState, setLiveState] = useState<LiveStateResponse | null>(null);
   const [cctvList, setCctvList] = useState<string[]>([]);
   const [selectedCctv, setSelectedCctv] = useState<string>('');
   const [selectedCctvImages, setSelectedCctvImages] = useState<any>(null);
@@ -64,6 +67,41 @@ const RealtimeParkingView: React.FC<RealtimeParkingViewProps> = ({ project, onBa
   // 타이머 참조
   const batchIntervalRef = useRef<NodeJS.Timeout | null>(null);
   const learningIntervalRef = useRef<NodeJS.Timeout | null>(null);
+  // 이벤트 핸들러에서 최신 선택 CCTV를 보기 위한 참조
+  const selectedCctvRef = useRef<string>('');
+
+  useEffect(() => {
+    selectedCctvRef.current = selectedCctv;
+  }, [selectedCctv]);
+
+  // 실행 중에는 주차면 변경과 이미지 갱신을 서버 이벤트로 받음 (폴링 대신)
+  useEffect(() => {
+    if (!isRunning) {
+      return;
+    }
+    return RealtimeParkingViewModel.subscribeLiveEvents(project.id, {
+      onSnapshot: (state) => setLiveState(state),
+      onSpaceChanged: (change) => setLiveState((prev) => RealtimeParkingViewModel.applySpaceChange(prev, change)),
+      onImageUpdated: async (update) => {
+        if (update.cctv_id !== selectedCctvRef.current) {
+          return;
+        }
+        try {
+          const images = await RealtimeParkingViewModel.getRealtimeCctvImages(project.id, update.cctv_id);
+          setSelectedCctvImages(images);
+          setCctvImageError(null);
+        } catch (error: any) {
+          console.error('현재 CCTV 이미지 업데이트 실패:', error);
+          setCctvImageError(error.message || 'CCTV 이미지 업데이트 중 오류가 발생했습니다.');
+        }
+      },
+      onRunFinished: (finished) => {
+        if (finished.success && finished.cctvs.length > 0) {
+          setCctvList(finished.cctvs);
+        }
+      },
+    });
+  }, [isRunning, project.id]);
 
   useEffect(() => {
     loadAvailableFolders();
@@ -116,30 +154,15 @@ const RealtimeParkingView: React.FC<RealtimeParkingViewProps> = ({ project, onBa
       // 실시간 학습 시작 (40초마다)
       learningIntervalRef.current = setInterval(async () => {
         try {
+          // 주차면 상태, CCTV 목록, 선택된 CCTV 이미지는 실시간 이벤트로 갱신
           const result = await RealtimeParkingViewModel.startRealtimeLearning(project.id, settings);
           setRealtimeResults(result);
-          
-          // CCTV 리스트 업데이트
-          let targetCctv = selectedCctv;
-          if (result && result.cctvs) {
-            setCctvList(result.cctvs);
-            // 현재 선택된 CCTV가 없거나 새로운 리스트에 없는 경우에만 첫 번째로 변경
-            if (result.cctvs.length > 0 && (!selectedCctv || !result.cctvs.includes(selectedCctv))) {
-              targetCctv = result.cctvs[0];
-              setSelectedCctv(targetCctv);
-            }
-          }
-          
-          // 선택된 CCTV의 이미지만 업데이트 (CCTV 선택은 유지)
-          if (targetCctv) {
-            try {
-              const images = await RealtimeParkingViewModel.getRealtimeCctvImages(project.id, targetCctv);
-              setSelectedCctvImages(images);
-              setCctvImageError(null);
-            } catch (error: any) {
-              console.error('현재 CCTV 이미지 업데이트 실패:', error);
-              setCctvImageError(error.message || 'CCTV 이미지 업데이트 중 오류가 발생했습니다.');
-            }
+
+          // 현재 선택된 CCTV가 없거나 새로운 리스트에 없는 경우에만 첫 번째로 변경
+          const current = selectedCctvRef.current;
+          if (result && result.cctvs && result.cctvs.length > 0 && (!current || !result.cctvs.includes(current))) {
+            setSelectedCctv(result.cctvs[0]);
+            handleCctvSelect(result.cctvs[0]);
           }
           
           console.log('실시간 학습 완료:', result);
@@ -200,6 +223,7 @@ const RealtimeParkingView: React.FC<RealtimeParkingViewProps> = ({ project, onBa
     
     setIsRunning(false);
     setRealtimeResults(null);
+    setLiveState(null);
     setCctvList([]);
     setSelectedCctv('');
     console.log('실시간 주차면 중단됨');
@@ -412,7 +436,9 @@ const RealtimeParkingView: React.FC<RealtimeParkingViewProps> = ({ project, onBa
                   CCTV 목록 ({cctvList.length}개)
                 </Typography>
                 <Box sx={{ maxHeight: '350px', overflowY: 'auto' }}>
-                  {cctvList.map((cctvId) => (
+                  {cctvList.map((cctvId) => {
+                    const cctvState = liveState?.cctvs.find((cctv) => cctv.cctv_id === cctvId);
+                    return (
                     <Box
                       key={cctvId}
                       sx={{
@@ -434,10 +460,13 @@ const RealtimeParkingView: React.FC<RealtimeParkingViewProps> = ({ project, onBa
                         {cctvId}
                       </Typography>
                       <Typography variant="body2" color="text.secondary">
-                        실시간 모니터링 중
+                        {cctvState
+                          ? `점유 ${cctvState.occupied} / 빈 자리 ${cctvState.free}`
+                          : '실시간 모니터링 중'}
                       </Typography>
                     </Box>
-                  ))}
+                    );
+                  })}
                 </Box>
               </Box>
               
@@ -448,6 +477,31 @@ const RealtimeParkingView: React.FC<RealtimeParkingViewProps> = ({ project, onBa
                     <Typography variant="h5" gutterBottom color="primary">
                       {selectedCctv}
                     </Typography>
+
+                    {/* 주차면별 점유 상태 */}
+                    {(() => {
+                      const cctvState = liveState?.cctvs.find((cctv) => cctv.cctv_id === selectedCctv);
+                      if (!cctvState) {
+                        return null;
+                      }
+                      return (
+                        <Box sx={{ mb: 2 }}>
+                          <Typography variant="body2" color="text.secondary" gutterBottom>
+                            {cctvState.source_image} · {new Date(cctvState.observed_at).toLocaleString()}
+                          </Typography>
+                          <Box sx={{ display: 'flex', gap: 1, flexWrap: 'wrap' }}>
+                            {cctvState.spaces.map((space) => (
+                              <Chip
+                                key={space.parking_id}
+                                size="small"
+                                color={space.occupied ? 'error' : 'success'}
+                                label={`${space.parking_id} ${(space.rate * 100).toFixed(0)}%`}
+                              />
+                            ))}
+                          </Box>
+                        </Box>
+                      );
+                    })()}
                     
                     {loadingCctvImages ? (
                       <Box sx={{ display: 'flex', justifyContent: 'center', alignItems: 'center', height: '300px' }}>
@@ -27,17 +27,249 @@ CREATE TABLE IF NOT EXISTS file_uploads (
     FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
 );
 
--- Parking test results table
-CREATE TABLE IF NOT EXISTS parking_test_results (
-    id INT AUTO_INCREMENT PRIMARY KEY,
+-- Learning sweeps table (하이퍼파라미터 조합 실행 묶음)
+CREATE TABLE IF NOT EXISTS learning_sweeps (
+    id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    project_id VARCHAR(50) NOT NULL,
+    learning_path VARCHAR(500),
+    test_image_path VARCHAR(500),
+    roi_path VARCHAR(500),
+    occupancy_threshold DOUBLE NOT NULL,
+    total_runs INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+-- Learning jobs table (OpenCV 학습 작업 대기열/실행 이력)
+CREATE TABLE IF NOT EXISTS learning_jobs (
+    id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
     project_id VARCHAR(50) NOT NULL,
-    test_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    var_threshold DECIMAL(5,2) NOT NULL,
+    sweep_id INT UNSIGNED NULL,
+    status ENUM('queued', 'running', 'succeeded', 'failed', 'cancelled') NOT NULL DEFAULT 'queued',
+    var_threshold DOUBLE NOT NULL,
+    learning_rate DOUBLE NOT NULL,
+    iterations INT NOT NULL,
     learning_path VARCHAR(500),
     test_image_path VARCHAR(500),
-    result_path VARCHAR(500),
-    status ENUM('pending', 'processing', 'completed', 'failed') DEFAULT 'pending',
+    roi_path VARCHAR(500),
+    result_folder VARCHAR(100),
+    experiment_session_id BIGINT UNSIGNED NULL,
+    exit_code INT NULL,
+    stdout_tail TEXT,
+    stderr_tail TEXT,
     error_message TEXT,
+    duration_ms BIGINT NOT NULL DEFAULT 0,
+    started_at TIMESTAMP NULL,
+    finished_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    FOREIGN KEY (sweep_id) REFERENCES learning_sweeps(id) ON DELETE SET NULL
+);
+
+-- Occupancy thresholds table (점유 판정 기준: 프로젝트 기본 / CCTV별 / parking_id별)
+-- scope가 project면 cctv_id='' roi_id=0, cctv면 roi_id=0
+CREATE TABLE IF NOT EXISTS occupancy_thresholds (
+    id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    project_id VARCHAR(50) NOT NULL,
+    scope ENUM('project', 'cctv', 'roi') NOT NULL,
+    cctv_id VARCHAR(100) NOT NULL DEFAULT '',
+    roi_id INT NOT NULL DEFAULT 0,
+    threshold DOUBLE NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_occupancy_thresholds (project_id, scope, cctv_id, roi_id),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+-- ROI versions table (초안 저장으로 만들어진 ROI 파일 버전의 작성자 기록)
+-- 파일은 uploads/roi/{base_name}_{YYYYMMDD_HHMMSS}.json
+CREATE TABLE IF NOT EXISTS roi_versions (
+    id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    project_id VARCHAR(50) NOT NULL,
+    base_name VARCHAR(255) NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    author_id INT UNSIGNED NULL,
+    author_email VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_roi_versions_file (project_id, file_name),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+-- Parking zones table (주차장 구역 계층: 주차장(lot) → 층(floor) → 구역(zone))
+CREATE TABLE IF NOT EXISTS parking_zones (
+    id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    project_id VARCHAR(50) NOT NULL,
+    lot VARCHAR(100) NOT NULL,
+    floor VARCHAR(100) NOT NULL,
+    zone VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_parking_zones (project_id, lot, floor, zone),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+-- Parking zone spaces table (구역에 속한 주차면, CCTV의 parking_id는 한 구역에만 속함)
+CREATE TABLE IF NOT EXISTS parking_zone_spaces (
+    id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    project_id VARCHAR(50) NOT NULL,
+    zone_id INT UNSIGNED NOT NULL,
+    cctv_id VARCHAR(100) NOT NULL,
+    parking_id VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_parking_zone_spaces (project_id, cctv_id, parking_id),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    FOREIGN KEY (zone_id) REFERENCES parking_zones(id) ON DELETE CASCADE
+);
+
+-- Parking zone rules table (이름 규칙으로 구역 만들기, priority 순으로 처음 맞는 규칙 적용)
+-- cctv_pattern/parking_pattern은 정규식 (비어 있으면 모두 일치), lot/floor/zone은 ${그룹이름} 템플릿
+CREATE TABLE IF NOT EXISTS parking_zone_rules (
+    id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    project_id VARCHAR(50) NOT NULL,
+    priority INT NOT NULL DEFAULT 0,
+    cctv_pattern VARCHAR(255) NOT NULL DEFAULT '',
+    parking_pattern VARCHAR(255) NOT NULL DEFAULT '',
+    lot VARCHAR(100) NOT NULL DEFAULT '',
+    floor VARCHAR(100) NOT NULL DEFAULT '',
+    zone VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+-- Parking space categories table (주차면 종류, 지정하지 않은 주차면은 general)
+-- 구역과 따로 저장해 구역을 다시 만들어도 유지됨
+CREATE TABLE IF NOT EXISTS parking_space_categories (
+    id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    project_id VARCHAR(50) NOT NULL,
+    cctv_id VARCHAR(100) NOT NULL,
+    parking_id VARCHAR(100) NOT NULL,
+    category VARCHAR(30) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_parking_space_categories (project_id, cctv_id, parking_id),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+-- Parking spaces table (CCTV별 parking_id 문자열 -> OpenCV ROI 번호, 한 번 정한 번호는 바꾸지 않음)
+CREATE TABLE IF NOT EXISTS parking_spaces (
+    id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    project_id VARCHAR(50) NOT NULL,
+    cctv_id VARCHAR(100) NOT NULL,
+    parking_id VARCHAR(100) NOT NULL,
+    roi_id INT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_parking_spaces_parking_id (project_id, cctv_id, parking_id),
+    UNIQUE KEY uk_parking_spaces_roi_id (project_id, cctv_id, roi_id),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+-- Space state table (주차면별 최신 실시간 점유 상태, 실시간 학습마다 갱신)
+CREATE TABLE IF NOT EXISTS space_state (
+    id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    project_id VARCHAR(50) NOT NULL,
+    cctv_id VARCHAR(100) NOT NULL,
+    parking_id VARCHAR(100) NOT NULL,
+    roi_id INT NOT NULL,
+    occupied BOOLEAN NOT NULL,
+    rate DOUBLE NOT NULL,
+    threshold DOUBLE NOT NULL,
+    source_image VARCHAR(255) NOT NULL DEFAULT '',
+    observed_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_space_state (project_id, cctv_id, parking_id),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+-- Occupancy samples table (실시간 학습마다 쌓이는 주차면 점유 이력, 같은 관측 시각은 한 번만 기록)
+CREATE TABLE IF NOT EXISTS occupancy_samples (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    project_id VARCHAR(50) NOT NULL,
+    cctv_id VARCHAR(100) NOT NULL,
+    parking_id VARCHAR(100) NOT NULL,
+    occupied BOOLEAN NOT NULL,
+    rate DOUBLE NOT NULL,
+    observed_at TIMESTAMP(3) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_occupancy_samples (project_id, cctv_id, parking_id, observed_at),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+-- Occupancy hourly samples table (보관 기간이 지난 원본 점을 시간 단위로 줄인 이력)
+CREATE TABLE IF NOT EXISTS occupancy_hourly_samples (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    project_id VARCHAR(50) NOT NULL,
+    cctv_id VARCHAR(100) NOT NULL,
+    parking_id VARCHAR(100) NOT NULL,
+    bucket_start TIMESTAMP NOT NULL,
+    samples INT NOT NULL,
+    occupied_samples INT NOT NULL,
+    rate_sum DOUBLE NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_occupancy_hourly_samples (project_id, cctv_id, parking_id, bucket_start),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+-- Webhook subscriptions table (프로젝트별 외부 알림 구독, event_types는 쉼표로 구분)
+-- zone_id cascade는 프로젝트 삭제용. 구역 저장은 구독이 걸린 구역을 지우지 않음 (CONFLICT)
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    project_id VARCHAR(50) NOT NULL,
+    url VARCHAR(500) NOT NULL,
+    secret VARCHAR(100) NOT NULL,
+    event_types VARCHAR(255) NOT NULL,
+    description VARCHAR(200) NOT NULL DEFAULT '',
+    zone_id INT UNSIGNED NULL,
+    threshold_percent DOUBLE NOT NULL DEFAULT 100,
+    stale_minutes INT NOT NULL DEFAULT 10,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    FOREIGN KEY (zone_id) REFERENCES parking_zones(id) ON DELETE CASCADE
+);
+
+-- Webhook deliveries table (구독별 전송 기록, 같은 이벤트는 구독마다 한 번만 기록)
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    subscription_id INT UNSIGNED NOT NULL,
+    project_id VARCHAR(50) NOT NULL,
+    event_id VARCHAR(150) NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload MEDIUMTEXT NOT NULL,
+    status ENUM('pending', 'succeeded', 'failed') NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    response_status INT NOT NULL DEFAULT 0,
+    response_body VARCHAR(1000) NOT NULL DEFAULT '',
+    error_message VARCHAR(1000) NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMP NULL,
+    delivered_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_webhook_deliveries (subscription_id, event_id),
+    FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+-- Public API keys table (파트너용 공개 API 키, 원래 키는 저장하지 않고 SHA-256 해시만 보관)
+CREATE TABLE IF NOT EXISTS public_api_keys (
+    id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    project_id VARCHAR(50) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    key_prefix VARCHAR(20) NOT NULL,
+    key_hash CHAR(64) NOT NULL,
+    last_used_at TIMESTAMP NULL,
+    revoked_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_public_api_keys (key_hash),
     FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
 );
 
@@ -58,5 +290,15 @@ ON DUPLICATE KEY UPDATE
 -- Create indexes for better performance
 CREATE INDEX idx_file_uploads_project_id ON file_uploads(project_id);
 CREATE INDEX idx_file_uploads_file_type ON file_uploads(file_type);
-CREATE INDEX idx_parking_test_results_project_id ON parking_test_results(project_id);
-CREATE INDEX idx_parking_test_results_status ON parking_test_results(status); 
\ No newline at end of file
+CREATE INDEX idx_learning_jobs_project_id ON learning_jobs(project_id, created_at);
+CREATE INDEX idx_learning_jobs_status ON learning_jobs(status);
+CREATE INDEX idx_learning_jobs_sweep_id ON learning_jobs(sweep_id);
+CREATE INDEX idx_learning_sweeps_project_id ON learning_sweeps(project_id, created_at);
+CREATE INDEX idx_roi_versions_base_name ON roi_versions(project_id, base_name); 
+CREATE INDEX idx_parking_zone_rules_project_id ON parking_zone_rules(project_id, priority);
+CREATE INDEX idx_occupancy_samples_observed_at ON occupancy_samples(project_id, observed_at);
+CREATE INDEX idx_occupancy_hourly_samples_bucket_start ON occupancy_hourly_samples(project_id, bucket_start);
+CREATE INDEX idx_webhook_subscriptions_project_id ON webhook_subscriptions(project_id);
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries(status, next_attempt_at);
+CREATE INDEX idx_webhook_deliveries_subscription_id ON webhook_deliveries(subscription_id, created_at);
+CREATE INDEX idx_public_api_keys_project_id ON public_api_keys(project_id);